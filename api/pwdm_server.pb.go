@@ -0,0 +1,12615 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.12.4
+// source: proto/pwdm_server.proto
+
+package api
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type WatchEvent_EventType int32
+
+const (
+	WatchEvent_UNKNOWN WatchEvent_EventType = 0
+	WatchEvent_CREATED WatchEvent_EventType = 1
+	WatchEvent_UPDATED WatchEvent_EventType = 2
+	WatchEvent_DELETED WatchEvent_EventType = 3
+)
+
+// Enum value maps for WatchEvent_EventType.
+var (
+	WatchEvent_EventType_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "CREATED",
+		2: "UPDATED",
+		3: "DELETED",
+	}
+	WatchEvent_EventType_value = map[string]int32{
+		"UNKNOWN": 0,
+		"CREATED": 1,
+		"UPDATED": 2,
+		"DELETED": 3,
+	}
+)
+
+func (x WatchEvent_EventType) Enum() *WatchEvent_EventType {
+	p := new(WatchEvent_EventType)
+	*p = x
+	return p
+}
+
+func (x WatchEvent_EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WatchEvent_EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_pwdm_server_proto_enumTypes[0].Descriptor()
+}
+
+func (WatchEvent_EventType) Type() protoreflect.EnumType {
+	return &file_proto_pwdm_server_proto_enumTypes[0]
+}
+
+func (x WatchEvent_EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WatchEvent_EventType.Descriptor instead.
+func (WatchEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type BatchReq_Operation_Action int32
+
+const (
+	BatchReq_Operation_UNKNOWN BatchReq_Operation_Action = 0
+	BatchReq_Operation_INSERT  BatchReq_Operation_Action = 1
+	BatchReq_Operation_UPDATE  BatchReq_Operation_Action = 2
+	BatchReq_Operation_DELETE  BatchReq_Operation_Action = 3
+)
+
+// Enum value maps for BatchReq_Operation_Action.
+var (
+	BatchReq_Operation_Action_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "INSERT",
+		2: "UPDATE",
+		3: "DELETE",
+	}
+	BatchReq_Operation_Action_value = map[string]int32{
+		"UNKNOWN": 0,
+		"INSERT":  1,
+		"UPDATE":  2,
+		"DELETE":  3,
+	}
+)
+
+func (x BatchReq_Operation_Action) Enum() *BatchReq_Operation_Action {
+	p := new(BatchReq_Operation_Action)
+	*p = x
+	return p
+}
+
+func (x BatchReq_Operation_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchReq_Operation_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_pwdm_server_proto_enumTypes[1].Descriptor()
+}
+
+func (BatchReq_Operation_Action) Type() protoreflect.EnumType {
+	return &file_proto_pwdm_server_proto_enumTypes[1]
+}
+
+func (x BatchReq_Operation_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchReq_Operation_Action.Descriptor instead.
+func (BatchReq_Operation_Action) EnumDescriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{4, 5, 0}
+}
+
+type ListItemsReq_SortBy int32
+
+const (
+	ListItemsReq_TITLE    ListItemsReq_SortBy = 0
+	ListItemsReq_CREATED  ListItemsReq_SortBy = 1
+	ListItemsReq_UPDATED  ListItemsReq_SortBy = 2
+	ListItemsReq_RECENT   ListItemsReq_SortBy = 3 // by the time of the last read
+	ListItemsReq_FREQUENT ListItemsReq_SortBy = 4 // by the number of reads
+)
+
+// Enum value maps for ListItemsReq_SortBy.
+var (
+	ListItemsReq_SortBy_name = map[int32]string{
+		0: "TITLE",
+		1: "CREATED",
+		2: "UPDATED",
+		3: "RECENT",
+		4: "FREQUENT",
+	}
+	ListItemsReq_SortBy_value = map[string]int32{
+		"TITLE":    0,
+		"CREATED":  1,
+		"UPDATED":  2,
+		"RECENT":   3,
+		"FREQUENT": 4,
+	}
+)
+
+func (x ListItemsReq_SortBy) Enum() *ListItemsReq_SortBy {
+	p := new(ListItemsReq_SortBy)
+	*p = x
+	return p
+}
+
+func (x ListItemsReq_SortBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListItemsReq_SortBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_pwdm_server_proto_enumTypes[2].Descriptor()
+}
+
+func (ListItemsReq_SortBy) Type() protoreflect.EnumType {
+	return &file_proto_pwdm_server_proto_enumTypes[2]
+}
+
+func (x ListItemsReq_SortBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListItemsReq_SortBy.Descriptor instead.
+func (ListItemsReq_SortBy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type CustomField_Type int32
+
+const (
+	CustomField_UNKNOWN CustomField_Type = 0
+	CustomField_TEXT    CustomField_Type = 1
+	CustomField_HIDDEN  CustomField_Type = 2
+	CustomField_BOOLEAN CustomField_Type = 3 // value is "true" or "false"
+	CustomField_URL     CustomField_Type = 4
+)
+
+// Enum value maps for CustomField_Type.
+var (
+	CustomField_Type_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "TEXT",
+		2: "HIDDEN",
+		3: "BOOLEAN",
+		4: "URL",
+	}
+	CustomField_Type_value = map[string]int32{
+		"UNKNOWN": 0,
+		"TEXT":    1,
+		"HIDDEN":  2,
+		"BOOLEAN": 3,
+		"URL":     4,
+	}
+)
+
+func (x CustomField_Type) Enum() *CustomField_Type {
+	p := new(CustomField_Type)
+	*p = x
+	return p
+}
+
+func (x CustomField_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CustomField_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_pwdm_server_proto_enumTypes[3].Descriptor()
+}
+
+func (CustomField_Type) Type() protoreflect.EnumType {
+	return &file_proto_pwdm_server_proto_enumTypes[3]
+}
+
+func (x CustomField_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CustomField_Type.Descriptor instead.
+func (CustomField_Type) EnumDescriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{14, 0}
+}
+
+type LoginURI_Match int32
+
+const (
+	LoginURI_BASE_DOMAIN LoginURI_Match = 0 // same registrable domain, any subdomain
+	LoginURI_HOST        LoginURI_Match = 1 // same host and port
+	LoginURI_STARTS_WITH LoginURI_Match = 2 // the page URL starts with the URI
+	LoginURI_EXACT       LoginURI_Match = 3 // the page URL is equal to the URI
+	LoginURI_REGEX       LoginURI_Match = 4 // the page URL matches the regular expression
+)
+
+// Enum value maps for LoginURI_Match.
+var (
+	LoginURI_Match_name = map[int32]string{
+		0: "BASE_DOMAIN",
+		1: "HOST",
+		2: "STARTS_WITH",
+		3: "EXACT",
+		4: "REGEX",
+	}
+	LoginURI_Match_value = map[string]int32{
+		"BASE_DOMAIN": 0,
+		"HOST":        1,
+		"STARTS_WITH": 2,
+		"EXACT":       3,
+		"REGEX":       4,
+	}
+)
+
+func (x LoginURI_Match) Enum() *LoginURI_Match {
+	p := new(LoginURI_Match)
+	*p = x
+	return p
+}
+
+func (x LoginURI_Match) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoginURI_Match) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_pwdm_server_proto_enumTypes[4].Descriptor()
+}
+
+func (LoginURI_Match) Type() protoreflect.EnumType {
+	return &file_proto_pwdm_server_proto_enumTypes[4]
+}
+
+func (x LoginURI_Match) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoginURI_Match.Descriptor instead.
+func (LoginURI_Match) EnumDescriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{29, 0}
+}
+
+// SyncReq - request for changes since the cursor.
+type SyncReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor int64 `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"` // last change sequence known to the client
+	Limit  int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`   // page size
+}
+
+func (x *SyncReq) Reset() {
+	*x = SyncReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncReq) ProtoMessage() {}
+
+func (x *SyncReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncReq.ProtoReflect.Descriptor instead.
+func (*SyncReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SyncReq) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *SyncReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// SyncResp - page of changes.
+type SyncResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*SyncResp_ChangeModel `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	Cursor  int64                   `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"` // cursor for the next page
+	HasMore bool                    `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	Error   string                  `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SyncResp) Reset() {
+	*x = SyncResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncResp) ProtoMessage() {}
+
+func (x *SyncResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncResp.ProtoReflect.Descriptor instead.
+func (*SyncResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SyncResp) GetChanges() []*SyncResp_ChangeModel {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *SyncResp) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *SyncResp) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *SyncResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// WatchReq - request for subscription to changes.
+type WatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor int64 `protobuf:"varint,1,opt,name=cursor,proto3" json:"cursor,omitempty"` // last change sequence known to the client
+}
+
+func (x *WatchReq) Reset() {
+	*x = WatchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchReq) ProtoMessage() {}
+
+func (x *WatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchReq.ProtoReflect.Descriptor instead.
+func (*WatchReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WatchReq) GetCursor() int64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+// WatchEvent - event of the record change.
+type WatchEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event   WatchEvent_EventType `protobuf:"varint,1,opt,name=event,proto3,enum=pwdm.WatchEvent_EventType" json:"event,omitempty"`
+	Seq     int64                `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"` // change sequence number, cursor for resuming
+	Id      int32                `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+	Type    int32                `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
+	Title   string               `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Tag     string               `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`
+	Comment string               `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *WatchEvent) Reset() {
+	*x = WatchEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEvent) ProtoMessage() {}
+
+func (x *WatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEvent.ProtoReflect.Descriptor instead.
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WatchEvent) GetEvent() WatchEvent_EventType {
+	if x != nil {
+		return x.Event
+	}
+	return WatchEvent_UNKNOWN
+}
+
+func (x *WatchEvent) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *WatchEvent) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WatchEvent) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *WatchEvent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// BatchReq - request with operations performed in a single transaction.
+type BatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operations []*BatchReq_Operation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
+}
+
+func (x *BatchReq) Reset() {
+	*x = BatchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReq) ProtoMessage() {}
+
+func (x *BatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReq.ProtoReflect.Descriptor instead.
+func (*BatchReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchReq) GetOperations() []*BatchReq_Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+// BatchResp - results of the operations in the order of the request.
+type BatchResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results   []*BatchResp_ResultModel `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Committed bool                     `protobuf:"varint,2,opt,name=committed,proto3" json:"committed,omitempty"` // false if the batch is rolled back
+	Error     string                   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BatchResp) Reset() {
+	*x = BatchResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResp) ProtoMessage() {}
+
+func (x *BatchResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResp.ProtoReflect.Descriptor instead.
+func (*BatchResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchResp) GetResults() []*BatchResp_ResultModel {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchResp) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *BatchResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ListItemsReq - request for a filtered page of records. Empty filters are not used.
+type ListItemsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Types          []int32                `protobuf:"varint,1,rep,packed,name=types,proto3" json:"types,omitempty"`
+	Tag            string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"` // substring of the title, case insensitive
+	CreatedFrom    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	UpdatedFrom    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_from,json=updatedFrom,proto3" json:"updated_from,omitempty"`
+	UpdatedTo      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_to,json=updatedTo,proto3" json:"updated_to,omitempty"`
+	SortBy         ListItemsReq_SortBy    `protobuf:"varint,8,opt,name=sort_by,json=sortBy,proto3,enum=pwdm.ListItemsReq_SortBy" json:"sort_by,omitempty"`
+	Desc           bool                   `protobuf:"varint,9,opt,name=desc,proto3" json:"desc,omitempty"`
+	Cursor         string                 `protobuf:"bytes,10,opt,name=cursor,proto3" json:"cursor,omitempty"` // next_cursor from the previous page, empty for the first page
+	Limit          int32                  `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
+	Tags           []string               `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`                                            // records with any of the tags, tag is added to them
+	FavoritesFirst bool                   `protobuf:"varint,13,opt,name=favorites_first,json=favoritesFirst,proto3" json:"favorites_first,omitempty"` // favorite records go before the others
+}
+
+func (x *ListItemsReq) Reset() {
+	*x = ListItemsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsReq) ProtoMessage() {}
+
+func (x *ListItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsReq.ProtoReflect.Descriptor instead.
+func (*ListItemsReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListItemsReq) GetTypes() []int32 {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ListItemsReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListItemsReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ListItemsReq) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListItemsReq) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListItemsReq) GetUpdatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedFrom
+	}
+	return nil
+}
+
+func (x *ListItemsReq) GetUpdatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedTo
+	}
+	return nil
+}
+
+func (x *ListItemsReq) GetSortBy() ListItemsReq_SortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return ListItemsReq_TITLE
+}
+
+func (x *ListItemsReq) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *ListItemsReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListItemsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListItemsReq) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListItemsReq) GetFavoritesFirst() bool {
+	if x != nil {
+		return x.FavoritesFirst
+	}
+	return false
+}
+
+// ListItemsResp - page of records.
+type ListItemsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items      []*ListItemsResp_ItemModel `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor string                     `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // empty if it is the last page
+	Error      string                     `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ListItemsResp) Reset() {
+	*x = ListItemsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListItemsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsResp) ProtoMessage() {}
+
+func (x *ListItemsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsResp.ProtoReflect.Descriptor instead.
+func (*ListItemsResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListItemsResp) GetItems() []*ListItemsResp_ItemModel {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListItemsResp) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListItemsResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ListTagsReq - request for all tags of the user.
+type ListTagsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTagsReq) Reset() {
+	*x = ListTagsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsReq) ProtoMessage() {}
+
+func (x *ListTagsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsReq.ProtoReflect.Descriptor instead.
+func (*ListTagsReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{8}
+}
+
+// ListTagsResp - tags with the number of records.
+type ListTagsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags  []*ListTagsResp_TagModel `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Error string                   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ListTagsResp) Reset() {
+	*x = ListTagsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResp) ProtoMessage() {}
+
+func (x *ListTagsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResp.ProtoReflect.Descriptor instead.
+func (*ListTagsResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListTagsResp) GetTags() []*ListTagsResp_TagModel {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListTagsResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// RenameTagReq - request for rename the tag on all records.
+type RenameTagReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NewName string `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+}
+
+func (x *RenameTagReq) Reset() {
+	*x = RenameTagReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameTagReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagReq) ProtoMessage() {}
+
+func (x *RenameTagReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagReq.ProtoReflect.Descriptor instead.
+func (*RenameTagReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RenameTagReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RenameTagReq) GetNewName() string {
+	if x != nil {
+		return x.NewName
+	}
+	return ""
+}
+
+// MergeTagsReq - request for replace the tags with the target tag on all records.
+type MergeTagsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Names  []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	Target string   `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *MergeTagsReq) Reset() {
+	*x = MergeTagsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeTagsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsReq) ProtoMessage() {}
+
+func (x *MergeTagsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsReq.ProtoReflect.Descriptor instead.
+func (*MergeTagsReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MergeTagsReq) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *MergeTagsReq) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+// DeleteTagReq - request for remove the tag from all records.
+type DeleteTagReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteTagReq) Reset() {
+	*x = DeleteTagReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteTagReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTagReq) ProtoMessage() {}
+
+func (x *DeleteTagReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTagReq.ProtoReflect.Descriptor instead.
+func (*DeleteTagReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteTagReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// TagsResp - result of the tag management.
+type TagsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Affected int32  `protobuf:"varint,1,opt,name=affected,proto3" json:"affected,omitempty"` // number of affected records
+	Error    string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *TagsResp) Reset() {
+	*x = TagsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagsResp) ProtoMessage() {}
+
+func (x *TagsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagsResp.ProtoReflect.Descriptor instead.
+func (*TagsResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TagsResp) GetAffected() int32 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+func (x *TagsResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// CustomField - custom field of the record.
+type CustomField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type  CustomField_Type `protobuf:"varint,2,opt,name=type,proto3,enum=pwdm.CustomField_Type" json:"type,omitempty"`
+	Value string           `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *CustomField) Reset() {
+	*x = CustomField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomField) ProtoMessage() {}
+
+func (x *CustomField) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomField.ProtoReflect.Descriptor instead.
+func (*CustomField) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CustomField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CustomField) GetType() CustomField_Type {
+	if x != nil {
+		return x.Type
+	}
+	return CustomField_UNKNOWN
+}
+
+func (x *CustomField) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// CustomFields - ordered list of the custom fields. Get* methods of GiveTakeService
+// send it in the response header "custom-fields-bin".
+type CustomFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []*CustomField `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *CustomFields) Reset() {
+	*x = CustomFields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomFields) ProtoMessage() {}
+
+func (x *CustomFields) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomFields.ProtoReflect.Descriptor instead.
+func (*CustomFields) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CustomFields) GetFields() []*CustomField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// SetCustomFieldsReq - request for replace the custom fields of the record.
+type SetCustomFieldsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int32          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type   int32          `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Fields []*CustomField `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *SetCustomFieldsReq) Reset() {
+	*x = SetCustomFieldsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCustomFieldsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCustomFieldsReq) ProtoMessage() {}
+
+func (x *SetCustomFieldsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCustomFieldsReq.ProtoReflect.Descriptor instead.
+func (*SetCustomFieldsReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetCustomFieldsReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetCustomFieldsReq) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *SetCustomFieldsReq) GetFields() []*CustomField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// CustomFieldsResp - custom fields of the record.
+type CustomFieldsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []*CustomField `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	Error  string         `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *CustomFieldsResp) Reset() {
+	*x = CustomFieldsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomFieldsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomFieldsResp) ProtoMessage() {}
+
+func (x *CustomFieldsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomFieldsResp.ProtoReflect.Descriptor instead.
+func (*CustomFieldsResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CustomFieldsResp) GetFields() []*CustomField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *CustomFieldsResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// SetFavoriteReq - request for mark the record as favorite or remove the mark.
+type SetFavoriteReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type     int32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Favorite bool  `protobuf:"varint,3,opt,name=favorite,proto3" json:"favorite,omitempty"`
+}
+
+func (x *SetFavoriteReq) Reset() {
+	*x = SetFavoriteReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFavoriteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFavoriteReq) ProtoMessage() {}
+
+func (x *SetFavoriteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFavoriteReq.ProtoReflect.Descriptor instead.
+func (*SetFavoriteReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetFavoriteReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetFavoriteReq) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *SetFavoriteReq) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+// SetFavoriteResp - result of the marking.
+type SetFavoriteResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SetFavoriteResp) Reset() {
+	*x = SetFavoriteResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFavoriteResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFavoriteResp) ProtoMessage() {}
+
+func (x *SetFavoriteResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFavoriteResp.ProtoReflect.Descriptor instead.
+func (*SetFavoriteResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetFavoriteResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// FolderModel - folder with the nested folders and records.
+type FolderModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 0 - root folder
+	ParentId int32                      `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Name     string                     `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Folders  []*FolderModel             `protobuf:"bytes,4,rep,name=folders,proto3" json:"folders,omitempty"`
+	Items    []*ListItemsResp_ItemModel `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *FolderModel) Reset() {
+	*x = FolderModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FolderModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolderModel) ProtoMessage() {}
+
+func (x *FolderModel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FolderModel.ProtoReflect.Descriptor instead.
+func (*FolderModel) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FolderModel) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FolderModel) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *FolderModel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FolderModel) GetFolders() []*FolderModel {
+	if x != nil {
+		return x.Folders
+	}
+	return nil
+}
+
+func (x *FolderModel) GetItems() []*ListItemsResp_ItemModel {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// GetFoldersReq - request for the folder contents.
+type GetFoldersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FolderId int32 `protobuf:"varint,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"` // 0 - root folder
+	Tree     bool  `protobuf:"varint,2,opt,name=tree,proto3" json:"tree,omitempty"`                         // all nested folders with their records, otherwise only the direct contents
+}
+
+func (x *GetFoldersReq) Reset() {
+	*x = GetFoldersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFoldersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFoldersReq) ProtoMessage() {}
+
+func (x *GetFoldersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFoldersReq.ProtoReflect.Descriptor instead.
+func (*GetFoldersReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetFoldersReq) GetFolderId() int32 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *GetFoldersReq) GetTree() bool {
+	if x != nil {
+		return x.Tree
+	}
+	return false
+}
+
+// GetFoldersResp - folder contents.
+type GetFoldersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Folder *FolderModel `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	Error  string       `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetFoldersResp) Reset() {
+	*x = GetFoldersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFoldersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFoldersResp) ProtoMessage() {}
+
+func (x *GetFoldersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFoldersResp.ProtoReflect.Descriptor instead.
+func (*GetFoldersResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetFoldersResp) GetFolder() *FolderModel {
+	if x != nil {
+		return x.Folder
+	}
+	return nil
+}
+
+func (x *GetFoldersResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// CreateFolderReq - request for create the folder.
+type CreateFolderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ParentId int32  `protobuf:"varint,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 0 - root folder
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateFolderReq) Reset() {
+	*x = CreateFolderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFolderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFolderReq) ProtoMessage() {}
+
+func (x *CreateFolderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFolderReq.ProtoReflect.Descriptor instead.
+func (*CreateFolderReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateFolderReq) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CreateFolderReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// RenameFolderReq - request for rename the folder.
+type RenameFolderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RenameFolderReq) Reset() {
+	*x = RenameFolderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameFolderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameFolderReq) ProtoMessage() {}
+
+func (x *RenameFolderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameFolderReq.ProtoReflect.Descriptor instead.
+func (*RenameFolderReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RenameFolderReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RenameFolderReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// MoveFolderReq - request for move the folder to another parent folder.
+type MoveFolderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId int32 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 0 - root folder
+}
+
+func (x *MoveFolderReq) Reset() {
+	*x = MoveFolderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveFolderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveFolderReq) ProtoMessage() {}
+
+func (x *MoveFolderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveFolderReq.ProtoReflect.Descriptor instead.
+func (*MoveFolderReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MoveFolderReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MoveFolderReq) GetParentId() int32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+// DeleteFolderReq - request for delete the folder. Records of the folder are moved to the trash.
+type DeleteFolderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteFolderReq) Reset() {
+	*x = DeleteFolderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFolderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFolderReq) ProtoMessage() {}
+
+func (x *DeleteFolderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFolderReq.ProtoReflect.Descriptor instead.
+func (*DeleteFolderReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteFolderReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// MoveItemsReq - request for move the records to the folder.
+type MoveItemsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FolderId int32                     `protobuf:"varint,1,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"` // 0 - root folder
+	Items    []*MoveItemsReq_ItemModel `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *MoveItemsReq) Reset() {
+	*x = MoveItemsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveItemsReq) ProtoMessage() {}
+
+func (x *MoveItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveItemsReq.ProtoReflect.Descriptor instead.
+func (*MoveItemsReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MoveItemsReq) GetFolderId() int32 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *MoveItemsReq) GetItems() []*MoveItemsReq_ItemModel {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// FolderResp - result of the folder management.
+type FolderResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`             // id of the folder
+	Affected int32  `protobuf:"varint,2,opt,name=affected,proto3" json:"affected,omitempty"` // number of affected records
+	Error    string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *FolderResp) Reset() {
+	*x = FolderResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FolderResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FolderResp) ProtoMessage() {}
+
+func (x *FolderResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FolderResp.ProtoReflect.Descriptor instead.
+func (*FolderResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FolderResp) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FolderResp) GetAffected() int32 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+func (x *FolderResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// LoginURI - URI of the login/password record.
+type LoginURI struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri   string         `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Match LoginURI_Match `protobuf:"varint,2,opt,name=match,proto3,enum=pwdm.LoginURI_Match" json:"match,omitempty"`
+}
+
+func (x *LoginURI) Reset() {
+	*x = LoginURI{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginURI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginURI) ProtoMessage() {}
+
+func (x *LoginURI) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginURI.ProtoReflect.Descriptor instead.
+func (*LoginURI) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *LoginURI) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *LoginURI) GetMatch() LoginURI_Match {
+	if x != nil {
+		return x.Match
+	}
+	return LoginURI_BASE_DOMAIN
+}
+
+// SetLoginURIsReq - request for replace the URIs of the login/password record.
+type SetLoginURIsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uris []*LoginURI `protobuf:"bytes,2,rep,name=uris,proto3" json:"uris,omitempty"`
+}
+
+func (x *SetLoginURIsReq) Reset() {
+	*x = SetLoginURIsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLoginURIsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLoginURIsReq) ProtoMessage() {}
+
+func (x *SetLoginURIsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLoginURIsReq.ProtoReflect.Descriptor instead.
+func (*SetLoginURIsReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetLoginURIsReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetLoginURIsReq) GetUris() []*LoginURI {
+	if x != nil {
+		return x.Uris
+	}
+	return nil
+}
+
+// GetLoginURIsReq - request for the URIs of the login/password record.
+type GetLoginURIsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetLoginURIsReq) Reset() {
+	*x = GetLoginURIsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoginURIsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoginURIsReq) ProtoMessage() {}
+
+func (x *GetLoginURIsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoginURIsReq.ProtoReflect.Descriptor instead.
+func (*GetLoginURIsReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetLoginURIsReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// LoginURIsResp - URIs of the login/password record.
+type LoginURIsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uris  []*LoginURI `protobuf:"bytes,1,rep,name=uris,proto3" json:"uris,omitempty"`
+	Error string      `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *LoginURIsResp) Reset() {
+	*x = LoginURIsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoginURIsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginURIsResp) ProtoMessage() {}
+
+func (x *LoginURIsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginURIsResp.ProtoReflect.Descriptor instead.
+func (*LoginURIsResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LoginURIsResp) GetUris() []*LoginURI {
+	if x != nil {
+		return x.Uris
+	}
+	return nil
+}
+
+func (x *LoginURIsResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// LookupByURLReq - request for the login/password records matching the page.
+type LookupByURLReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *LookupByURLReq) Reset() {
+	*x = LookupByURLReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupByURLReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupByURLReq) ProtoMessage() {}
+
+func (x *LookupByURLReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupByURLReq.ProtoReflect.Descriptor instead.
+func (*LookupByURLReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LookupByURLReq) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// LookupByURLResp - login/password records matching the page.
+type LookupByURLResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logins []*LookupByURLResp_LoginModel `protobuf:"bytes,1,rep,name=logins,proto3" json:"logins,omitempty"`
+	Error  string                        `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *LookupByURLResp) Reset() {
+	*x = LookupByURLResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupByURLResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupByURLResp) ProtoMessage() {}
+
+func (x *LookupByURLResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupByURLResp.ProtoReflect.Descriptor instead.
+func (*LookupByURLResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *LookupByURLResp) GetLogins() []*LookupByURLResp_LoginModel {
+	if x != nil {
+		return x.Logins
+	}
+	return nil
+}
+
+func (x *LookupByURLResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// SetTOTPReq - request for set the TOTP secret of the login/password record.
+type SetTOTPReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uri string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"` // otpauth://totp URI or base32 secret, empty to remove the secret
+}
+
+func (x *SetTOTPReq) Reset() {
+	*x = SetTOTPReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTOTPReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTOTPReq) ProtoMessage() {}
+
+func (x *SetTOTPReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTOTPReq.ProtoReflect.Descriptor instead.
+func (*SetTOTPReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SetTOTPReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetTOTPReq) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+// GetTOTPCodeReq - request for the current TOTP code of the login/password record.
+type GetTOTPCodeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetTOTPCodeReq) Reset() {
+	*x = GetTOTPCodeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTOTPCodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTOTPCodeReq) ProtoMessage() {}
+
+func (x *GetTOTPCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTOTPCodeReq.ProtoReflect.Descriptor instead.
+func (*GetTOTPCodeReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetTOTPCodeReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// TOTPResp - current TOTP code.
+type TOTPResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code      string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Remaining int32  `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"` // seconds until the code expires
+	Period    int32  `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`       // seconds
+	Issuer    string `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Account   string `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
+	Error     string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *TOTPResp) Reset() {
+	*x = TOTPResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TOTPResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TOTPResp) ProtoMessage() {}
+
+func (x *TOTPResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TOTPResp.ProtoReflect.Descriptor instead.
+func (*TOTPResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TOTPResp) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *TOTPResp) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *TOTPResp) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *TOTPResp) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *TOTPResp) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *TOTPResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// SSHKeyReq - request for insert or update the SSH key.
+// The public key, key type and fingerprint are derived from the private key.
+type SSHKeyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // for update only
+	Title      string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	PrivateKey string `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	PublicKey  string `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // optional, must belong to the private key
+	Passphrase string `protobuf:"bytes,5,opt,name=passphrase,proto3" json:"passphrase,omitempty"`                // required for the encrypted private key
+	Tag        string `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`
+	Comment    string `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *SSHKeyReq) Reset() {
+	*x = SSHKeyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSHKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHKeyReq) ProtoMessage() {}
+
+func (x *SSHKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHKeyReq.ProtoReflect.Descriptor instead.
+func (*SSHKeyReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SSHKeyReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SSHKeyReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SSHKeyReq) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *SSHKeyReq) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *SSHKeyReq) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *SSHKeyReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *SSHKeyReq) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// GetSSHKeyReq - request for the SSH key.
+type GetSSHKeyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetSSHKeyReq) Reset() {
+	*x = GetSSHKeyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSSHKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSSHKeyReq) ProtoMessage() {}
+
+func (x *GetSSHKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSSHKeyReq.ProtoReflect.Descriptor instead.
+func (*GetSSHKeyReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetSSHKeyReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// SSHKeyResp - SSH key.
+type SSHKeyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int32          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string         `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	PrivateKey  string         `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	PublicKey   string         `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Passphrase  string         `protobuf:"bytes,5,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	KeyType     string         `protobuf:"bytes,6,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
+	Fingerprint string         `protobuf:"bytes,7,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Tag         string         `protobuf:"bytes,8,opt,name=tag,proto3" json:"tag,omitempty"`
+	Comment     string         `protobuf:"bytes,9,opt,name=comment,proto3" json:"comment,omitempty"`
+	Error       string         `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	Fields      []*CustomField `protobuf:"bytes,11,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *SSHKeyResp) Reset() {
+	*x = SSHKeyResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSHKeyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHKeyResp) ProtoMessage() {}
+
+func (x *SSHKeyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHKeyResp.ProtoReflect.Descriptor instead.
+func (*SSHKeyResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SSHKeyResp) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SSHKeyResp) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SSHKeyResp) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *SSHKeyResp) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *SSHKeyResp) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *SSHKeyResp) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+func (x *SSHKeyResp) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *SSHKeyResp) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *SSHKeyResp) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *SSHKeyResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SSHKeyResp) GetFields() []*CustomField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// SSHKeysInfo - public information about the SSH keys of the user.
+// Sent in the GetInfo response header.
+type SSHKeysInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []*SSHKeysInfo_KeyModel `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (x *SSHKeysInfo) Reset() {
+	*x = SSHKeysInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSHKeysInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHKeysInfo) ProtoMessage() {}
+
+func (x *SSHKeysInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHKeysInfo.ProtoReflect.Descriptor instead.
+func (*SSHKeysInfo) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SSHKeysInfo) GetKeys() []*SSHKeysInfo_KeyModel {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// Identity - personal information. Dates are in the YYYY-MM-DD format, empty if not set.
+type Identity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstName      string `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	MiddleName     string `protobuf:"bytes,2,opt,name=middle_name,json=middleName,proto3" json:"middle_name,omitempty"`
+	LastName       string `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Address1       string `protobuf:"bytes,4,opt,name=address1,proto3" json:"address1,omitempty"`
+	Address2       string `protobuf:"bytes,5,opt,name=address2,proto3" json:"address2,omitempty"`
+	City           string `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
+	State          string `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode     string `protobuf:"bytes,8,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Country        string `protobuf:"bytes,9,opt,name=country,proto3" json:"country,omitempty"`
+	Phone          string `protobuf:"bytes,10,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email          string `protobuf:"bytes,11,opt,name=email,proto3" json:"email,omitempty"`
+	PassportNumber string `protobuf:"bytes,12,opt,name=passport_number,json=passportNumber,proto3" json:"passport_number,omitempty"`
+	PassportExpiry string `protobuf:"bytes,13,opt,name=passport_expiry,json=passportExpiry,proto3" json:"passport_expiry,omitempty"`
+	LicenseNumber  string `protobuf:"bytes,14,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"` // driver's license
+	LicenseExpiry  string `protobuf:"bytes,15,opt,name=license_expiry,json=licenseExpiry,proto3" json:"license_expiry,omitempty"`
+	NationalId     string `protobuf:"bytes,16,opt,name=national_id,json=nationalId,proto3" json:"national_id,omitempty"`
+	TaxId          string `protobuf:"bytes,17,opt,name=tax_id,json=taxId,proto3" json:"tax_id,omitempty"`
+}
+
+func (x *Identity) Reset() {
+	*x = Identity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Identity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Identity) ProtoMessage() {}
+
+func (x *Identity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Identity.ProtoReflect.Descriptor instead.
+func (*Identity) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Identity) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Identity) GetMiddleName() string {
+	if x != nil {
+		return x.MiddleName
+	}
+	return ""
+}
+
+func (x *Identity) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Identity) GetAddress1() string {
+	if x != nil {
+		return x.Address1
+	}
+	return ""
+}
+
+func (x *Identity) GetAddress2() string {
+	if x != nil {
+		return x.Address2
+	}
+	return ""
+}
+
+func (x *Identity) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Identity) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Identity) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Identity) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Identity) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Identity) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Identity) GetPassportNumber() string {
+	if x != nil {
+		return x.PassportNumber
+	}
+	return ""
+}
+
+func (x *Identity) GetPassportExpiry() string {
+	if x != nil {
+		return x.PassportExpiry
+	}
+	return ""
+}
+
+func (x *Identity) GetLicenseNumber() string {
+	if x != nil {
+		return x.LicenseNumber
+	}
+	return ""
+}
+
+func (x *Identity) GetLicenseExpiry() string {
+	if x != nil {
+		return x.LicenseExpiry
+	}
+	return ""
+}
+
+func (x *Identity) GetNationalId() string {
+	if x != nil {
+		return x.NationalId
+	}
+	return ""
+}
+
+func (x *Identity) GetTaxId() string {
+	if x != nil {
+		return x.TaxId
+	}
+	return ""
+}
+
+// IdentityReq - request for insert or update the identity.
+type IdentityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // for update only
+	Title    string    `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Identity *Identity `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	Tag      string    `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	Comment  string    `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *IdentityReq) Reset() {
+	*x = IdentityReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdentityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityReq) ProtoMessage() {}
+
+func (x *IdentityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityReq.ProtoReflect.Descriptor instead.
+func (*IdentityReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *IdentityReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *IdentityReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *IdentityReq) GetIdentity() *Identity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *IdentityReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *IdentityReq) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// GetIdentityReq - request for the identity.
+type GetIdentityReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetIdentityReq) Reset() {
+	*x = GetIdentityReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIdentityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdentityReq) ProtoMessage() {}
+
+func (x *GetIdentityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdentityReq.ProtoReflect.Descriptor instead.
+func (*GetIdentityReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetIdentityReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// IdentityResp - identity.
+type IdentityResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title    string         `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Identity *Identity      `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	Tag      string         `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	Comment  string         `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	Error    string         `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Fields   []*CustomField `protobuf:"bytes,7,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *IdentityResp) Reset() {
+	*x = IdentityResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdentityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityResp) ProtoMessage() {}
+
+func (x *IdentityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityResp.ProtoReflect.Descriptor instead.
+func (*IdentityResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *IdentityResp) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *IdentityResp) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *IdentityResp) GetIdentity() *Identity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *IdentityResp) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *IdentityResp) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *IdentityResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *IdentityResp) GetFields() []*CustomField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// CardsInfo - brands and masked numbers of the cards of the user.
+// Sent in the GetInfo response header.
+type CardsInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cards []*CardsInfo_CardModel `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+}
+
+func (x *CardsInfo) Reset() {
+	*x = CardsInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardsInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardsInfo) ProtoMessage() {}
+
+func (x *CardsInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardsInfo.ProtoReflect.Descriptor instead.
+func (*CardsInfo) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CardsInfo) GetCards() []*CardsInfo_CardModel {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+// SetExpiryReq - request for set the expiry date of the record.
+// The expiry date of the card is derived from its validity period and cannot be set.
+type SetExpiryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type      int32  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	ExpiresAt string `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // YYYY-MM-DD, empty to remove the expiry date
+}
+
+func (x *SetExpiryReq) Reset() {
+	*x = SetExpiryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetExpiryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExpiryReq) ProtoMessage() {}
+
+func (x *SetExpiryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExpiryReq.ProtoReflect.Descriptor instead.
+func (*SetExpiryReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetExpiryReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetExpiryReq) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *SetExpiryReq) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// SetExpiryResp - result of setting the expiry date.
+type SetExpiryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SetExpiryResp) Reset() {
+	*x = SetExpiryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetExpiryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExpiryResp) ProtoMessage() {}
+
+func (x *SetExpiryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetExpiryResp.ProtoReflect.Descriptor instead.
+func (*SetExpiryResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SetExpiryResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ExpiringItemsReq - request for the records expiring within the horizon, including the expired ones.
+type ExpiringItemsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HorizonDays int32 `protobuf:"varint,1,opt,name=horizon_days,json=horizonDays,proto3" json:"horizon_days,omitempty"` // 0 - 30 days
+}
+
+func (x *ExpiringItemsReq) Reset() {
+	*x = ExpiringItemsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpiringItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpiringItemsReq) ProtoMessage() {}
+
+func (x *ExpiringItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpiringItemsReq.ProtoReflect.Descriptor instead.
+func (*ExpiringItemsReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ExpiringItemsReq) GetHorizonDays() int32 {
+	if x != nil {
+		return x.HorizonDays
+	}
+	return 0
+}
+
+// ExpiringItemsResp - records sorted by the expiry date.
+type ExpiringItemsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*ExpiringItemsResp_ItemModel `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Error string                         `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ExpiringItemsResp) Reset() {
+	*x = ExpiringItemsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpiringItemsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpiringItemsResp) ProtoMessage() {}
+
+func (x *ExpiringItemsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpiringItemsResp.ProtoReflect.Descriptor instead.
+func (*ExpiringItemsResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ExpiringItemsResp) GetItems() []*ExpiringItemsResp_ItemModel {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ExpiringItemsResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// BinaryChunk - part of the binary data.
+type BinaryChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset int64  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"` // position of the chunk in the data
+	Data   []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`      // at most 1 MiB
+	Crc32  uint32 `protobuf:"varint,3,opt,name=crc32,proto3" json:"crc32,omitempty"`   // CRC-32 (IEEE) of the chunk data
+}
+
+func (x *BinaryChunk) Reset() {
+	*x = BinaryChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BinaryChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryChunk) ProtoMessage() {}
+
+func (x *BinaryChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinaryChunk.ProtoReflect.Descriptor instead.
+func (*BinaryChunk) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BinaryChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *BinaryChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BinaryChunk) GetCrc32() uint32 {
+	if x != nil {
+		return x.Crc32
+	}
+	return 0
+}
+
+// UploadBinaryReq - message of the upload stream. The first message is the header, the others are the chunks.
+type UploadBinaryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Msg:
+	//	*UploadBinaryReq_Header_
+	//	*UploadBinaryReq_Chunk
+	Msg isUploadBinaryReq_Msg `protobuf_oneof:"msg"`
+}
+
+func (x *UploadBinaryReq) Reset() {
+	*x = UploadBinaryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadBinaryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadBinaryReq) ProtoMessage() {}
+
+func (x *UploadBinaryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadBinaryReq.ProtoReflect.Descriptor instead.
+func (*UploadBinaryReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{52}
+}
+
+func (m *UploadBinaryReq) GetMsg() isUploadBinaryReq_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (x *UploadBinaryReq) GetHeader() *UploadBinaryReq_Header {
+	if x, ok := x.GetMsg().(*UploadBinaryReq_Header_); ok {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *UploadBinaryReq) GetChunk() *BinaryChunk {
+	if x, ok := x.GetMsg().(*UploadBinaryReq_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isUploadBinaryReq_Msg interface {
+	isUploadBinaryReq_Msg()
+}
+
+type UploadBinaryReq_Header_ struct {
+	Header *UploadBinaryReq_Header `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type UploadBinaryReq_Chunk struct {
+	Chunk *BinaryChunk `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadBinaryReq_Header_) isUploadBinaryReq_Msg() {}
+
+func (*UploadBinaryReq_Chunk) isUploadBinaryReq_Msg() {}
+
+// UploadBinaryResp - state of the upload.
+type UploadBinaryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UploadId string `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	Offset   int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` // number of the received bytes, the upload is resumed from this offset
+	Done     bool   `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`     // the data is saved to the record
+	Id       int32  `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`         // id of the record, if done
+	Title    string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Error    string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *UploadBinaryResp) Reset() {
+	*x = UploadBinaryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadBinaryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadBinaryResp) ProtoMessage() {}
+
+func (x *UploadBinaryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadBinaryResp.ProtoReflect.Descriptor instead.
+func (*UploadBinaryResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UploadBinaryResp) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadBinaryResp) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *UploadBinaryResp) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *UploadBinaryResp) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UploadBinaryResp) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UploadBinaryResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// UploadStatusReq - request for the state of the upload.
+type UploadStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UploadId string `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+}
+
+func (x *UploadStatusReq) Reset() {
+	*x = UploadStatusReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadStatusReq) ProtoMessage() {}
+
+func (x *UploadStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadStatusReq.ProtoReflect.Descriptor instead.
+func (*UploadStatusReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UploadStatusReq) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+// DownloadBinaryReq - request for the binary data starting at the offset.
+type DownloadBinaryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *DownloadBinaryReq) Reset() {
+	*x = DownloadBinaryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadBinaryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadBinaryReq) ProtoMessage() {}
+
+func (x *DownloadBinaryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadBinaryReq.ProtoReflect.Descriptor instead.
+func (*DownloadBinaryReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DownloadBinaryReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DownloadBinaryReq) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// DownloadBinaryResp - message of the download stream. The first message is the header, the others are the chunks.
+type DownloadBinaryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Msg:
+	//	*DownloadBinaryResp_Header_
+	//	*DownloadBinaryResp_Chunk
+	Msg isDownloadBinaryResp_Msg `protobuf_oneof:"msg"`
+}
+
+func (x *DownloadBinaryResp) Reset() {
+	*x = DownloadBinaryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadBinaryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadBinaryResp) ProtoMessage() {}
+
+func (x *DownloadBinaryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadBinaryResp.ProtoReflect.Descriptor instead.
+func (*DownloadBinaryResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{56}
+}
+
+func (m *DownloadBinaryResp) GetMsg() isDownloadBinaryResp_Msg {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (x *DownloadBinaryResp) GetHeader() *DownloadBinaryResp_Header {
+	if x, ok := x.GetMsg().(*DownloadBinaryResp_Header_); ok {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *DownloadBinaryResp) GetChunk() *BinaryChunk {
+	if x, ok := x.GetMsg().(*DownloadBinaryResp_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isDownloadBinaryResp_Msg interface {
+	isDownloadBinaryResp_Msg()
+}
+
+type DownloadBinaryResp_Header_ struct {
+	Header *DownloadBinaryResp_Header `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type DownloadBinaryResp_Chunk struct {
+	Chunk *BinaryChunk `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*DownloadBinaryResp_Header_) isDownloadBinaryResp_Msg() {}
+
+func (*DownloadBinaryResp_Chunk) isDownloadBinaryResp_Msg() {}
+
+// AttachmentModel - file attached to the record.
+type AttachmentModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type      int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`                   // type of the record
+	ItemId    int32                  `protobuf:"varint,3,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"` // id of the record
+	Filename  string                 `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	MimeType  string                 `protobuf:"bytes,5,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Size      int64                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256    string                 `protobuf:"bytes,7,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AttachmentModel) Reset() {
+	*x = AttachmentModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachmentModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentModel) ProtoMessage() {}
+
+func (x *AttachmentModel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentModel.ProtoReflect.Descriptor instead.
+func (*AttachmentModel) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AttachmentModel) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AttachmentModel) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *AttachmentModel) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *AttachmentModel) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *AttachmentModel) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *AttachmentModel) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AttachmentModel) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *AttachmentModel) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListAttachmentsReq - request for the attachments of the record.
+type ListAttachmentsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type int32 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id   int32 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ListAttachmentsReq) Reset() {
+	*x = ListAttachmentsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAttachmentsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsReq) ProtoMessage() {}
+
+func (x *ListAttachmentsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsReq.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListAttachmentsReq) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ListAttachmentsReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ListAttachmentsResp - attachments of the record.
+type ListAttachmentsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attachments []*AttachmentModel `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Error       string             `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ListAttachmentsResp) Reset() {
+	*x = ListAttachmentsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAttachmentsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsResp) ProtoMessage() {}
+
+func (x *ListAttachmentsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsResp.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListAttachmentsResp) GetAttachments() []*AttachmentModel {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *ListAttachmentsResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// DownloadAttachmentReq - request for the attachment starting at the offset.
+type DownloadAttachmentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // id of the attachment
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *DownloadAttachmentReq) Reset() {
+	*x = DownloadAttachmentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadAttachmentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentReq) ProtoMessage() {}
+
+func (x *DownloadAttachmentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentReq.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DownloadAttachmentReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DownloadAttachmentReq) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// RemoveAttachmentReq - request for removing the attachment.
+type RemoveAttachmentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // id of the attachment
+}
+
+func (x *RemoveAttachmentReq) Reset() {
+	*x = RemoveAttachmentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveAttachmentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAttachmentReq) ProtoMessage() {}
+
+func (x *RemoveAttachmentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAttachmentReq.ProtoReflect.Descriptor instead.
+func (*RemoveAttachmentReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RemoveAttachmentReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// RemoveAttachmentResp - response to removing the attachment.
+type RemoveAttachmentResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *RemoveAttachmentResp) Reset() {
+	*x = RemoveAttachmentResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveAttachmentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAttachmentResp) ProtoMessage() {}
+
+func (x *RemoveAttachmentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAttachmentResp.ProtoReflect.Descriptor instead.
+func (*RemoveAttachmentResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RemoveAttachmentResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// UsageReq - request for the storage usage of the current user.
+type UsageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UsageReq) Reset() {
+	*x = UsageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageReq) ProtoMessage() {}
+
+func (x *UsageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageReq.ProtoReflect.Descriptor instead.
+func (*UsageReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{63}
+}
+
+// SizeModel - logical size of the data and its size in the storage after the compression.
+type SizeModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logical int64 `protobuf:"varint,1,opt,name=logical,proto3" json:"logical,omitempty"`
+	Stored  int64 `protobuf:"varint,2,opt,name=stored,proto3" json:"stored,omitempty"`
+}
+
+func (x *SizeModel) Reset() {
+	*x = SizeModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SizeModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SizeModel) ProtoMessage() {}
+
+func (x *SizeModel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SizeModel.ProtoReflect.Descriptor instead.
+func (*SizeModel) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SizeModel) GetLogical() int64 {
+	if x != nil {
+		return x.Logical
+	}
+	return 0
+}
+
+func (x *SizeModel) GetStored() int64 {
+	if x != nil {
+		return x.Stored
+	}
+	return 0
+}
+
+// UsageResp - storage usage of the current user in bytes.
+type UsageResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text        *SizeModel `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Binary      *SizeModel `protobuf:"bytes,2,opt,name=binary,proto3" json:"binary,omitempty"`
+	Attachments *SizeModel `protobuf:"bytes,3,opt,name=attachments,proto3" json:"attachments,omitempty"`
+	Total       *SizeModel `protobuf:"bytes,4,opt,name=total,proto3" json:"total,omitempty"`
+	Quota       int64      `protobuf:"varint,5,opt,name=quota,proto3" json:"quota,omitempty"` // 0 - unlimited, counted by the logical size of the binary data and the attachments
+	Error       string     `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *UsageResp) Reset() {
+	*x = UsageResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageResp) ProtoMessage() {}
+
+func (x *UsageResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageResp.ProtoReflect.Descriptor instead.
+func (*UsageResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UsageResp) GetText() *SizeModel {
+	if x != nil {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *UsageResp) GetBinary() *SizeModel {
+	if x != nil {
+		return x.Binary
+	}
+	return nil
+}
+
+func (x *UsageResp) GetAttachments() *SizeModel {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *UsageResp) GetTotal() *SizeModel {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *UsageResp) GetQuota() int64 {
+	if x != nil {
+		return x.Quota
+	}
+	return 0
+}
+
+func (x *UsageResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// VaultParams - parameters of the key derivation. The client derives the vault key from the master password
+// with them, the server keeps them for the other devices of the user and never sees the key.
+type VaultParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kdf         string `protobuf:"bytes,1,opt,name=kdf,proto3" json:"kdf,omitempty"` // pbkdf2-sha256 or argon2id
+	Salt        []byte `protobuf:"bytes,2,opt,name=salt,proto3" json:"salt,omitempty"`
+	Iterations  int32  `protobuf:"varint,3,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	Memory      int32  `protobuf:"varint,4,opt,name=memory,proto3" json:"memory,omitempty"`           // KiB, argon2id only
+	Parallelism int32  `protobuf:"varint,5,opt,name=parallelism,proto3" json:"parallelism,omitempty"` // argon2id only
+}
+
+func (x *VaultParams) Reset() {
+	*x = VaultParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultParams) ProtoMessage() {}
+
+func (x *VaultParams) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultParams.ProtoReflect.Descriptor instead.
+func (*VaultParams) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *VaultParams) GetKdf() string {
+	if x != nil {
+		return x.Kdf
+	}
+	return ""
+}
+
+func (x *VaultParams) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+func (x *VaultParams) GetIterations() int32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *VaultParams) GetMemory() int32 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *VaultParams) GetParallelism() int32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+// SetupVaultReq - request for switching the current user to the vault mode.
+type SetupVaultReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params *VaultParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *SetupVaultReq) Reset() {
+	*x = SetupVaultReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetupVaultReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetupVaultReq) ProtoMessage() {}
+
+func (x *SetupVaultReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetupVaultReq.ProtoReflect.Descriptor instead.
+func (*SetupVaultReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SetupVaultReq) GetParams() *VaultParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// SetupVaultResp - result of the setup.
+type SetupVaultResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SetupVaultResp) Reset() {
+	*x = SetupVaultResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetupVaultResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetupVaultResp) ProtoMessage() {}
+
+func (x *SetupVaultResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetupVaultResp.ProtoReflect.Descriptor instead.
+func (*SetupVaultResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SetupVaultResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// GetVaultParamsReq - request for the key derivation parameters of the current user.
+type GetVaultParamsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetVaultParamsReq) Reset() {
+	*x = GetVaultParamsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVaultParamsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVaultParamsReq) ProtoMessage() {}
+
+func (x *GetVaultParamsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVaultParamsReq.ProtoReflect.Descriptor instead.
+func (*GetVaultParamsReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{69}
+}
+
+// GetVaultParamsResp - key derivation parameters of the current user.
+type GetVaultParamsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params *VaultParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Error  string       `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetVaultParamsResp) Reset() {
+	*x = GetVaultParamsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVaultParamsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVaultParamsResp) ProtoMessage() {}
+
+func (x *GetVaultParamsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVaultParamsResp.ProtoReflect.Descriptor instead.
+func (*GetVaultParamsResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetVaultParamsResp) GetParams() *VaultParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GetVaultParamsResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// VaultItem - item encrypted by the client. The blob is stored as is,
+// the metadata is the plain data the client chooses to expose for the search.
+type VaultItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Blob      []byte                 `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	Metadata  map[string]string      `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Revision  int64                  `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	Deleted   bool                   `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"` // the blob and the metadata of the deleted item are empty
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *VaultItem) Reset() {
+	*x = VaultItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultItem) ProtoMessage() {}
+
+func (x *VaultItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultItem.ProtoReflect.Descriptor instead.
+func (*VaultItem) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *VaultItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VaultItem) GetBlob() []byte {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+func (x *VaultItem) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *VaultItem) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *VaultItem) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *VaultItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *VaultItem) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// PutVaultItemReq - request for saving the item.
+type PutVaultItemReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 0 - the new item
+	Blob     []byte            `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	Metadata map[string]string `protobuf:"bytes,3,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Revision int64             `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"` // revision of the item known to the client, the item changed since it is not overwritten
+}
+
+func (x *PutVaultItemReq) Reset() {
+	*x = PutVaultItemReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutVaultItemReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutVaultItemReq) ProtoMessage() {}
+
+func (x *PutVaultItemReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutVaultItemReq.ProtoReflect.Descriptor instead.
+func (*PutVaultItemReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PutVaultItemReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PutVaultItemReq) GetBlob() []byte {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+func (x *PutVaultItemReq) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PutVaultItemReq) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// PutVaultItemResp - id and new revision of the saved item.
+type PutVaultItemResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revision int64  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Error    string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *PutVaultItemResp) Reset() {
+	*x = PutVaultItemResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutVaultItemResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutVaultItemResp) ProtoMessage() {}
+
+func (x *PutVaultItemResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutVaultItemResp.ProtoReflect.Descriptor instead.
+func (*PutVaultItemResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *PutVaultItemResp) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PutVaultItemResp) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *PutVaultItemResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// GetVaultItemReq - request for the item.
+type GetVaultItemReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetVaultItemReq) Reset() {
+	*x = GetVaultItemReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVaultItemReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVaultItemReq) ProtoMessage() {}
+
+func (x *GetVaultItemReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVaultItemReq.ProtoReflect.Descriptor instead.
+func (*GetVaultItemReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetVaultItemReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// GetVaultItemResp - the item.
+type GetVaultItemResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item  *VaultItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	Error string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GetVaultItemResp) Reset() {
+	*x = GetVaultItemResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVaultItemResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVaultItemResp) ProtoMessage() {}
+
+func (x *GetVaultItemResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVaultItemResp.ProtoReflect.Descriptor instead.
+func (*GetVaultItemResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetVaultItemResp) GetItem() *VaultItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *GetVaultItemResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// DeleteVaultItemReq - request for deleting the item.
+type DeleteVaultItemReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"` // revision of the item known to the client
+}
+
+func (x *DeleteVaultItemReq) Reset() {
+	*x = DeleteVaultItemReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteVaultItemReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVaultItemReq) ProtoMessage() {}
+
+func (x *DeleteVaultItemReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVaultItemReq.ProtoReflect.Descriptor instead.
+func (*DeleteVaultItemReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DeleteVaultItemReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteVaultItemReq) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+// DeleteVaultItemResp - revision of the deleted item.
+type DeleteVaultItemResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision int64  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Error    string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *DeleteVaultItemResp) Reset() {
+	*x = DeleteVaultItemResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteVaultItemResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVaultItemResp) ProtoMessage() {}
+
+func (x *DeleteVaultItemResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVaultItemResp.ProtoReflect.Descriptor instead.
+func (*DeleteVaultItemResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DeleteVaultItemResp) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *DeleteVaultItemResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ListVaultItemsReq - request for the items changed since the revision.
+type ListVaultItemsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Since int64             `protobuf:"varint,1,opt,name=since,proto3" json:"since,omitempty"`                                                                                        // last revision known to the client
+	Limit int32             `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                                                                        // page size
+	Match map[string]string `protobuf:"bytes,3,rep,name=match,proto3" json:"match,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // only the items with this metadata, the deleted items are not returned
+}
+
+func (x *ListVaultItemsReq) Reset() {
+	*x = ListVaultItemsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVaultItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVaultItemsReq) ProtoMessage() {}
+
+func (x *ListVaultItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVaultItemsReq.ProtoReflect.Descriptor instead.
+func (*ListVaultItemsReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListVaultItemsReq) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListVaultItemsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListVaultItemsReq) GetMatch() map[string]string {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+// ListVaultItemsResp - page of the items ordered by the revision.
+type ListVaultItemsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items    []*VaultItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Revision int64        `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"` // revision of the last item, the cursor of the next page
+	HasMore  bool         `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	Error    string       `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ListVaultItemsResp) Reset() {
+	*x = ListVaultItemsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVaultItemsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVaultItemsResp) ProtoMessage() {}
+
+func (x *ListVaultItemsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVaultItemsResp.ProtoReflect.Descriptor instead.
+func (*ListVaultItemsResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListVaultItemsResp) GetItems() []*VaultItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListVaultItemsResp) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ListVaultItemsResp) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListVaultItemsResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// SRPRegisterReq - request for creating the user with the SRP login. The client computes the verifier
+// of the password as described in the srptools package, the password is not sent.
+type SRPRegisterReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login    string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Salt     []byte `protobuf:"bytes,2,opt,name=salt,proto3" json:"salt,omitempty"`
+	Verifier []byte `protobuf:"bytes,3,opt,name=verifier,proto3" json:"verifier,omitempty"`
+}
+
+func (x *SRPRegisterReq) Reset() {
+	*x = SRPRegisterReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRPRegisterReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRPRegisterReq) ProtoMessage() {}
+
+func (x *SRPRegisterReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRPRegisterReq.ProtoReflect.Descriptor instead.
+func (*SRPRegisterReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SRPRegisterReq) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *SRPRegisterReq) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+func (x *SRPRegisterReq) GetVerifier() []byte {
+	if x != nil {
+		return x.Verifier
+	}
+	return nil
+}
+
+// SRPRegisterResp - token of the new user.
+type SRPRegisterResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SRPRegisterResp) Reset() {
+	*x = SRPRegisterResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRPRegisterResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRPRegisterResp) ProtoMessage() {}
+
+func (x *SRPRegisterResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRPRegisterResp.ProtoReflect.Descriptor instead.
+func (*SRPRegisterResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SRPRegisterResp) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SRPRegisterResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// SRPStartReq - first step of the login: the login and the public ephemeral value of the client A.
+type SRPStartReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login        string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	ClientPublic []byte `protobuf:"bytes,2,opt,name=client_public,json=clientPublic,proto3" json:"client_public,omitempty"`
+}
+
+func (x *SRPStartReq) Reset() {
+	*x = SRPStartReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRPStartReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRPStartReq) ProtoMessage() {}
+
+func (x *SRPStartReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRPStartReq.ProtoReflect.Descriptor instead.
+func (*SRPStartReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SRPStartReq) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *SRPStartReq) GetClientPublic() []byte {
+	if x != nil {
+		return x.ClientPublic
+	}
+	return nil
+}
+
+// SRPStartResp - salt of the user and the public ephemeral value of the server B.
+type SRPStartResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId    string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Salt         []byte `protobuf:"bytes,2,opt,name=salt,proto3" json:"salt,omitempty"`
+	ServerPublic []byte `protobuf:"bytes,3,opt,name=server_public,json=serverPublic,proto3" json:"server_public,omitempty"`
+	Error        string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SRPStartResp) Reset() {
+	*x = SRPStartResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRPStartResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRPStartResp) ProtoMessage() {}
+
+func (x *SRPStartResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRPStartResp.ProtoReflect.Descriptor instead.
+func (*SRPStartResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SRPStartResp) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SRPStartResp) GetSalt() []byte {
+	if x != nil {
+		return x.Salt
+	}
+	return nil
+}
+
+func (x *SRPStartResp) GetServerPublic() []byte {
+	if x != nil {
+		return x.ServerPublic
+	}
+	return nil
+}
+
+func (x *SRPStartResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// SRPFinishReq - second step of the login: the proof of the client M1.
+type SRPFinishReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId   string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ClientProof []byte `protobuf:"bytes,2,opt,name=client_proof,json=clientProof,proto3" json:"client_proof,omitempty"`
+}
+
+func (x *SRPFinishReq) Reset() {
+	*x = SRPFinishReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRPFinishReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRPFinishReq) ProtoMessage() {}
+
+func (x *SRPFinishReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRPFinishReq.ProtoReflect.Descriptor instead.
+func (*SRPFinishReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SRPFinishReq) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SRPFinishReq) GetClientProof() []byte {
+	if x != nil {
+		return x.ClientProof
+	}
+	return nil
+}
+
+// SRPFinishResp - proof of the server M2 and the token. The client checks M2 before using the token.
+type SRPFinishResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerProof []byte `protobuf:"bytes,1,opt,name=server_proof,json=serverProof,proto3" json:"server_proof,omitempty"`
+	Token       string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Error       string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *SRPFinishResp) Reset() {
+	*x = SRPFinishResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SRPFinishResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SRPFinishResp) ProtoMessage() {}
+
+func (x *SRPFinishResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SRPFinishResp.ProtoReflect.Descriptor instead.
+func (*SRPFinishResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SRPFinishResp) GetServerProof() []byte {
+	if x != nil {
+		return x.ServerProof
+	}
+	return nil
+}
+
+func (x *SRPFinishResp) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SRPFinishResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// KeyPair - public key of the user and its private key encrypted by the client with the vault key.
+type KeyPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Algorithm           string `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"` // x25519 (raw 32 bytes) or rsa-oaep-sha256 (DER PKIX, at least 2048 bits)
+	PublicKey           []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	EncryptedPrivateKey []byte `protobuf:"bytes,3,opt,name=encrypted_private_key,json=encryptedPrivateKey,proto3" json:"encrypted_private_key,omitempty"` // empty for the keys of the other users
+	Fingerprint         string `protobuf:"bytes,4,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`                                              // hex SHA-256 of the public key, set by the server
+}
+
+func (x *KeyPair) Reset() {
+	*x = KeyPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyPair) ProtoMessage() {}
+
+func (x *KeyPair) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyPair.ProtoReflect.Descriptor instead.
+func (*KeyPair) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *KeyPair) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *KeyPair) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *KeyPair) GetEncryptedPrivateKey() []byte {
+	if x != nil {
+		return x.EncryptedPrivateKey
+	}
+	return nil
+}
+
+func (x *KeyPair) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+// SetKeyPairReq - request for publishing the key pair of the current user.
+type SetKeyPairReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyPair *KeyPair `protobuf:"bytes,1,opt,name=key_pair,json=keyPair,proto3" json:"key_pair,omitempty"`
+}
+
+func (x *SetKeyPairReq) Reset() {
+	*x = SetKeyPairReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetKeyPairReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetKeyPairReq) ProtoMessage() {}
+
+func (x *SetKeyPairReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetKeyPairReq.ProtoReflect.Descriptor instead.
+func (*SetKeyPairReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SetKeyPairReq) GetKeyPair() *KeyPair {
+	if x != nil {
+		return x.KeyPair
+	}
+	return nil
+}
+
+// GetKeyPairReq - request for the key pair of the current user.
+type GetKeyPairReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetKeyPairReq) Reset() {
+	*x = GetKeyPairReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetKeyPairReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKeyPairReq) ProtoMessage() {}
+
+func (x *GetKeyPairReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKeyPairReq.ProtoReflect.Descriptor instead.
+func (*GetKeyPairReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{88}
+}
+
+// GetPublicKeyReq - request for the public key of the user.
+type GetPublicKeyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (x *GetPublicKeyReq) Reset() {
+	*x = GetPublicKeyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPublicKeyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicKeyReq) ProtoMessage() {}
+
+func (x *GetPublicKeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicKeyReq.ProtoReflect.Descriptor instead.
+func (*GetPublicKeyReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetPublicKeyReq) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+// KeyPairResp - key pair.
+type KeyPairResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyPair *KeyPair `protobuf:"bytes,1,opt,name=key_pair,json=keyPair,proto3" json:"key_pair,omitempty"`
+	Error   string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *KeyPairResp) Reset() {
+	*x = KeyPairResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyPairResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyPairResp) ProtoMessage() {}
+
+func (x *KeyPairResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyPairResp.ProtoReflect.Descriptor instead.
+func (*KeyPairResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *KeyPairResp) GetKeyPair() *KeyPair {
+	if x != nil {
+		return x.KeyPair
+	}
+	return nil
+}
+
+func (x *KeyPairResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ShareItemReq - request for sharing the vault item. The key of the item is wrapped by the client
+// with the public key of the recipient, the fingerprint is the fingerprint of this key.
+type ShareItemReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId         int32  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	RecipientLogin string `protobuf:"bytes,2,opt,name=recipient_login,json=recipientLogin,proto3" json:"recipient_login,omitempty"`
+	WrappedKey     []byte `protobuf:"bytes,3,opt,name=wrapped_key,json=wrappedKey,proto3" json:"wrapped_key,omitempty"`
+	KeyFingerprint string `protobuf:"bytes,4,opt,name=key_fingerprint,json=keyFingerprint,proto3" json:"key_fingerprint,omitempty"`
+	Permission     int32  `protobuf:"varint,5,opt,name=permission,proto3" json:"permission,omitempty"` // 1 - read-only, 2 - read-write
+}
+
+func (x *ShareItemReq) Reset() {
+	*x = ShareItemReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareItemReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareItemReq) ProtoMessage() {}
+
+func (x *ShareItemReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareItemReq.ProtoReflect.Descriptor instead.
+func (*ShareItemReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ShareItemReq) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *ShareItemReq) GetRecipientLogin() string {
+	if x != nil {
+		return x.RecipientLogin
+	}
+	return ""
+}
+
+func (x *ShareItemReq) GetWrappedKey() []byte {
+	if x != nil {
+		return x.WrappedKey
+	}
+	return nil
+}
+
+func (x *ShareItemReq) GetKeyFingerprint() string {
+	if x != nil {
+		return x.KeyFingerprint
+	}
+	return ""
+}
+
+func (x *ShareItemReq) GetPermission() int32 {
+	if x != nil {
+		return x.Permission
+	}
+	return 0
+}
+
+// ShareItemResp - result of the sharing.
+type ShareItemResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ShareItemResp) Reset() {
+	*x = ShareItemResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareItemResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareItemResp) ProtoMessage() {}
+
+func (x *ShareItemResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareItemResp.ProtoReflect.Descriptor instead.
+func (*ShareItemResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ShareItemResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ListSharedWithMeReq - request for the vault items shared with the current user.
+type ListSharedWithMeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSharedWithMeReq) Reset() {
+	*x = ListSharedWithMeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSharedWithMeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedWithMeReq) ProtoMessage() {}
+
+func (x *ListSharedWithMeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedWithMeReq.ProtoReflect.Descriptor instead.
+func (*ListSharedWithMeReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{93}
+}
+
+// SharedItem - vault item shared with the current user and its key wrapped with the public key of the user.
+type SharedItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item           *VaultItem             `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	OwnerLogin     string                 `protobuf:"bytes,2,opt,name=owner_login,json=ownerLogin,proto3" json:"owner_login,omitempty"`
+	WrappedKey     []byte                 `protobuf:"bytes,3,opt,name=wrapped_key,json=wrappedKey,proto3" json:"wrapped_key,omitempty"`
+	KeyFingerprint string                 `protobuf:"bytes,4,opt,name=key_fingerprint,json=keyFingerprint,proto3" json:"key_fingerprint,omitempty"` // differs from the fingerprint of the current key pair if the key pair was replaced
+	Permission     int32                  `protobuf:"varint,5,opt,name=permission,proto3" json:"permission,omitempty"`
+	SharedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=shared_at,json=sharedAt,proto3" json:"shared_at,omitempty"`
+}
+
+func (x *SharedItem) Reset() {
+	*x = SharedItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SharedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SharedItem) ProtoMessage() {}
+
+func (x *SharedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SharedItem.ProtoReflect.Descriptor instead.
+func (*SharedItem) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SharedItem) GetItem() *VaultItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *SharedItem) GetOwnerLogin() string {
+	if x != nil {
+		return x.OwnerLogin
+	}
+	return ""
+}
+
+func (x *SharedItem) GetWrappedKey() []byte {
+	if x != nil {
+		return x.WrappedKey
+	}
+	return nil
+}
+
+func (x *SharedItem) GetKeyFingerprint() string {
+	if x != nil {
+		return x.KeyFingerprint
+	}
+	return ""
+}
+
+func (x *SharedItem) GetPermission() int32 {
+	if x != nil {
+		return x.Permission
+	}
+	return 0
+}
+
+func (x *SharedItem) GetSharedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SharedAt
+	}
+	return nil
+}
+
+// ListSharedWithMeResp - vault items shared with the current user.
+type ListSharedWithMeResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*SharedItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Error string        `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ListSharedWithMeResp) Reset() {
+	*x = ListSharedWithMeResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSharedWithMeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedWithMeResp) ProtoMessage() {}
+
+func (x *ListSharedWithMeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedWithMeResp.ProtoReflect.Descriptor instead.
+func (*ListSharedWithMeResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListSharedWithMeResp) GetItems() []*SharedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListSharedWithMeResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// RevokeShareReq - request for revoking the access of the recipient to the vault item.
+type RevokeShareReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId         int32  `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	RecipientLogin string `protobuf:"bytes,2,opt,name=recipient_login,json=recipientLogin,proto3" json:"recipient_login,omitempty"`
+}
+
+func (x *RevokeShareReq) Reset() {
+	*x = RevokeShareReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeShareReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeShareReq) ProtoMessage() {}
+
+func (x *RevokeShareReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeShareReq.ProtoReflect.Descriptor instead.
+func (*RevokeShareReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *RevokeShareReq) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *RevokeShareReq) GetRecipientLogin() string {
+	if x != nil {
+		return x.RecipientLogin
+	}
+	return ""
+}
+
+// RevokeShareResp - result of the revoking.
+type RevokeShareResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *RevokeShareResp) Reset() {
+	*x = RevokeShareResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeShareResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeShareResp) ProtoMessage() {}
+
+func (x *RevokeShareResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeShareResp.ProtoReflect.Descriptor instead.
+func (*RevokeShareResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *RevokeShareResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Organization - organization of the current user.
+type Organization struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Admin     bool                   `protobuf:"varint,3,opt,name=admin,proto3" json:"admin,omitempty"` // the current user is the administrator
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *Organization) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Organization) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Organization) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+func (x *Organization) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// OrgMember - member of the organization.
+type OrgMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login    string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Admin    bool                   `protobuf:"varint,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	JoinedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+}
+
+func (x *OrgMember) Reset() {
+	*x = OrgMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrgMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgMember) ProtoMessage() {}
+
+func (x *OrgMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgMember.ProtoReflect.Descriptor instead.
+func (*OrgMember) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *OrgMember) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *OrgMember) GetAdmin() bool {
+	if x != nil {
+		return x.Admin
+	}
+	return false
+}
+
+func (x *OrgMember) GetJoinedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return nil
+}
+
+// Invitation - invitation of the current user to the organization.
+type Invitation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgId     string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	OrgName   string                 `protobuf:"bytes,2,opt,name=org_name,json=orgName,proto3" json:"org_name,omitempty"`
+	InvitedBy string                 `protobuf:"bytes,3,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"` // login of the administrator, empty if the user is deleted
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *Invitation) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *Invitation) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *Invitation) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *Invitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Collection - collection of the organization with the role of the current user.
+type Collection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrgId     string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Role      int32                  `protobuf:"varint,4,opt,name=role,proto3" json:"role,omitempty"` // 1 - viewer, 2 - editor, 3 - manager, 0 - no role, the collection is visible to the administrator
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *Collection) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Collection) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *Collection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collection) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
+func (x *Collection) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CollectionMember - member of the collection with the role.
+type CollectionMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Role  int32  `protobuf:"varint,2,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *CollectionMember) Reset() {
+	*x = CollectionMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionMember) ProtoMessage() {}
+
+func (x *CollectionMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionMember.ProtoReflect.Descriptor instead.
+func (*CollectionMember) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *CollectionMember) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *CollectionMember) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
+// CreateOrganizationReq - request for create the organization.
+type CreateOrganizationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateOrganizationReq) Reset() {
+	*x = CreateOrganizationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateOrganizationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationReq) ProtoMessage() {}
+
+func (x *CreateOrganizationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationReq.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *CreateOrganizationReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// CreateOrganizationResp - result of the creation.
+type CreateOrganizationResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *CreateOrganizationResp) Reset() {
+	*x = CreateOrganizationResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateOrganizationResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationResp) ProtoMessage() {}
+
+func (x *CreateOrganizationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationResp.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *CreateOrganizationResp) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateOrganizationResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ListOrganizationsReq - request for the organizations of the current user.
+type ListOrganizationsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListOrganizationsReq) Reset() {
+	*x = ListOrganizationsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrganizationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsReq) ProtoMessage() {}
+
+func (x *ListOrganizationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsReq.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{105}
+}
+
+// ListOrganizationsResp - organizations of the current user.
+type ListOrganizationsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organizations []*Organization `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	Error         string          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ListOrganizationsResp) Reset() {
+	*x = ListOrganizationsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrganizationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrganizationsResp) ProtoMessage() {}
+
+func (x *ListOrganizationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrganizationsResp.ProtoReflect.Descriptor instead.
+func (*ListOrganizationsResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListOrganizationsResp) GetOrganizations() []*Organization {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+func (x *ListOrganizationsResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ListMembersReq - request for the members of the organization.
+type ListMembersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgId string `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+}
+
+func (x *ListMembersReq) Reset() {
+	*x = ListMembersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMembersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembersReq) ProtoMessage() {}
+
+func (x *ListMembersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembersReq.ProtoReflect.Descriptor instead.
+func (*ListMembersReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ListMembersReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// ListMembersResp - members of the organization.
+type ListMembersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*OrgMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Error   string       `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ListMembersResp) Reset() {
+	*x = ListMembersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMembersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembersResp) ProtoMessage() {}
+
+func (x *ListMembersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembersResp.ProtoReflect.Descriptor instead.
+func (*ListMembersResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListMembersResp) GetMembers() []*OrgMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListMembersResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// RemoveMemberReq - request for remove the member from the organization, the own login leaves it.
+type RemoveMemberReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgId string `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Login string `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (x *RemoveMemberReq) Reset() {
+	*x = RemoveMemberReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveMemberReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMemberReq) ProtoMessage() {}
+
+func (x *RemoveMemberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMemberReq.ProtoReflect.Descriptor instead.
+func (*RemoveMemberReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *RemoveMemberReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *RemoveMemberReq) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+// InviteMemberReq - request for invite the user to the organization.
+type InviteMemberReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgId string `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Login string `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+}
+
+func (x *InviteMemberReq) Reset() {
+	*x = InviteMemberReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteMemberReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteMemberReq) ProtoMessage() {}
+
+func (x *InviteMemberReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteMemberReq.ProtoReflect.Descriptor instead.
+func (*InviteMemberReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *InviteMemberReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *InviteMemberReq) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+// ListInvitationsReq - request for the invitations of the current user.
+type ListInvitationsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListInvitationsReq) Reset() {
+	*x = ListInvitationsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInvitationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsReq) ProtoMessage() {}
+
+func (x *ListInvitationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsReq.ProtoReflect.Descriptor instead.
+func (*ListInvitationsReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{111}
+}
+
+// ListInvitationsResp - invitations of the current user.
+type ListInvitationsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitations []*Invitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	Error       string        `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ListInvitationsResp) Reset() {
+	*x = ListInvitationsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInvitationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInvitationsResp) ProtoMessage() {}
+
+func (x *ListInvitationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInvitationsResp.ProtoReflect.Descriptor instead.
+func (*ListInvitationsResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ListInvitationsResp) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+func (x *ListInvitationsResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// InvitationReq - request for accept or decline the invitation.
+type InvitationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgId string `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+}
+
+func (x *InvitationReq) Reset() {
+	*x = InvitationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvitationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitationReq) ProtoMessage() {}
+
+func (x *InvitationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitationReq.ProtoReflect.Descriptor instead.
+func (*InvitationReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *InvitationReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// OrgResp - result of the organization management.
+type OrgResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *OrgResp) Reset() {
+	*x = OrgResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrgResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgResp) ProtoMessage() {}
+
+func (x *OrgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgResp.ProtoReflect.Descriptor instead.
+func (*OrgResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *OrgResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// CreateCollectionReq - request for create the collection in the organization.
+type CreateCollectionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgId string `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateCollectionReq) Reset() {
+	*x = CreateCollectionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCollectionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionReq) ProtoMessage() {}
+
+func (x *CreateCollectionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionReq.ProtoReflect.Descriptor instead.
+func (*CreateCollectionReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *CreateCollectionReq) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *CreateCollectionReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// CreateCollectionResp - result of the creation.
+type CreateCollectionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *CreateCollectionResp) Reset() {
+	*x = CreateCollectionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCollectionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionResp) ProtoMessage() {}
+
+func (x *CreateCollectionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionResp.ProtoReflect.Descriptor instead.
+func (*CreateCollectionResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CreateCollectionResp) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateCollectionResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ListCollectionsReq - request for the collections of the current user.
+type ListCollectionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListCollectionsReq) Reset() {
+	*x = ListCollectionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCollectionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsReq) ProtoMessage() {}
+
+func (x *ListCollectionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsReq.ProtoReflect.Descriptor instead.
+func (*ListCollectionsReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{117}
+}
+
+// ListCollectionsResp - collections of the current user.
+type ListCollectionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collections []*Collection `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	Error       string        `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ListCollectionsResp) Reset() {
+	*x = ListCollectionsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCollectionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsResp) ProtoMessage() {}
+
+func (x *ListCollectionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsResp.ProtoReflect.Descriptor instead.
+func (*ListCollectionsResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ListCollectionsResp) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *ListCollectionsResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// SetCollectionRoleReq - request for grant the role in the collection to the member of the organization.
+type SetCollectionRoleReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId int32  `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Login        string `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	Role         int32  `protobuf:"varint,3,opt,name=role,proto3" json:"role,omitempty"` // 0 - remove the member from the collection
+}
+
+func (x *SetCollectionRoleReq) Reset() {
+	*x = SetCollectionRoleReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCollectionRoleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionRoleReq) ProtoMessage() {}
+
+func (x *SetCollectionRoleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionRoleReq.ProtoReflect.Descriptor instead.
+func (*SetCollectionRoleReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *SetCollectionRoleReq) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *SetCollectionRoleReq) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *SetCollectionRoleReq) GetRole() int32 {
+	if x != nil {
+		return x.Role
+	}
+	return 0
+}
+
+// ListCollectionMembersReq - request for the members of the collection.
+type ListCollectionMembersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId int32 `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+}
+
+func (x *ListCollectionMembersReq) Reset() {
+	*x = ListCollectionMembersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCollectionMembersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionMembersReq) ProtoMessage() {}
+
+func (x *ListCollectionMembersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionMembersReq.ProtoReflect.Descriptor instead.
+func (*ListCollectionMembersReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListCollectionMembersReq) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+// ListCollectionMembersResp - members of the collection.
+type ListCollectionMembersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*CollectionMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Error   string              `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ListCollectionMembersResp) Reset() {
+	*x = ListCollectionMembersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCollectionMembersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionMembersResp) ProtoMessage() {}
+
+func (x *ListCollectionMembersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionMembersResp.ProtoReflect.Descriptor instead.
+func (*ListCollectionMembersResp) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ListCollectionMembersResp) GetMembers() []*CollectionMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListCollectionMembersResp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ListCollectionItemsReq - request for the records of the collection.
+type ListCollectionItemsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId int32 `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+}
+
+func (x *ListCollectionItemsReq) Reset() {
+	*x = ListCollectionItemsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCollectionItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionItemsReq) ProtoMessage() {}
+
+func (x *ListCollectionItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionItemsReq.ProtoReflect.Descriptor instead.
+func (*ListCollectionItemsReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ListCollectionItemsReq) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+// MoveToCollectionReq - request for move the own record to the collection.
+type MoveToCollectionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type         int32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	CollectionId int32 `protobuf:"varint,3,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+}
+
+func (x *MoveToCollectionReq) Reset() {
+	*x = MoveToCollectionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveToCollectionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveToCollectionReq) ProtoMessage() {}
+
+func (x *MoveToCollectionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveToCollectionReq.ProtoReflect.Descriptor instead.
+func (*MoveToCollectionReq) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *MoveToCollectionReq) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MoveToCollectionReq) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *MoveToCollectionReq) GetCollectionId() int32 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+type SyncResp_ChangeModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq     int64  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Id      int32  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Type    int32  `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	Deleted bool   `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"` // tombstone
+	Title   string `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Tag     string `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`
+	Comment string `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *SyncResp_ChangeModel) Reset() {
+	*x = SyncResp_ChangeModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncResp_ChangeModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncResp_ChangeModel) ProtoMessage() {}
+
+func (x *SyncResp_ChangeModel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncResp_ChangeModel.ProtoReflect.Descriptor instead.
+func (*SyncResp_ChangeModel) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *SyncResp_ChangeModel) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *SyncResp_ChangeModel) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SyncResp_ChangeModel) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *SyncResp_ChangeModel) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *SyncResp_ChangeModel) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SyncResp_ChangeModel) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *SyncResp_ChangeModel) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type BatchReq_LoginPasswordModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Login    string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *BatchReq_LoginPasswordModel) Reset() {
+	*x = BatchReq_LoginPasswordModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchReq_LoginPasswordModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReq_LoginPasswordModel) ProtoMessage() {}
+
+func (x *BatchReq_LoginPasswordModel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReq_LoginPasswordModel.ProtoReflect.Descriptor instead.
+func (*BatchReq_LoginPasswordModel) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *BatchReq_LoginPasswordModel) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *BatchReq_LoginPasswordModel) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type BatchReq_CardModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Num       string `protobuf:"bytes,1,opt,name=num,proto3" json:"num,omitempty"`
+	Date      string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Cvc       string `protobuf:"bytes,3,opt,name=cvc,proto3" json:"cvc,omitempty"`
+	FirstName string `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+}
+
+func (x *BatchReq_CardModel) Reset() {
+	*x = BatchReq_CardModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchReq_CardModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReq_CardModel) ProtoMessage() {}
+
+func (x *BatchReq_CardModel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReq_CardModel.ProtoReflect.Descriptor instead.
+func (*BatchReq_CardModel) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *BatchReq_CardModel) GetNum() string {
+	if x != nil {
+		return x.Num
+	}
+	return ""
+}
+
+func (x *BatchReq_CardModel) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *BatchReq_CardModel) GetCvc() string {
+	if x != nil {
+		return x.Cvc
+	}
+	return ""
+}
+
+func (x *BatchReq_CardModel) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *BatchReq_CardModel) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+type BatchReq_TextModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *BatchReq_TextModel) Reset() {
+	*x = BatchReq_TextModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchReq_TextModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReq_TextModel) ProtoMessage() {}
+
+func (x *BatchReq_TextModel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReq_TextModel.ProtoReflect.Descriptor instead.
+func (*BatchReq_TextModel) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{4, 2}
+}
+
+func (x *BatchReq_TextModel) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type BatchReq_BinaryModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *BatchReq_BinaryModel) Reset() {
+	*x = BatchReq_BinaryModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchReq_BinaryModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReq_BinaryModel) ProtoMessage() {}
+
+func (x *BatchReq_BinaryModel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReq_BinaryModel.ProtoReflect.Descriptor instead.
+func (*BatchReq_BinaryModel) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{4, 3}
+}
+
+func (x *BatchReq_BinaryModel) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BatchReq_SSHKeyModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrivateKey string `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	PublicKey  string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"` // optional, must belong to the private key
+	Passphrase string `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`                // required for the encrypted private key
+}
+
+func (x *BatchReq_SSHKeyModel) Reset() {
+	*x = BatchReq_SSHKeyModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchReq_SSHKeyModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReq_SSHKeyModel) ProtoMessage() {}
+
+func (x *BatchReq_SSHKeyModel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReq_SSHKeyModel.ProtoReflect.Descriptor instead.
+func (*BatchReq_SSHKeyModel) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{4, 4}
+}
+
+func (x *BatchReq_SSHKeyModel) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *BatchReq_SSHKeyModel) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *BatchReq_SSHKeyModel) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+type BatchReq_Operation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action  BatchReq_Operation_Action `protobuf:"varint,1,opt,name=action,proto3,enum=pwdm.BatchReq_Operation_Action" json:"action,omitempty"`
+	Type    int32                     `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"` // data type, required for delete
+	Id      int32                     `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`     // record id, required for update and delete
+	Title   string                    `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Tag     string                    `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	Comment string                    `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	// Types that are assignable to Data:
+	//	*BatchReq_Operation_LoginPassword
+	//	*BatchReq_Operation_Card
+	//	*BatchReq_Operation_Text
+	//	*BatchReq_Operation_Binary
+	//	*BatchReq_Operation_Identity
+	//	*BatchReq_Operation_SshKey
+	Data isBatchReq_Operation_Data `protobuf_oneof:"data"`
+}
+
+func (x *BatchReq_Operation) Reset() {
+	*x = BatchReq_Operation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchReq_Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReq_Operation) ProtoMessage() {}
+
+func (x *BatchReq_Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReq_Operation.ProtoReflect.Descriptor instead.
+func (*BatchReq_Operation) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{4, 5}
+}
+
+func (x *BatchReq_Operation) GetAction() BatchReq_Operation_Action {
+	if x != nil {
+		return x.Action
+	}
+	return BatchReq_Operation_UNKNOWN
+}
+
+func (x *BatchReq_Operation) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *BatchReq_Operation) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BatchReq_Operation) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BatchReq_Operation) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *BatchReq_Operation) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (m *BatchReq_Operation) GetData() isBatchReq_Operation_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *BatchReq_Operation) GetLoginPassword() *BatchReq_LoginPasswordModel {
+	if x, ok := x.GetData().(*BatchReq_Operation_LoginPassword); ok {
+		return x.LoginPassword
+	}
+	return nil
+}
+
+func (x *BatchReq_Operation) GetCard() *BatchReq_CardModel {
+	if x, ok := x.GetData().(*BatchReq_Operation_Card); ok {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *BatchReq_Operation) GetText() *BatchReq_TextModel {
+	if x, ok := x.GetData().(*BatchReq_Operation_Text); ok {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *BatchReq_Operation) GetBinary() *BatchReq_BinaryModel {
+	if x, ok := x.GetData().(*BatchReq_Operation_Binary); ok {
+		return x.Binary
+	}
+	return nil
+}
+
+func (x *BatchReq_Operation) GetIdentity() *Identity {
+	if x, ok := x.GetData().(*BatchReq_Operation_Identity); ok {
+		return x.Identity
+	}
+	return nil
+}
+
+func (x *BatchReq_Operation) GetSshKey() *BatchReq_SSHKeyModel {
+	if x, ok := x.GetData().(*BatchReq_Operation_SshKey); ok {
+		return x.SshKey
+	}
+	return nil
+}
+
+type isBatchReq_Operation_Data interface {
+	isBatchReq_Operation_Data()
+}
+
+type BatchReq_Operation_LoginPassword struct {
+	LoginPassword *BatchReq_LoginPasswordModel `protobuf:"bytes,7,opt,name=login_password,json=loginPassword,proto3,oneof"`
+}
+
+type BatchReq_Operation_Card struct {
+	Card *BatchReq_CardModel `protobuf:"bytes,8,opt,name=card,proto3,oneof"`
+}
+
+type BatchReq_Operation_Text struct {
+	Text *BatchReq_TextModel `protobuf:"bytes,9,opt,name=text,proto3,oneof"`
+}
+
+type BatchReq_Operation_Binary struct {
+	Binary *BatchReq_BinaryModel `protobuf:"bytes,10,opt,name=binary,proto3,oneof"`
+}
+
+type BatchReq_Operation_Identity struct {
+	Identity *Identity `protobuf:"bytes,11,opt,name=identity,proto3,oneof"`
+}
+
+type BatchReq_Operation_SshKey struct {
+	SshKey *BatchReq_SSHKeyModel `protobuf:"bytes,12,opt,name=ssh_key,json=sshKey,proto3,oneof"`
+}
+
+func (*BatchReq_Operation_LoginPassword) isBatchReq_Operation_Data() {}
+
+func (*BatchReq_Operation_Card) isBatchReq_Operation_Data() {}
+
+func (*BatchReq_Operation_Text) isBatchReq_Operation_Data() {}
+
+func (*BatchReq_Operation_Binary) isBatchReq_Operation_Data() {}
+
+func (*BatchReq_Operation_Identity) isBatchReq_Operation_Data() {}
+
+func (*BatchReq_Operation_SshKey) isBatchReq_Operation_Data() {}
+
+type BatchResp_ResultModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BatchResp_ResultModel) Reset() {
+	*x = BatchResp_ResultModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResp_ResultModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResp_ResultModel) ProtoMessage() {}
+
+func (x *BatchResp_ResultModel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResp_ResultModel.ProtoReflect.Descriptor instead.
+func (*BatchResp_ResultModel) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *BatchResp_ResultModel) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BatchResp_ResultModel) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BatchResp_ResultModel) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ListItemsResp_ItemModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type           int32                  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Title          string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Tag            string                 `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	Comment        string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FolderId       int32                  `protobuf:"varint,8,opt,name=folder_id,json=folderId,proto3" json:"folder_id,omitempty"` // 0 - root folder
+	Fields         []*CustomField         `protobuf:"bytes,9,rep,name=fields,proto3" json:"fields,omitempty"`
+	CardBrand      string                 `protobuf:"bytes,10,opt,name=card_brand,json=cardBrand,proto3" json:"card_brand,omitempty"` // cards only, for example: visa, mastercard, mir
+	MaskedNum      string                 `protobuf:"bytes,11,opt,name=masked_num,json=maskedNum,proto3" json:"masked_num,omitempty"` // cards only, for example: **** 1234
+	Favorite       bool                   `protobuf:"varint,12,opt,name=favorite,proto3" json:"favorite,omitempty"`
+	LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_accessed_at,json=lastAccessedAt,proto3" json:"last_accessed_at,omitempty"` // not set if the record has never been read
+	AccessCount    int32                  `protobuf:"varint,14,opt,name=access_count,json=accessCount,proto3" json:"access_count,omitempty"`
+}
+
+func (x *ListItemsResp_ItemModel) Reset() {
+	*x = ListItemsResp_ItemModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListItemsResp_ItemModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsResp_ItemModel) ProtoMessage() {}
+
+func (x *ListItemsResp_ItemModel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsResp_ItemModel.ProtoReflect.Descriptor instead.
+func (*ListItemsResp_ItemModel) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *ListItemsResp_ItemModel) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListItemsResp_ItemModel) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ListItemsResp_ItemModel) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ListItemsResp_ItemModel) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListItemsResp_ItemModel) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ListItemsResp_ItemModel) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ListItemsResp_ItemModel) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ListItemsResp_ItemModel) GetFolderId() int32 {
+	if x != nil {
+		return x.FolderId
+	}
+	return 0
+}
+
+func (x *ListItemsResp_ItemModel) GetFields() []*CustomField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ListItemsResp_ItemModel) GetCardBrand() string {
+	if x != nil {
+		return x.CardBrand
+	}
+	return ""
+}
+
+func (x *ListItemsResp_ItemModel) GetMaskedNum() string {
+	if x != nil {
+		return x.MaskedNum
+	}
+	return ""
+}
+
+func (x *ListItemsResp_ItemModel) GetFavorite() bool {
+	if x != nil {
+		return x.Favorite
+	}
+	return false
+}
+
+func (x *ListItemsResp_ItemModel) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAccessedAt
+	}
+	return nil
+}
+
+func (x *ListItemsResp_ItemModel) GetAccessCount() int32 {
+	if x != nil {
+		return x.AccessCount
+	}
+	return 0
+}
+
+type ListTagsResp_TagModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ListTagsResp_TagModel) Reset() {
+	*x = ListTagsResp_TagModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsResp_TagModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResp_TagModel) ProtoMessage() {}
+
+func (x *ListTagsResp_TagModel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResp_TagModel.ProtoReflect.Descriptor instead.
+func (*ListTagsResp_TagModel) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *ListTagsResp_TagModel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListTagsResp_TagModel) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type MoveItemsReq_ItemModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type int32 `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *MoveItemsReq_ItemModel) Reset() {
+	*x = MoveItemsReq_ItemModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveItemsReq_ItemModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveItemsReq_ItemModel) ProtoMessage() {}
+
+func (x *MoveItemsReq_ItemModel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveItemsReq_ItemModel.ProtoReflect.Descriptor instead.
+func (*MoveItemsReq_ItemModel) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *MoveItemsReq_ItemModel) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MoveItemsReq_ItemModel) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+type LookupByURLResp_LoginModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title    string      `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Login    string      `protobuf:"bytes,3,opt,name=login,proto3" json:"login,omitempty"`
+	Password string      `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Tag      string      `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	Comment  string      `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	Uris     []*LoginURI `protobuf:"bytes,7,rep,name=uris,proto3" json:"uris,omitempty"`
+}
+
+func (x *LookupByURLResp_LoginModel) Reset() {
+	*x = LookupByURLResp_LoginModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupByURLResp_LoginModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupByURLResp_LoginModel) ProtoMessage() {}
+
+func (x *LookupByURLResp_LoginModel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupByURLResp_LoginModel.ProtoReflect.Descriptor instead.
+func (*LookupByURLResp_LoginModel) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{34, 0}
+}
+
+func (x *LookupByURLResp_LoginModel) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LookupByURLResp_LoginModel) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LookupByURLResp_LoginModel) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *LookupByURLResp_LoginModel) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *LookupByURLResp_LoginModel) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *LookupByURLResp_LoginModel) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *LookupByURLResp_LoginModel) GetUris() []*LoginURI {
+	if x != nil {
+		return x.Uris
+	}
+	return nil
+}
+
+type SSHKeysInfo_KeyModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	KeyType     string `protobuf:"bytes,2,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
+	PublicKey   string `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Fingerprint string `protobuf:"bytes,4,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+}
+
+func (x *SSHKeysInfo_KeyModel) Reset() {
+	*x = SSHKeysInfo_KeyModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SSHKeysInfo_KeyModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SSHKeysInfo_KeyModel) ProtoMessage() {}
+
+func (x *SSHKeysInfo_KeyModel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SSHKeysInfo_KeyModel.ProtoReflect.Descriptor instead.
+func (*SSHKeysInfo_KeyModel) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{41, 0}
+}
+
+func (x *SSHKeysInfo_KeyModel) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SSHKeysInfo_KeyModel) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+func (x *SSHKeysInfo_KeyModel) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *SSHKeysInfo_KeyModel) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+type CardsInfo_CardModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Brand     string `protobuf:"bytes,2,opt,name=brand,proto3" json:"brand,omitempty"`
+	MaskedNum string `protobuf:"bytes,3,opt,name=masked_num,json=maskedNum,proto3" json:"masked_num,omitempty"`
+}
+
+func (x *CardsInfo_CardModel) Reset() {
+	*x = CardsInfo_CardModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardsInfo_CardModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardsInfo_CardModel) ProtoMessage() {}
+
+func (x *CardsInfo_CardModel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardsInfo_CardModel.ProtoReflect.Descriptor instead.
+func (*CardsInfo_CardModel) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{46, 0}
+}
+
+func (x *CardsInfo_CardModel) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CardsInfo_CardModel) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *CardsInfo_CardModel) GetMaskedNum() string {
+	if x != nil {
+		return x.MaskedNum
+	}
+	return ""
+}
+
+type ExpiringItemsResp_ItemModel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type      int32  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Title     string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Tag       string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	ExpiresAt string `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // YYYY-MM-DD, the record is valid through this day
+	DaysLeft  int32  `protobuf:"varint,6,opt,name=days_left,json=daysLeft,proto3" json:"days_left,omitempty"`   // negative for the expired records
+}
+
+func (x *ExpiringItemsResp_ItemModel) Reset() {
+	*x = ExpiringItemsResp_ItemModel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExpiringItemsResp_ItemModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpiringItemsResp_ItemModel) ProtoMessage() {}
+
+func (x *ExpiringItemsResp_ItemModel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpiringItemsResp_ItemModel.ProtoReflect.Descriptor instead.
+func (*ExpiringItemsResp_ItemModel) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{50, 0}
+}
+
+func (x *ExpiringItemsResp_ItemModel) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ExpiringItemsResp_ItemModel) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ExpiringItemsResp_ItemModel) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ExpiringItemsResp_ItemModel) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ExpiringItemsResp_ItemModel) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *ExpiringItemsResp_ItemModel) GetDaysLeft() int32 {
+	if x != nil {
+		return x.DaysLeft
+	}
+	return 0
+}
+
+type UploadBinaryReq_Header struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UploadId string `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"` // empty to start a new upload, otherwise the upload to resume
+	Id       int32  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`                            // 0 - new record, otherwise the record whose data is replaced
+	Title    string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Tag      string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	Comment  string `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	Size     int64  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`                        // size of the whole data in bytes
+	Sha256   string `protobuf:"bytes,7,opt,name=sha256,proto3" json:"sha256,omitempty"`                     // hex SHA-256 of the whole data
+	Type     int32  `protobuf:"varint,8,opt,name=type,proto3" json:"type,omitempty"`                        // type of the record, for the attachments only
+	MimeType string `protobuf:"bytes,9,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"` // for the attachments only
+}
+
+func (x *UploadBinaryReq_Header) Reset() {
+	*x = UploadBinaryReq_Header{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadBinaryReq_Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadBinaryReq_Header) ProtoMessage() {}
+
+func (x *UploadBinaryReq_Header) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadBinaryReq_Header.ProtoReflect.Descriptor instead.
+func (*UploadBinaryReq_Header) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{52, 0}
+}
+
+func (x *UploadBinaryReq_Header) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadBinaryReq_Header) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UploadBinaryReq_Header) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UploadBinaryReq_Header) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *UploadBinaryReq_Header) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *UploadBinaryReq_Header) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadBinaryReq_Header) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *UploadBinaryReq_Header) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *UploadBinaryReq_Header) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+type DownloadBinaryResp_Header struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title    string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Tag      string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Comment  string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	Size     int64  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`                        // size of the whole data in bytes
+	Sha256   string `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`                     // hex SHA-256 of the whole data
+	MimeType string `protobuf:"bytes,7,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"` // for the attachments only
+}
+
+func (x *DownloadBinaryResp_Header) Reset() {
+	*x = DownloadBinaryResp_Header{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_pwdm_server_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadBinaryResp_Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadBinaryResp_Header) ProtoMessage() {}
+
+func (x *DownloadBinaryResp_Header) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pwdm_server_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadBinaryResp_Header.ProtoReflect.Descriptor instead.
+func (*DownloadBinaryResp_Header) Descriptor() ([]byte, []int) {
+	return file_proto_pwdm_server_proto_rawDescGZIP(), []int{56, 0}
+}
+
+func (x *DownloadBinaryResp_Header) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DownloadBinaryResp_Header) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DownloadBinaryResp_Header) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *DownloadBinaryResp_Header) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *DownloadBinaryResp_Header) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DownloadBinaryResp_Header) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *DownloadBinaryResp_Header) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+var File_proto_pwdm_server_proto protoreflect.FileDescriptor
+
+var file_proto_pwdm_server_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x77, 0x64, 0x6d, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x70, 0x77, 0x64, 0x6d, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x37, 0x0a, 0x07, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xab, 0x02, 0x0a, 0x08, 0x53, 0x79,
+	0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x9f, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x22, 0x0a, 0x08, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xf7, 0x01, 0x0a, 0x0a,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x77, 0x64, 0x6d,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x3f, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b,
+	0x0a, 0x07, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x44, 0x10, 0x03, 0x22, 0xf7, 0x07, 0x0a, 0x08, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x12, 0x38, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x46, 0x0a, 0x12,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x1a, 0x7f, 0x0a, 0x09, 0x43, 0x61, 0x72, 0x64, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x76, 0x63, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x76, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x1f, 0x0a, 0x09, 0x54, 0x65, 0x78, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x21, 0x0a, 0x0b, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x6d, 0x0a, 0x0b, 0x53, 0x53, 0x48,
+	0x4b, 0x65, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73,
+	0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61,
+	0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x1a, 0xb4, 0x04, 0x0a, 0x09, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x0e, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x70, 0x77, 0x64, 0x6d, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x2e, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x48, 0x00, 0x52, 0x0d, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x2e, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x2e,
+	0x43, 0x61, 0x72, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x63, 0x61, 0x72,
+	0x64, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x2e,
+	0x54, 0x65, 0x78, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x34, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x2e, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x48, 0x00, 0x52,
+	0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x2c, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x77, 0x64, 0x6d,
+	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x48, 0x00, 0x52, 0x08, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x73, 0x68, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x2e, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x48, 0x00, 0x52, 0x06, 0x73, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x22, 0x39, 0x0a, 0x06,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0xc1, 0x01, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x35, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x2e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x49, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0xbc, 0x04, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x3d, 0x0a, 0x0c,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x53, 0x6f, 0x72, 0x74,
+	0x42, 0x79, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65,
+	0x73, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x73, 0x46, 0x69, 0x72, 0x73, 0x74, 0x22, 0x47, 0x0a, 0x06, 0x53, 0x6f, 0x72,
+	0x74, 0x42, 0x79, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x0b,
+	0x0a, 0x07, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x43, 0x45,
+	0x4e, 0x54, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x54,
+	0x10, 0x04, 0x22, 0xf0, 0x04, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x1a, 0xf2, 0x03, 0x0a, 0x09, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x42, 0x72, 0x61, 0x6e,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x64, 0x4e, 0x75, 0x6d,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x10,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x0d, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x22, 0x8b, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x54, 0x61, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x34, 0x0a, 0x08,
+	0x54, 0x61, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x3d, 0x0a, 0x0c, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x67, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x3c, 0x0a, 0x0c, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22,
+	0x22, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x08, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0xa4, 0x01, 0x0a, 0x0b, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3f, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x49, 0x44, 0x44, 0x45, 0x4e,
+	0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x4f, 0x4f, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x03, 0x12,
+	0x07, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x10, 0x04, 0x22, 0x39, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x22, 0x63, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x70, 0x77, 0x64, 0x6d, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x53, 0x0a, 0x10, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x29, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
+	0x77, 0x64, 0x6d, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x50, 0x0a,
+	0x0e, 0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x22,
+	0x27, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb0, 0x01, 0x0a, 0x0b, 0x46, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x66, 0x6f, 0x6c,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x77, 0x64,
+	0x6d, 0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x07, 0x66,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x40, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x72, 0x65,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x74, 0x72, 0x65, 0x65, 0x22, 0x51, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x29, 0x0a, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x4d, 0x6f, 0x64,
+	0x65, 0x6c, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x42, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x35, 0x0a, 0x0f, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x0d, 0x4d,
+	0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x21, 0x0a, 0x0f, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x90, 0x01, 0x0a,
+	0x0c, 0x4d, 0x6f, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a,
+	0x09, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x77, 0x64, 0x6d,
+	0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x49, 0x74,
+	0x65, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x2f,
+	0x0a, 0x09, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x4e, 0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x93, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x52, 0x49, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2a,
+	0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x52, 0x49, 0x2e, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x49, 0x0a, 0x05, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x44, 0x4f, 0x4d, 0x41,
+	0x49, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x53, 0x54, 0x41, 0x52, 0x54, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x10, 0x02, 0x12,
+	0x09, 0x0a, 0x05, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45,
+	0x47, 0x45, 0x58, 0x10, 0x04, 0x22, 0x45, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x55, 0x52, 0x49, 0x73, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x75, 0x72, 0x69, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x55, 0x52, 0x49, 0x52, 0x04, 0x75, 0x72, 0x69, 0x73, 0x22, 0x21, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x52, 0x49, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x49, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x52, 0x49, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x22, 0x0a, 0x04, 0x75, 0x72, 0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x52, 0x49, 0x52, 0x04,
+	0x75, 0x72, 0x69, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x22, 0x0a, 0x0e, 0x4c, 0x6f,
+	0x6f, 0x6b, 0x75, 0x70, 0x42, 0x79, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x98,
+	0x02, 0x0a, 0x0f, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x42, 0x79, 0x55, 0x52, 0x4c, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x38, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x42, 0x79, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x1a, 0xb4, 0x01, 0x0a, 0x0a, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x75, 0x72, 0x69, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x55, 0x52, 0x49, 0x52, 0x04, 0x75, 0x72, 0x69, 0x73, 0x22, 0x2e, 0x0a, 0x0a, 0x53, 0x65, 0x74,
+	0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x54, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x08,
+	0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xbd, 0x01, 0x0a, 0x09, 0x53,
+	0x53, 0x48, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x1e, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0xbc, 0x02, 0x0a, 0x0a, 0x53,
+	0x53, 0x48, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x61, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x29,
+	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x0b, 0x53, 0x53,
+	0x48, 0x4b, 0x65, 0x79, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53,
+	0x53, 0x48, 0x4b, 0x65, 0x79, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4b, 0x65, 0x79, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x1a, 0x76, 0x0a, 0x08, 0x4b, 0x65, 0x79,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12,
+	0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e,
+	0x74, 0x22, 0x88, 0x04, 0x0a, 0x08, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x31, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70,
+	0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x27, 0x0a,
+	0x0f, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x73, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73,
+	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a,
+	0x0e, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x78, 0x49, 0x64, 0x22, 0x8b, 0x01, 0x0a,
+	0x0b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x20, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0xcd, 0x01, 0x0a,
+	0x0c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x29, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x8e, 0x01, 0x0a,
+	0x09, 0x43, 0x61, 0x72, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2f, 0x0a, 0x05, 0x63, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x77, 0x64, 0x6d,
+	0x2e, 0x43, 0x61, 0x72, 0x64, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x1a, 0x50, 0x0a, 0x09, 0x43,
+	0x61, 0x72, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x72, 0x61, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x22, 0x51, 0x0a,
+	0x0c, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0x25, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x35, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x12, 0x21, 0x0a, 0x0c, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x22, 0xf8,
+	0x01, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x37, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x49, 0x74, 0x65,
+	0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x1a, 0x93, 0x01, 0x0a, 0x09, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x61, 0x79, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x64, 0x61, 0x79, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x22, 0x4f, 0x0a, 0x0b, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x72, 0x63, 0x33, 0x32, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x72, 0x63, 0x33, 0x32, 0x22, 0xd2, 0x02, 0x0a, 0x0f, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x36,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x1a, 0xd4, 0x01, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22,
+	0x97, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2e, 0x0a, 0x0f, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1b, 0x0a, 0x09,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x11, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xa7, 0x02, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x39, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x70, 0x77, 0x64, 0x6d, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x48, 0x00,
+	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e,
+	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x42,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x1a, 0xa3, 0x01, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67,
+	0x22, 0xee, 0x01, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x38, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x64, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x37, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x0b,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x3f, 0x0a, 0x15, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x25, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x14, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x0a, 0x0a, 0x08, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x22, 0x3d, 0x0a, 0x09, 0x53, 0x69, 0x7a, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x64, 0x22, 0xdf, 0x01, 0x0a, 0x09, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x23, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x27, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x69, 0x7a,
+	0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x31,
+	0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x6c, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x25, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x4d, 0x6f, 0x64, 0x65,
+	0x6c, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x8d, 0x01, 0x0a, 0x0b, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x64, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x64, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73,
+	0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c, 0x6c, 0x65,
+	0x6c, 0x69, 0x73, 0x6d, 0x22, 0x3a, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x75, 0x70, 0x56, 0x61, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x71, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x56, 0x61, 0x75,
+	0x6c, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x22, 0x26, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56,
+	0x61, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x22, 0x55, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0xd3, 0x02, 0x0a, 0x09, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x12, 0x39, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e,
+	0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x3b, 0x0a,
+	0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xcf, 0x01, 0x0a, 0x0f, 0x50,
+	0x75, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6c,
+	0x6f, 0x62, 0x12, 0x3f, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x50, 0x75, 0x74, 0x56,
+	0x61, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x2e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x1a,
+	0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x54, 0x0a, 0x10,
+	0x50, 0x75, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x21, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x23, 0x0a, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x56,
+	0x61, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x40, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61,
+	0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x47, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0xb3, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x38, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x38, 0x0a, 0x0a, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x88, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61,
+	0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x77,
+	0x64, 0x6d, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x56, 0x0a, 0x0e, 0x53, 0x52, 0x50, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x3d, 0x0a, 0x0f, 0x53, 0x52, 0x50, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x48, 0x0a, 0x0b, 0x53, 0x52, 0x50, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x22, 0x7c, 0x0a, 0x0c, 0x53, 0x52, 0x50, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x73, 0x61, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x50, 0x0a, 0x0c, 0x53, 0x52, 0x50, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x22, 0x5e, 0x0a, 0x0d, 0x53, 0x52, 0x50, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x9c, 0x01, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x65, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x20,
+	0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74,
+	0x22, 0x39, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65,
+	0x71, 0x12, 0x28, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4b, 0x65, 0x79, 0x50, 0x61,
+	0x69, 0x72, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x22, 0x0f, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x22, 0x27, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x4d, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x28, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4b, 0x65,
+	0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0xba, 0x01, 0x0a, 0x0c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x77, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x6b, 0x65, 0x79, 0x5f,
+	0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x25, 0x0a, 0x0d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x52, 0x65, 0x71, 0x22,
+	0xf5, 0x01, 0x0a, 0x0a, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x23,
+	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70,
+	0x77, 0x64, 0x6d, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x64, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x77, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x6e,
+	0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x6b, 0x65, 0x79, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37,
+	0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x54, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x26, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x52, 0x0a,
+	0x0e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x22, 0x27, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x83, 0x01, 0x0a, 0x0c, 0x4f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x70, 0x0a, 0x09, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x6a, 0x6f, 0x69,
+	0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6a, 0x6f, 0x69, 0x6e, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x67, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x96, 0x01,
+	0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06,
+	0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72,
+	0x67, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3c, 0x0a, 0x10, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f,
+	0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x3e, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x22, 0x67, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x38, 0x0a, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x77, 0x64, 0x6d,
+	0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x27, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x29,
+	0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4f, 0x72, 0x67, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x3e, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22,
+	0x3e, 0x0a, 0x0f, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22,
+	0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x22, 0x5f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32, 0x0a, 0x0b,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x26, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x22, 0x1f,
+	0x0a, 0x07, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x40, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x3c, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x22, 0x5f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x65, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x3f, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x63,
+	0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x77, 0x64, 0x6d, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x3d, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0x5e, 0x0a, 0x13, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x32, 0x34, 0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x25, 0x0a, 0x04, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x0d, 0x2e, 0x70, 0x77, 0x64, 0x6d,
+	0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e,
+	0x53, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x32, 0x3b, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x0e, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x1a, 0x10, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x30, 0x01, 0x32, 0x38, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x0e,
+	0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x0f,
+	0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x32,
+	0x8b, 0x03, 0x0a, 0x0c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x34, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x2e,
+	0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x13, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61,
+	0x67, 0x73, 0x12, 0x11, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x09, 0x52, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x54, 0x61, 0x67, 0x12, 0x12, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x52, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x77, 0x64,
+	0x6d, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x09, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x12, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4d,
+	0x65, 0x72, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x77,
+	0x64, 0x6d, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x09, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x12, 0x12, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70,
+	0x77, 0x64, 0x6d, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x43, 0x0a, 0x0f,
+	0x53, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x18, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x77, 0x64, 0x6d,
+	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x3a, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65,
+	0x12, 0x14, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x65,
+	0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x32, 0xdc, 0x02,
+	0x0a, 0x0e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x37, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x13,
+	0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x37, 0x0a, 0x0c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x77, 0x64, 0x6d,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x10, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x37, 0x0a, 0x0c, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x6f, 0x6c, 0x64,
+	0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x77, 0x64, 0x6d,
+	0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x0a, 0x4d,
+	0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x70, 0x77, 0x64, 0x6d,
+	0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x10,
+	0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x37, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72,
+	0x12, 0x15, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x09, 0x4d, 0x6f, 0x76,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4d, 0x6f,
+	0x76, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x77, 0x64,
+	0x6d, 0x2e, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x32, 0xc5, 0x01, 0x0a,
+	0x0f, 0x41, 0x75, 0x74, 0x6f, 0x66, 0x69, 0x6c, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x3a, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x52, 0x49, 0x73,
+	0x12, 0x15, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x55, 0x52, 0x49, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x55, 0x52, 0x49, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x52, 0x49, 0x73, 0x12, 0x15, 0x2e, 0x70,
+	0x77, 0x64, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x52, 0x49, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x55, 0x52, 0x49, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x0b, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x42, 0x79, 0x55, 0x52, 0x4c, 0x12, 0x14, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x42, 0x79, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e,
+	0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x42, 0x79, 0x55, 0x52, 0x4c,
+	0x52, 0x65, 0x73, 0x70, 0x32, 0x6f, 0x0a, 0x0b, 0x54, 0x4f, 0x54, 0x50, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x10,
+	0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71,
+	0x1a, 0x0e, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x33, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x14, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x4f, 0x54, 0x50, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x54, 0x4f, 0x54,
+	0x50, 0x52, 0x65, 0x73, 0x70, 0x32, 0xa5, 0x01, 0x0a, 0x0d, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x49, 0x6e, 0x73, 0x53, 0x53,
+	0x48, 0x4b, 0x65, 0x79, 0x12, 0x0f, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x53, 0x48, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x53, 0x48,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x53,
+	0x48, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x53, 0x48, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e,
+	0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x0c, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x12, 0x0f, 0x2e, 0x70, 0x77, 0x64,
+	0x6d, 0x2e, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x70, 0x77,
+	0x64, 0x6d, 0x2e, 0x53, 0x53, 0x48, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x32, 0xb9, 0x01,
+	0x0a, 0x0f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x34, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x11, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x37, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x47, 0x65,
+	0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70,
+	0x77, 0x64, 0x6d, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x37, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x11, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x32, 0x87, 0x01, 0x0a, 0x0d, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x53,
+	0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x12, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e,
+	0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70,
+	0x77, 0x64, 0x6d, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x40, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x70, 0x77, 0x64,
+	0x6d, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x32, 0xd6, 0x01, 0x0a, 0x0d, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42,
+	0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70,
+	0x77, 0x64, 0x6d, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x28, 0x01, 0x12, 0x3d, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x15, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x70, 0x77, 0x64, 0x6d, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x17, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x1a, 0x18, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x30, 0x01, 0x32, 0xb0, 0x02, 0x0a,
+	0x11, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x12, 0x15, 0x2e, 0x70,
+	0x77, 0x64, 0x6d, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x28, 0x01, 0x12, 0x46, 0x0a,
+	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x18, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x77, 0x64,
+	0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x77,
+	0x64, 0x6d, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x32,
+	0x3b, 0x0a, 0x0c, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x2b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x2e, 0x70, 0x77,
+	0x64, 0x6d, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x70, 0x77,
+	0x64, 0x6d, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x32, 0x97, 0x03, 0x0a,
+	0x0c, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a,
+	0x0a, 0x53, 0x65, 0x74, 0x75, 0x70, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x13, 0x2e, 0x70, 0x77,
+	0x64, 0x6d, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x14, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x65, 0x74, 0x75, 0x70, 0x56, 0x61, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x43, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x75,
+	0x6c, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e,
+	0x47, 0x65, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x18, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3d, 0x0a, 0x0c, 0x50,
+	0x75, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x77,
+	0x64, 0x6d, 0x2e, 0x50, 0x75, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x71, 0x1a, 0x16, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x50, 0x75, 0x74, 0x56, 0x61, 0x75,
+	0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3d, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x15, 0x2e, 0x70, 0x77, 0x64,
+	0x6d, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x71, 0x1a, 0x16, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x75, 0x6c,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x46, 0x0a, 0x0f, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x18, 0x2e, 0x70,
+	0x77, 0x64, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x43, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56,
+	0x61, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70,
+	0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x32, 0xb2, 0x01, 0x0a, 0x0a, 0x53, 0x52, 0x50, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x14, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x52, 0x50, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53,
+	0x52, 0x50, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x33,
+	0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x11, 0x2e, 0x70,
+	0x77, 0x64, 0x6d, 0x2e, 0x53, 0x52, 0x50, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x12, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x52, 0x50, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x12, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x52, 0x50, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x52,
+	0x50, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x32, 0xf1, 0x02, 0x0a, 0x0c,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x0a,
+	0x53, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x13, 0x2e, 0x70, 0x77, 0x64,
+	0x6d, 0x2e, 0x53, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x1a,
+	0x11, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x34, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72,
+	0x12, 0x13, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x50, 0x61,
+	0x69, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4b, 0x65, 0x79,
+	0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x15, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a,
+	0x11, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x12, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x12, 0x19, 0x2e, 0x70,
+	0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69,
+	0x74, 0x68, 0x4d, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x12, 0x14, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x32,
+	0xca, 0x07, 0x0a, 0x13, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e,
+	0x70, 0x77, 0x64, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x70, 0x77, 0x64,
+	0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x2e,
+	0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x70, 0x77, 0x64, 0x6d,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70, 0x77,
+	0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x34, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x70, 0x77, 0x64, 0x6d,
+	0x2e, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a,
+	0x0d, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x46,
+	0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x18, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x77,
+	0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x70, 0x77, 0x64,
+	0x6d, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a,
+	0x0d, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x37,
+	0x0a, 0x11, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e,
+	0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70, 0x77,
+	0x64, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x46, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x19, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x11, 0x53, 0x65,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x1a, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x70, 0x77,
+	0x64, 0x6d, 0x2e, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x58, 0x0a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x77,
+	0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x77, 0x64, 0x6d,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3c,
+	0x0a, 0x10, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e,
+	0x70, 0x77, 0x64, 0x6d, 0x2e, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x73, 0x70, 0x42, 0x2a, 0x5a, 0x28,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x69, 0x6c, 0x6c, 0x79,
+	0x42, 0x6f, 0x6e, 0x65, 0x73, 0x30, 0x30, 0x37, 0x2f, 0x70, 0x77, 0x64, 0x6d, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_pwdm_server_proto_rawDescOnce sync.Once
+	file_proto_pwdm_server_proto_rawDescData = file_proto_pwdm_server_proto_rawDesc
+)
+
+func file_proto_pwdm_server_proto_rawDescGZIP() []byte {
+	file_proto_pwdm_server_proto_rawDescOnce.Do(func() {
+		file_proto_pwdm_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_pwdm_server_proto_rawDescData)
+	})
+	return file_proto_pwdm_server_proto_rawDescData
+}
+
+var file_proto_pwdm_server_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_proto_pwdm_server_proto_msgTypes = make([]protoimpl.MessageInfo, 144)
+var file_proto_pwdm_server_proto_goTypes = []interface{}{
+	(WatchEvent_EventType)(0),           // 0: pwdm.WatchEvent.EventType
+	(BatchReq_Operation_Action)(0),      // 1: pwdm.BatchReq.Operation.Action
+	(ListItemsReq_SortBy)(0),            // 2: pwdm.ListItemsReq.SortBy
+	(CustomField_Type)(0),               // 3: pwdm.CustomField.Type
+	(LoginURI_Match)(0),                 // 4: pwdm.LoginURI.Match
+	(*SyncReq)(nil),                     // 5: pwdm.SyncReq
+	(*SyncResp)(nil),                    // 6: pwdm.SyncResp
+	(*WatchReq)(nil),                    // 7: pwdm.WatchReq
+	(*WatchEvent)(nil),                  // 8: pwdm.WatchEvent
+	(*BatchReq)(nil),                    // 9: pwdm.BatchReq
+	(*BatchResp)(nil),                   // 10: pwdm.BatchResp
+	(*ListItemsReq)(nil),                // 11: pwdm.ListItemsReq
+	(*ListItemsResp)(nil),               // 12: pwdm.ListItemsResp
+	(*ListTagsReq)(nil),                 // 13: pwdm.ListTagsReq
+	(*ListTagsResp)(nil),                // 14: pwdm.ListTagsResp
+	(*RenameTagReq)(nil),                // 15: pwdm.RenameTagReq
+	(*MergeTagsReq)(nil),                // 16: pwdm.MergeTagsReq
+	(*DeleteTagReq)(nil),                // 17: pwdm.DeleteTagReq
+	(*TagsResp)(nil),                    // 18: pwdm.TagsResp
+	(*CustomField)(nil),                 // 19: pwdm.CustomField
+	(*CustomFields)(nil),                // 20: pwdm.CustomFields
+	(*SetCustomFieldsReq)(nil),          // 21: pwdm.SetCustomFieldsReq
+	(*CustomFieldsResp)(nil),            // 22: pwdm.CustomFieldsResp
+	(*SetFavoriteReq)(nil),              // 23: pwdm.SetFavoriteReq
+	(*SetFavoriteResp)(nil),             // 24: pwdm.SetFavoriteResp
+	(*FolderModel)(nil),                 // 25: pwdm.FolderModel
+	(*GetFoldersReq)(nil),               // 26: pwdm.GetFoldersReq
+	(*GetFoldersResp)(nil),              // 27: pwdm.GetFoldersResp
+	(*CreateFolderReq)(nil),             // 28: pwdm.CreateFolderReq
+	(*RenameFolderReq)(nil),             // 29: pwdm.RenameFolderReq
+	(*MoveFolderReq)(nil),               // 30: pwdm.MoveFolderReq
+	(*DeleteFolderReq)(nil),             // 31: pwdm.DeleteFolderReq
+	(*MoveItemsReq)(nil),                // 32: pwdm.MoveItemsReq
+	(*FolderResp)(nil),                  // 33: pwdm.FolderResp
+	(*LoginURI)(nil),                    // 34: pwdm.LoginURI
+	(*SetLoginURIsReq)(nil),             // 35: pwdm.SetLoginURIsReq
+	(*GetLoginURIsReq)(nil),             // 36: pwdm.GetLoginURIsReq
+	(*LoginURIsResp)(nil),               // 37: pwdm.LoginURIsResp
+	(*LookupByURLReq)(nil),              // 38: pwdm.LookupByURLReq
+	(*LookupByURLResp)(nil),             // 39: pwdm.LookupByURLResp
+	(*SetTOTPReq)(nil),                  // 40: pwdm.SetTOTPReq
+	(*GetTOTPCodeReq)(nil),              // 41: pwdm.GetTOTPCodeReq
+	(*TOTPResp)(nil),                    // 42: pwdm.TOTPResp
+	(*SSHKeyReq)(nil),                   // 43: pwdm.SSHKeyReq
+	(*GetSSHKeyReq)(nil),                // 44: pwdm.GetSSHKeyReq
+	(*SSHKeyResp)(nil),                  // 45: pwdm.SSHKeyResp
+	(*SSHKeysInfo)(nil),                 // 46: pwdm.SSHKeysInfo
+	(*Identity)(nil),                    // 47: pwdm.Identity
+	(*IdentityReq)(nil),                 // 48: pwdm.IdentityReq
+	(*GetIdentityReq)(nil),              // 49: pwdm.GetIdentityReq
+	(*IdentityResp)(nil),                // 50: pwdm.IdentityResp
+	(*CardsInfo)(nil),                   // 51: pwdm.CardsInfo
+	(*SetExpiryReq)(nil),                // 52: pwdm.SetExpiryReq
+	(*SetExpiryResp)(nil),               // 53: pwdm.SetExpiryResp
+	(*ExpiringItemsReq)(nil),            // 54: pwdm.ExpiringItemsReq
+	(*ExpiringItemsResp)(nil),           // 55: pwdm.ExpiringItemsResp
+	(*BinaryChunk)(nil),                 // 56: pwdm.BinaryChunk
+	(*UploadBinaryReq)(nil),             // 57: pwdm.UploadBinaryReq
+	(*UploadBinaryResp)(nil),            // 58: pwdm.UploadBinaryResp
+	(*UploadStatusReq)(nil),             // 59: pwdm.UploadStatusReq
+	(*DownloadBinaryReq)(nil),           // 60: pwdm.DownloadBinaryReq
+	(*DownloadBinaryResp)(nil),          // 61: pwdm.DownloadBinaryResp
+	(*AttachmentModel)(nil),             // 62: pwdm.AttachmentModel
+	(*ListAttachmentsReq)(nil),          // 63: pwdm.ListAttachmentsReq
+	(*ListAttachmentsResp)(nil),         // 64: pwdm.ListAttachmentsResp
+	(*DownloadAttachmentReq)(nil),       // 65: pwdm.DownloadAttachmentReq
+	(*RemoveAttachmentReq)(nil),         // 66: pwdm.RemoveAttachmentReq
+	(*RemoveAttachmentResp)(nil),        // 67: pwdm.RemoveAttachmentResp
+	(*UsageReq)(nil),                    // 68: pwdm.UsageReq
+	(*SizeModel)(nil),                   // 69: pwdm.SizeModel
+	(*UsageResp)(nil),                   // 70: pwdm.UsageResp
+	(*VaultParams)(nil),                 // 71: pwdm.VaultParams
+	(*SetupVaultReq)(nil),               // 72: pwdm.SetupVaultReq
+	(*SetupVaultResp)(nil),              // 73: pwdm.SetupVaultResp
+	(*GetVaultParamsReq)(nil),           // 74: pwdm.GetVaultParamsReq
+	(*GetVaultParamsResp)(nil),          // 75: pwdm.GetVaultParamsResp
+	(*VaultItem)(nil),                   // 76: pwdm.VaultItem
+	(*PutVaultItemReq)(nil),             // 77: pwdm.PutVaultItemReq
+	(*PutVaultItemResp)(nil),            // 78: pwdm.PutVaultItemResp
+	(*GetVaultItemReq)(nil),             // 79: pwdm.GetVaultItemReq
+	(*GetVaultItemResp)(nil),            // 80: pwdm.GetVaultItemResp
+	(*DeleteVaultItemReq)(nil),          // 81: pwdm.DeleteVaultItemReq
+	(*DeleteVaultItemResp)(nil),         // 82: pwdm.DeleteVaultItemResp
+	(*ListVaultItemsReq)(nil),           // 83: pwdm.ListVaultItemsReq
+	(*ListVaultItemsResp)(nil),          // 84: pwdm.ListVaultItemsResp
+	(*SRPRegisterReq)(nil),              // 85: pwdm.SRPRegisterReq
+	(*SRPRegisterResp)(nil),             // 86: pwdm.SRPRegisterResp
+	(*SRPStartReq)(nil),                 // 87: pwdm.SRPStartReq
+	(*SRPStartResp)(nil),                // 88: pwdm.SRPStartResp
+	(*SRPFinishReq)(nil),                // 89: pwdm.SRPFinishReq
+	(*SRPFinishResp)(nil),               // 90: pwdm.SRPFinishResp
+	(*KeyPair)(nil),                     // 91: pwdm.KeyPair
+	(*SetKeyPairReq)(nil),               // 92: pwdm.SetKeyPairReq
+	(*GetKeyPairReq)(nil),               // 93: pwdm.GetKeyPairReq
+	(*GetPublicKeyReq)(nil),             // 94: pwdm.GetPublicKeyReq
+	(*KeyPairResp)(nil),                 // 95: pwdm.KeyPairResp
+	(*ShareItemReq)(nil),                // 96: pwdm.ShareItemReq
+	(*ShareItemResp)(nil),               // 97: pwdm.ShareItemResp
+	(*ListSharedWithMeReq)(nil),         // 98: pwdm.ListSharedWithMeReq
+	(*SharedItem)(nil),                  // 99: pwdm.SharedItem
+	(*ListSharedWithMeResp)(nil),        // 100: pwdm.ListSharedWithMeResp
+	(*RevokeShareReq)(nil),              // 101: pwdm.RevokeShareReq
+	(*RevokeShareResp)(nil),             // 102: pwdm.RevokeShareResp
+	(*Organization)(nil),                // 103: pwdm.Organization
+	(*OrgMember)(nil),                   // 104: pwdm.OrgMember
+	(*Invitation)(nil),                  // 105: pwdm.Invitation
+	(*Collection)(nil),                  // 106: pwdm.Collection
+	(*CollectionMember)(nil),            // 107: pwdm.CollectionMember
+	(*CreateOrganizationReq)(nil),       // 108: pwdm.CreateOrganizationReq
+	(*CreateOrganizationResp)(nil),      // 109: pwdm.CreateOrganizationResp
+	(*ListOrganizationsReq)(nil),        // 110: pwdm.ListOrganizationsReq
+	(*ListOrganizationsResp)(nil),       // 111: pwdm.ListOrganizationsResp
+	(*ListMembersReq)(nil),              // 112: pwdm.ListMembersReq
+	(*ListMembersResp)(nil),             // 113: pwdm.ListMembersResp
+	(*RemoveMemberReq)(nil),             // 114: pwdm.RemoveMemberReq
+	(*InviteMemberReq)(nil),             // 115: pwdm.InviteMemberReq
+	(*ListInvitationsReq)(nil),          // 116: pwdm.ListInvitationsReq
+	(*ListInvitationsResp)(nil),         // 117: pwdm.ListInvitationsResp
+	(*InvitationReq)(nil),               // 118: pwdm.InvitationReq
+	(*OrgResp)(nil),                     // 119: pwdm.OrgResp
+	(*CreateCollectionReq)(nil),         // 120: pwdm.CreateCollectionReq
+	(*CreateCollectionResp)(nil),        // 121: pwdm.CreateCollectionResp
+	(*ListCollectionsReq)(nil),          // 122: pwdm.ListCollectionsReq
+	(*ListCollectionsResp)(nil),         // 123: pwdm.ListCollectionsResp
+	(*SetCollectionRoleReq)(nil),        // 124: pwdm.SetCollectionRoleReq
+	(*ListCollectionMembersReq)(nil),    // 125: pwdm.ListCollectionMembersReq
+	(*ListCollectionMembersResp)(nil),   // 126: pwdm.ListCollectionMembersResp
+	(*ListCollectionItemsReq)(nil),      // 127: pwdm.ListCollectionItemsReq
+	(*MoveToCollectionReq)(nil),         // 128: pwdm.MoveToCollectionReq
+	(*SyncResp_ChangeModel)(nil),        // 129: pwdm.SyncResp.ChangeModel
+	(*BatchReq_LoginPasswordModel)(nil), // 130: pwdm.BatchReq.LoginPasswordModel
+	(*BatchReq_CardModel)(nil),          // 131: pwdm.BatchReq.CardModel
+	(*BatchReq_TextModel)(nil),          // 132: pwdm.BatchReq.TextModel
+	(*BatchReq_BinaryModel)(nil),        // 133: pwdm.BatchReq.BinaryModel
+	(*BatchReq_SSHKeyModel)(nil),        // 134: pwdm.BatchReq.SSHKeyModel
+	(*BatchReq_Operation)(nil),          // 135: pwdm.BatchReq.Operation
+	(*BatchResp_ResultModel)(nil),       // 136: pwdm.BatchResp.ResultModel
+	(*ListItemsResp_ItemModel)(nil),     // 137: pwdm.ListItemsResp.ItemModel
+	(*ListTagsResp_TagModel)(nil),       // 138: pwdm.ListTagsResp.TagModel
+	(*MoveItemsReq_ItemModel)(nil),      // 139: pwdm.MoveItemsReq.ItemModel
+	(*LookupByURLResp_LoginModel)(nil),  // 140: pwdm.LookupByURLResp.LoginModel
+	(*SSHKeysInfo_KeyModel)(nil),        // 141: pwdm.SSHKeysInfo.KeyModel
+	(*CardsInfo_CardModel)(nil),         // 142: pwdm.CardsInfo.CardModel
+	(*ExpiringItemsResp_ItemModel)(nil), // 143: pwdm.ExpiringItemsResp.ItemModel
+	(*UploadBinaryReq_Header)(nil),      // 144: pwdm.UploadBinaryReq.Header
+	(*DownloadBinaryResp_Header)(nil),   // 145: pwdm.DownloadBinaryResp.Header
+	nil,                                 // 146: pwdm.VaultItem.MetadataEntry
+	nil,                                 // 147: pwdm.PutVaultItemReq.MetadataEntry
+	nil,                                 // 148: pwdm.ListVaultItemsReq.MatchEntry
+	(*timestamppb.Timestamp)(nil),       // 149: google.protobuf.Timestamp
+}
+var file_proto_pwdm_server_proto_depIdxs = []int32{
+	129, // 0: pwdm.SyncResp.changes:type_name -> pwdm.SyncResp.ChangeModel
+	0,   // 1: pwdm.WatchEvent.event:type_name -> pwdm.WatchEvent.EventType
+	135, // 2: pwdm.BatchReq.operations:type_name -> pwdm.BatchReq.Operation
+	136, // 3: pwdm.BatchResp.results:type_name -> pwdm.BatchResp.ResultModel
+	149, // 4: pwdm.ListItemsReq.created_from:type_name -> google.protobuf.Timestamp
+	149, // 5: pwdm.ListItemsReq.created_to:type_name -> google.protobuf.Timestamp
+	149, // 6: pwdm.ListItemsReq.updated_from:type_name -> google.protobuf.Timestamp
+	149, // 7: pwdm.ListItemsReq.updated_to:type_name -> google.protobuf.Timestamp
+	2,   // 8: pwdm.ListItemsReq.sort_by:type_name -> pwdm.ListItemsReq.SortBy
+	137, // 9: pwdm.ListItemsResp.items:type_name -> pwdm.ListItemsResp.ItemModel
+	138, // 10: pwdm.ListTagsResp.tags:type_name -> pwdm.ListTagsResp.TagModel
+	3,   // 11: pwdm.CustomField.type:type_name -> pwdm.CustomField.Type
+	19,  // 12: pwdm.CustomFields.fields:type_name -> pwdm.CustomField
+	19,  // 13: pwdm.SetCustomFieldsReq.fields:type_name -> pwdm.CustomField
+	19,  // 14: pwdm.CustomFieldsResp.fields:type_name -> pwdm.CustomField
+	25,  // 15: pwdm.FolderModel.folders:type_name -> pwdm.FolderModel
+	137, // 16: pwdm.FolderModel.items:type_name -> pwdm.ListItemsResp.ItemModel
+	25,  // 17: pwdm.GetFoldersResp.folder:type_name -> pwdm.FolderModel
+	139, // 18: pwdm.MoveItemsReq.items:type_name -> pwdm.MoveItemsReq.ItemModel
+	4,   // 19: pwdm.LoginURI.match:type_name -> pwdm.LoginURI.Match
+	34,  // 20: pwdm.SetLoginURIsReq.uris:type_name -> pwdm.LoginURI
+	34,  // 21: pwdm.LoginURIsResp.uris:type_name -> pwdm.LoginURI
+	140, // 22: pwdm.LookupByURLResp.logins:type_name -> pwdm.LookupByURLResp.LoginModel
+	19,  // 23: pwdm.SSHKeyResp.fields:type_name -> pwdm.CustomField
+	141, // 24: pwdm.SSHKeysInfo.keys:type_name -> pwdm.SSHKeysInfo.KeyModel
+	47,  // 25: pwdm.IdentityReq.identity:type_name -> pwdm.Identity
+	47,  // 26: pwdm.IdentityResp.identity:type_name -> pwdm.Identity
+	19,  // 27: pwdm.IdentityResp.fields:type_name -> pwdm.CustomField
+	142, // 28: pwdm.CardsInfo.cards:type_name -> pwdm.CardsInfo.CardModel
+	143, // 29: pwdm.ExpiringItemsResp.items:type_name -> pwdm.ExpiringItemsResp.ItemModel
+	144, // 30: pwdm.UploadBinaryReq.header:type_name -> pwdm.UploadBinaryReq.Header
+	56,  // 31: pwdm.UploadBinaryReq.chunk:type_name -> pwdm.BinaryChunk
+	145, // 32: pwdm.DownloadBinaryResp.header:type_name -> pwdm.DownloadBinaryResp.Header
+	56,  // 33: pwdm.DownloadBinaryResp.chunk:type_name -> pwdm.BinaryChunk
+	149, // 34: pwdm.AttachmentModel.created_at:type_name -> google.protobuf.Timestamp
+	62,  // 35: pwdm.ListAttachmentsResp.attachments:type_name -> pwdm.AttachmentModel
+	69,  // 36: pwdm.UsageResp.text:type_name -> pwdm.SizeModel
+	69,  // 37: pwdm.UsageResp.binary:type_name -> pwdm.SizeModel
+	69,  // 38: pwdm.UsageResp.attachments:type_name -> pwdm.SizeModel
+	69,  // 39: pwdm.UsageResp.total:type_name -> pwdm.SizeModel
+	71,  // 40: pwdm.SetupVaultReq.params:type_name -> pwdm.VaultParams
+	71,  // 41: pwdm.GetVaultParamsResp.params:type_name -> pwdm.VaultParams
+	146, // 42: pwdm.VaultItem.metadata:type_name -> pwdm.VaultItem.MetadataEntry
+	149, // 43: pwdm.VaultItem.created_at:type_name -> google.protobuf.Timestamp
+	149, // 44: pwdm.VaultItem.updated_at:type_name -> google.protobuf.Timestamp
+	147, // 45: pwdm.PutVaultItemReq.metadata:type_name -> pwdm.PutVaultItemReq.MetadataEntry
+	76,  // 46: pwdm.GetVaultItemResp.item:type_name -> pwdm.VaultItem
+	148, // 47: pwdm.ListVaultItemsReq.match:type_name -> pwdm.ListVaultItemsReq.MatchEntry
+	76,  // 48: pwdm.ListVaultItemsResp.items:type_name -> pwdm.VaultItem
+	91,  // 49: pwdm.SetKeyPairReq.key_pair:type_name -> pwdm.KeyPair
+	91,  // 50: pwdm.KeyPairResp.key_pair:type_name -> pwdm.KeyPair
+	76,  // 51: pwdm.SharedItem.item:type_name -> pwdm.VaultItem
+	149, // 52: pwdm.SharedItem.shared_at:type_name -> google.protobuf.Timestamp
+	99,  // 53: pwdm.ListSharedWithMeResp.items:type_name -> pwdm.SharedItem
+	149, // 54: pwdm.Organization.created_at:type_name -> google.protobuf.Timestamp
+	149, // 55: pwdm.OrgMember.joined_at:type_name -> google.protobuf.Timestamp
+	149, // 56: pwdm.Invitation.created_at:type_name -> google.protobuf.Timestamp
+	149, // 57: pwdm.Collection.created_at:type_name -> google.protobuf.Timestamp
+	103, // 58: pwdm.ListOrganizationsResp.organizations:type_name -> pwdm.Organization
+	104, // 59: pwdm.ListMembersResp.members:type_name -> pwdm.OrgMember
+	105, // 60: pwdm.ListInvitationsResp.invitations:type_name -> pwdm.Invitation
+	106, // 61: pwdm.ListCollectionsResp.collections:type_name -> pwdm.Collection
+	107, // 62: pwdm.ListCollectionMembersResp.members:type_name -> pwdm.CollectionMember
+	1,   // 63: pwdm.BatchReq.Operation.action:type_name -> pwdm.BatchReq.Operation.Action
+	130, // 64: pwdm.BatchReq.Operation.login_password:type_name -> pwdm.BatchReq.LoginPasswordModel
+	131, // 65: pwdm.BatchReq.Operation.card:type_name -> pwdm.BatchReq.CardModel
+	132, // 66: pwdm.BatchReq.Operation.text:type_name -> pwdm.BatchReq.TextModel
+	133, // 67: pwdm.BatchReq.Operation.binary:type_name -> pwdm.BatchReq.BinaryModel
+	47,  // 68: pwdm.BatchReq.Operation.identity:type_name -> pwdm.Identity
+	134, // 69: pwdm.BatchReq.Operation.ssh_key:type_name -> pwdm.BatchReq.SSHKeyModel
+	149, // 70: pwdm.ListItemsResp.ItemModel.created_at:type_name -> google.protobuf.Timestamp
+	149, // 71: pwdm.ListItemsResp.ItemModel.updated_at:type_name -> google.protobuf.Timestamp
+	19,  // 72: pwdm.ListItemsResp.ItemModel.fields:type_name -> pwdm.CustomField
+	149, // 73: pwdm.ListItemsResp.ItemModel.last_accessed_at:type_name -> google.protobuf.Timestamp
+	34,  // 74: pwdm.LookupByURLResp.LoginModel.uris:type_name -> pwdm.LoginURI
+	5,   // 75: pwdm.SyncService.Sync:input_type -> pwdm.SyncReq
+	7,   // 76: pwdm.WatchService.Watch:input_type -> pwdm.WatchReq
+	9,   // 77: pwdm.BatchService.Batch:input_type -> pwdm.BatchReq
+	11,  // 78: pwdm.ItemsService.ListItems:input_type -> pwdm.ListItemsReq
+	13,  // 79: pwdm.ItemsService.ListTags:input_type -> pwdm.ListTagsReq
+	15,  // 80: pwdm.ItemsService.RenameTag:input_type -> pwdm.RenameTagReq
+	16,  // 81: pwdm.ItemsService.MergeTags:input_type -> pwdm.MergeTagsReq
+	17,  // 82: pwdm.ItemsService.DeleteTag:input_type -> pwdm.DeleteTagReq
+	21,  // 83: pwdm.ItemsService.SetCustomFields:input_type -> pwdm.SetCustomFieldsReq
+	23,  // 84: pwdm.ItemsService.SetFavorite:input_type -> pwdm.SetFavoriteReq
+	26,  // 85: pwdm.FoldersService.GetFolders:input_type -> pwdm.GetFoldersReq
+	28,  // 86: pwdm.FoldersService.CreateFolder:input_type -> pwdm.CreateFolderReq
+	29,  // 87: pwdm.FoldersService.RenameFolder:input_type -> pwdm.RenameFolderReq
+	30,  // 88: pwdm.FoldersService.MoveFolder:input_type -> pwdm.MoveFolderReq
+	31,  // 89: pwdm.FoldersService.DeleteFolder:input_type -> pwdm.DeleteFolderReq
+	32,  // 90: pwdm.FoldersService.MoveItems:input_type -> pwdm.MoveItemsReq
+	35,  // 91: pwdm.AutofillService.SetLoginURIs:input_type -> pwdm.SetLoginURIsReq
+	36,  // 92: pwdm.AutofillService.GetLoginURIs:input_type -> pwdm.GetLoginURIsReq
+	38,  // 93: pwdm.AutofillService.LookupByURL:input_type -> pwdm.LookupByURLReq
+	40,  // 94: pwdm.TOTPService.SetTOTP:input_type -> pwdm.SetTOTPReq
+	41,  // 95: pwdm.TOTPService.GetTOTPCode:input_type -> pwdm.GetTOTPCodeReq
+	43,  // 96: pwdm.SSHKeyService.InsSSHKey:input_type -> pwdm.SSHKeyReq
+	44,  // 97: pwdm.SSHKeyService.GetSSHKey:input_type -> pwdm.GetSSHKeyReq
+	43,  // 98: pwdm.SSHKeyService.UpdateSSHKey:input_type -> pwdm.SSHKeyReq
+	48,  // 99: pwdm.IdentityService.InsIdentity:input_type -> pwdm.IdentityReq
+	49,  // 100: pwdm.IdentityService.GetIdentity:input_type -> pwdm.GetIdentityReq
+	48,  // 101: pwdm.IdentityService.UpdateIdentity:input_type -> pwdm.IdentityReq
+	52,  // 102: pwdm.ExpiryService.SetExpiry:input_type -> pwdm.SetExpiryReq
+	54,  // 103: pwdm.ExpiryService.ExpiringItems:input_type -> pwdm.ExpiringItemsReq
+	57,  // 104: pwdm.BinaryService.UploadBinary:input_type -> pwdm.UploadBinaryReq
+	59,  // 105: pwdm.BinaryService.UploadStatus:input_type -> pwdm.UploadStatusReq
+	60,  // 106: pwdm.BinaryService.DownloadBinary:input_type -> pwdm.DownloadBinaryReq
+	57,  // 107: pwdm.AttachmentService.Attach:input_type -> pwdm.UploadBinaryReq
+	63,  // 108: pwdm.AttachmentService.ListAttachments:input_type -> pwdm.ListAttachmentsReq
+	65,  // 109: pwdm.AttachmentService.DownloadAttachment:input_type -> pwdm.DownloadAttachmentReq
+	66,  // 110: pwdm.AttachmentService.RemoveAttachment:input_type -> pwdm.RemoveAttachmentReq
+	68,  // 111: pwdm.UsageService.GetUsage:input_type -> pwdm.UsageReq
+	72,  // 112: pwdm.VaultService.SetupVault:input_type -> pwdm.SetupVaultReq
+	74,  // 113: pwdm.VaultService.GetVaultParams:input_type -> pwdm.GetVaultParamsReq
+	77,  // 114: pwdm.VaultService.PutVaultItem:input_type -> pwdm.PutVaultItemReq
+	79,  // 115: pwdm.VaultService.GetVaultItem:input_type -> pwdm.GetVaultItemReq
+	81,  // 116: pwdm.VaultService.DeleteVaultItem:input_type -> pwdm.DeleteVaultItemReq
+	83,  // 117: pwdm.VaultService.ListVaultItems:input_type -> pwdm.ListVaultItemsReq
+	85,  // 118: pwdm.SRPService.Register:input_type -> pwdm.SRPRegisterReq
+	87,  // 119: pwdm.SRPService.StartLogin:input_type -> pwdm.SRPStartReq
+	89,  // 120: pwdm.SRPService.FinishLogin:input_type -> pwdm.SRPFinishReq
+	92,  // 121: pwdm.ShareService.SetKeyPair:input_type -> pwdm.SetKeyPairReq
+	93,  // 122: pwdm.ShareService.GetKeyPair:input_type -> pwdm.GetKeyPairReq
+	94,  // 123: pwdm.ShareService.GetPublicKey:input_type -> pwdm.GetPublicKeyReq
+	96,  // 124: pwdm.ShareService.ShareItem:input_type -> pwdm.ShareItemReq
+	98,  // 125: pwdm.ShareService.ListSharedWithMe:input_type -> pwdm.ListSharedWithMeReq
+	101, // 126: pwdm.ShareService.RevokeShare:input_type -> pwdm.RevokeShareReq
+	108, // 127: pwdm.OrganizationService.CreateOrganization:input_type -> pwdm.CreateOrganizationReq
+	110, // 128: pwdm.OrganizationService.ListOrganizations:input_type -> pwdm.ListOrganizationsReq
+	112, // 129: pwdm.OrganizationService.ListMembers:input_type -> pwdm.ListMembersReq
+	114, // 130: pwdm.OrganizationService.RemoveMember:input_type -> pwdm.RemoveMemberReq
+	115, // 131: pwdm.OrganizationService.InviteMember:input_type -> pwdm.InviteMemberReq
+	116, // 132: pwdm.OrganizationService.ListInvitations:input_type -> pwdm.ListInvitationsReq
+	118, // 133: pwdm.OrganizationService.AcceptInvitation:input_type -> pwdm.InvitationReq
+	118, // 134: pwdm.OrganizationService.DeclineInvitation:input_type -> pwdm.InvitationReq
+	120, // 135: pwdm.OrganizationService.CreateCollection:input_type -> pwdm.CreateCollectionReq
+	122, // 136: pwdm.OrganizationService.ListCollections:input_type -> pwdm.ListCollectionsReq
+	124, // 137: pwdm.OrganizationService.SetCollectionRole:input_type -> pwdm.SetCollectionRoleReq
+	125, // 138: pwdm.OrganizationService.ListCollectionMembers:input_type -> pwdm.ListCollectionMembersReq
+	127, // 139: pwdm.OrganizationService.ListCollectionItems:input_type -> pwdm.ListCollectionItemsReq
+	128, // 140: pwdm.OrganizationService.MoveToCollection:input_type -> pwdm.MoveToCollectionReq
+	6,   // 141: pwdm.SyncService.Sync:output_type -> pwdm.SyncResp
+	8,   // 142: pwdm.WatchService.Watch:output_type -> pwdm.WatchEvent
+	10,  // 143: pwdm.BatchService.Batch:output_type -> pwdm.BatchResp
+	12,  // 144: pwdm.ItemsService.ListItems:output_type -> pwdm.ListItemsResp
+	14,  // 145: pwdm.ItemsService.ListTags:output_type -> pwdm.ListTagsResp
+	18,  // 146: pwdm.ItemsService.RenameTag:output_type -> pwdm.TagsResp
+	18,  // 147: pwdm.ItemsService.MergeTags:output_type -> pwdm.TagsResp
+	18,  // 148: pwdm.ItemsService.DeleteTag:output_type -> pwdm.TagsResp
+	22,  // 149: pwdm.ItemsService.SetCustomFields:output_type -> pwdm.CustomFieldsResp
+	24,  // 150: pwdm.ItemsService.SetFavorite:output_type -> pwdm.SetFavoriteResp
+	27,  // 151: pwdm.FoldersService.GetFolders:output_type -> pwdm.GetFoldersResp
+	33,  // 152: pwdm.FoldersService.CreateFolder:output_type -> pwdm.FolderResp
+	33,  // 153: pwdm.FoldersService.RenameFolder:output_type -> pwdm.FolderResp
+	33,  // 154: pwdm.FoldersService.MoveFolder:output_type -> pwdm.FolderResp
+	33,  // 155: pwdm.FoldersService.DeleteFolder:output_type -> pwdm.FolderResp
+	33,  // 156: pwdm.FoldersService.MoveItems:output_type -> pwdm.FolderResp
+	37,  // 157: pwdm.AutofillService.SetLoginURIs:output_type -> pwdm.LoginURIsResp
+	37,  // 158: pwdm.AutofillService.GetLoginURIs:output_type -> pwdm.LoginURIsResp
+	39,  // 159: pwdm.AutofillService.LookupByURL:output_type -> pwdm.LookupByURLResp
+	42,  // 160: pwdm.TOTPService.SetTOTP:output_type -> pwdm.TOTPResp
+	42,  // 161: pwdm.TOTPService.GetTOTPCode:output_type -> pwdm.TOTPResp
+	45,  // 162: pwdm.SSHKeyService.InsSSHKey:output_type -> pwdm.SSHKeyResp
+	45,  // 163: pwdm.SSHKeyService.GetSSHKey:output_type -> pwdm.SSHKeyResp
+	45,  // 164: pwdm.SSHKeyService.UpdateSSHKey:output_type -> pwdm.SSHKeyResp
+	50,  // 165: pwdm.IdentityService.InsIdentity:output_type -> pwdm.IdentityResp
+	50,  // 166: pwdm.IdentityService.GetIdentity:output_type -> pwdm.IdentityResp
+	50,  // 167: pwdm.IdentityService.UpdateIdentity:output_type -> pwdm.IdentityResp
+	53,  // 168: pwdm.ExpiryService.SetExpiry:output_type -> pwdm.SetExpiryResp
+	55,  // 169: pwdm.ExpiryService.ExpiringItems:output_type -> pwdm.ExpiringItemsResp
+	58,  // 170: pwdm.BinaryService.UploadBinary:output_type -> pwdm.UploadBinaryResp
+	58,  // 171: pwdm.BinaryService.UploadStatus:output_type -> pwdm.UploadBinaryResp
+	61,  // 172: pwdm.BinaryService.DownloadBinary:output_type -> pwdm.DownloadBinaryResp
+	58,  // 173: pwdm.AttachmentService.Attach:output_type -> pwdm.UploadBinaryResp
+	64,  // 174: pwdm.AttachmentService.ListAttachments:output_type -> pwdm.ListAttachmentsResp
+	61,  // 175: pwdm.AttachmentService.DownloadAttachment:output_type -> pwdm.DownloadBinaryResp
+	67,  // 176: pwdm.AttachmentService.RemoveAttachment:output_type -> pwdm.RemoveAttachmentResp
+	70,  // 177: pwdm.UsageService.GetUsage:output_type -> pwdm.UsageResp
+	73,  // 178: pwdm.VaultService.SetupVault:output_type -> pwdm.SetupVaultResp
+	75,  // 179: pwdm.VaultService.GetVaultParams:output_type -> pwdm.GetVaultParamsResp
+	78,  // 180: pwdm.VaultService.PutVaultItem:output_type -> pwdm.PutVaultItemResp
+	80,  // 181: pwdm.VaultService.GetVaultItem:output_type -> pwdm.GetVaultItemResp
+	82,  // 182: pwdm.VaultService.DeleteVaultItem:output_type -> pwdm.DeleteVaultItemResp
+	84,  // 183: pwdm.VaultService.ListVaultItems:output_type -> pwdm.ListVaultItemsResp
+	86,  // 184: pwdm.SRPService.Register:output_type -> pwdm.SRPRegisterResp
+	88,  // 185: pwdm.SRPService.StartLogin:output_type -> pwdm.SRPStartResp
+	90,  // 186: pwdm.SRPService.FinishLogin:output_type -> pwdm.SRPFinishResp
+	95,  // 187: pwdm.ShareService.SetKeyPair:output_type -> pwdm.KeyPairResp
+	95,  // 188: pwdm.ShareService.GetKeyPair:output_type -> pwdm.KeyPairResp
+	95,  // 189: pwdm.ShareService.GetPublicKey:output_type -> pwdm.KeyPairResp
+	97,  // 190: pwdm.ShareService.ShareItem:output_type -> pwdm.ShareItemResp
+	100, // 191: pwdm.ShareService.ListSharedWithMe:output_type -> pwdm.ListSharedWithMeResp
+	102, // 192: pwdm.ShareService.RevokeShare:output_type -> pwdm.RevokeShareResp
+	109, // 193: pwdm.OrganizationService.CreateOrganization:output_type -> pwdm.CreateOrganizationResp
+	111, // 194: pwdm.OrganizationService.ListOrganizations:output_type -> pwdm.ListOrganizationsResp
+	113, // 195: pwdm.OrganizationService.ListMembers:output_type -> pwdm.ListMembersResp
+	119, // 196: pwdm.OrganizationService.RemoveMember:output_type -> pwdm.OrgResp
+	119, // 197: pwdm.OrganizationService.InviteMember:output_type -> pwdm.OrgResp
+	117, // 198: pwdm.OrganizationService.ListInvitations:output_type -> pwdm.ListInvitationsResp
+	119, // 199: pwdm.OrganizationService.AcceptInvitation:output_type -> pwdm.OrgResp
+	119, // 200: pwdm.OrganizationService.DeclineInvitation:output_type -> pwdm.OrgResp
+	121, // 201: pwdm.OrganizationService.CreateCollection:output_type -> pwdm.CreateCollectionResp
+	123, // 202: pwdm.OrganizationService.ListCollections:output_type -> pwdm.ListCollectionsResp
+	119, // 203: pwdm.OrganizationService.SetCollectionRole:output_type -> pwdm.OrgResp
+	126, // 204: pwdm.OrganizationService.ListCollectionMembers:output_type -> pwdm.ListCollectionMembersResp
+	12,  // 205: pwdm.OrganizationService.ListCollectionItems:output_type -> pwdm.ListItemsResp
+	119, // 206: pwdm.OrganizationService.MoveToCollection:output_type -> pwdm.OrgResp
+	141, // [141:207] is the sub-list for method output_type
+	75,  // [75:141] is the sub-list for method input_type
+	75,  // [75:75] is the sub-list for extension type_name
+	75,  // [75:75] is the sub-list for extension extendee
+	0,   // [0:75] is the sub-list for field type_name
+}
+
+func init() { file_proto_pwdm_server_proto_init() }
+func file_proto_pwdm_server_proto_init() {
+	if File_proto_pwdm_server_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_pwdm_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListItemsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameTagReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeTagsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTagReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCustomFieldsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomFieldsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFavoriteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFavoriteResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FolderModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFoldersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFoldersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFolderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameFolderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveFolderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFolderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FolderResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginURI); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLoginURIsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoginURIsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginURIsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupByURLReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupByURLResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTOTPReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTOTPCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TOTPResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHKeyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSSHKeyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHKeyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHKeysInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Identity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdentityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIdentityReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdentityResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardsInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetExpiryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetExpiryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpiringItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpiringItemsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BinaryChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadBinaryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadBinaryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadBinaryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadBinaryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachmentModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAttachmentsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAttachmentsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadAttachmentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAttachmentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAttachmentResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SizeModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsageResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetupVaultReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetupVaultResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVaultParamsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVaultParamsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutVaultItemReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutVaultItemResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVaultItemReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVaultItemResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteVaultItemReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteVaultItemResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVaultItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVaultItemsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SRPRegisterReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SRPRegisterResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SRPStartReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SRPStartResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SRPFinishReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SRPFinishResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyPair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetKeyPairReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetKeyPairReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPublicKeyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyPairResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareItemReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareItemResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSharedWithMeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSharedWithMeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeShareReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeShareResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Organization); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrgMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invitation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Collection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrganizationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrganizationResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrganizationsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrganizationsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMembersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMembersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveMemberReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteMemberReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInvitationsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInvitationsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvitationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCollectionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCollectionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCollectionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCollectionsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCollectionRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCollectionMembersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCollectionMembersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCollectionItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveToCollectionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncResp_ChangeModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchReq_LoginPasswordModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchReq_CardModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchReq_TextModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchReq_BinaryModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchReq_SSHKeyModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchReq_Operation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResp_ResultModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListItemsResp_ItemModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsResp_TagModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveItemsReq_ItemModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupByURLResp_LoginModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SSHKeysInfo_KeyModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardsInfo_CardModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExpiringItemsResp_ItemModel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadBinaryReq_Header); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_pwdm_server_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadBinaryResp_Header); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_pwdm_server_proto_msgTypes[52].OneofWrappers = []interface{}{
+		(*UploadBinaryReq_Header_)(nil),
+		(*UploadBinaryReq_Chunk)(nil),
+	}
+	file_proto_pwdm_server_proto_msgTypes[56].OneofWrappers = []interface{}{
+		(*DownloadBinaryResp_Header_)(nil),
+		(*DownloadBinaryResp_Chunk)(nil),
+	}
+	file_proto_pwdm_server_proto_msgTypes[130].OneofWrappers = []interface{}{
+		(*BatchReq_Operation_LoginPassword)(nil),
+		(*BatchReq_Operation_Card)(nil),
+		(*BatchReq_Operation_Text)(nil),
+		(*BatchReq_Operation_Binary)(nil),
+		(*BatchReq_Operation_Identity)(nil),
+		(*BatchReq_Operation_SshKey)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_pwdm_server_proto_rawDesc,
+			NumEnums:      5,
+			NumMessages:   144,
+			NumExtensions: 0,
+			NumServices:   17,
+		},
+		GoTypes:           file_proto_pwdm_server_proto_goTypes,
+		DependencyIndexes: file_proto_pwdm_server_proto_depIdxs,
+		EnumInfos:         file_proto_pwdm_server_proto_enumTypes,
+		MessageInfos:      file_proto_pwdm_server_proto_msgTypes,
+	}.Build()
+	File_proto_pwdm_server_proto = out.File
+	file_proto_pwdm_server_proto_rawDesc = nil
+	file_proto_pwdm_server_proto_goTypes = nil
+	file_proto_pwdm_server_proto_depIdxs = nil
+}
@@ -0,0 +1,3513 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.12.4
+// source: proto/pwdm_server.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	SyncService_Sync_FullMethodName = "/pwdm.SyncService/Sync"
+)
+
+// SyncServiceClient is the client API for SyncService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SyncServiceClient interface {
+	Sync(ctx context.Context, in *SyncReq, opts ...grpc.CallOption) (*SyncResp, error)
+}
+
+type syncServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSyncServiceClient(cc grpc.ClientConnInterface) SyncServiceClient {
+	return &syncServiceClient{cc}
+}
+
+func (c *syncServiceClient) Sync(ctx context.Context, in *SyncReq, opts ...grpc.CallOption) (*SyncResp, error) {
+	out := new(SyncResp)
+	err := c.cc.Invoke(ctx, SyncService_Sync_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SyncServiceServer is the server API for SyncService service.
+// All implementations must embed UnimplementedSyncServiceServer
+// for forward compatibility
+type SyncServiceServer interface {
+	Sync(context.Context, *SyncReq) (*SyncResp, error)
+	mustEmbedUnimplementedSyncServiceServer()
+}
+
+// UnimplementedSyncServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSyncServiceServer struct {
+}
+
+func (UnimplementedSyncServiceServer) Sync(context.Context, *SyncReq) (*SyncResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
+}
+func (UnimplementedSyncServiceServer) mustEmbedUnimplementedSyncServiceServer() {}
+
+// UnsafeSyncServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SyncServiceServer will
+// result in compilation errors.
+type UnsafeSyncServiceServer interface {
+	mustEmbedUnimplementedSyncServiceServer()
+}
+
+func RegisterSyncServiceServer(s grpc.ServiceRegistrar, srv SyncServiceServer) {
+	s.RegisterService(&SyncService_ServiceDesc, srv)
+}
+
+func _SyncService_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServiceServer).Sync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SyncService_Sync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServiceServer).Sync(ctx, req.(*SyncReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SyncService_ServiceDesc is the grpc.ServiceDesc for SyncService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SyncService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pwdm.SyncService",
+	HandlerType: (*SyncServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Sync",
+			Handler:    _SyncService_Sync_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/pwdm_server.proto",
+}
+
+const (
+	WatchService_Watch_FullMethodName = "/pwdm.WatchService/Watch"
+)
+
+// WatchServiceClient is the client API for WatchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WatchServiceClient interface {
+	Watch(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (WatchService_WatchClient, error)
+}
+
+type watchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWatchServiceClient(cc grpc.ClientConnInterface) WatchServiceClient {
+	return &watchServiceClient{cc}
+}
+
+func (c *watchServiceClient) Watch(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (WatchService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WatchService_ServiceDesc.Streams[0], WatchService_Watch_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &watchServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WatchService_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type watchServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *watchServiceWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WatchServiceServer is the server API for WatchService service.
+// All implementations must embed UnimplementedWatchServiceServer
+// for forward compatibility
+type WatchServiceServer interface {
+	Watch(*WatchReq, WatchService_WatchServer) error
+	mustEmbedUnimplementedWatchServiceServer()
+}
+
+// UnimplementedWatchServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWatchServiceServer struct {
+}
+
+func (UnimplementedWatchServiceServer) Watch(*WatchReq, WatchService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedWatchServiceServer) mustEmbedUnimplementedWatchServiceServer() {}
+
+// UnsafeWatchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WatchServiceServer will
+// result in compilation errors.
+type UnsafeWatchServiceServer interface {
+	mustEmbedUnimplementedWatchServiceServer()
+}
+
+func RegisterWatchServiceServer(s grpc.ServiceRegistrar, srv WatchServiceServer) {
+	s.RegisterService(&WatchService_ServiceDesc, srv)
+}
+
+func _WatchService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WatchServiceServer).Watch(m, &watchServiceWatchServer{stream})
+}
+
+type WatchService_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type watchServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *watchServiceWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// WatchService_ServiceDesc is the grpc.ServiceDesc for WatchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WatchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pwdm.WatchService",
+	HandlerType: (*WatchServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _WatchService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/pwdm_server.proto",
+}
+
+const (
+	BatchService_Batch_FullMethodName = "/pwdm.BatchService/Batch"
+)
+
+// BatchServiceClient is the client API for BatchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BatchServiceClient interface {
+	Batch(ctx context.Context, in *BatchReq, opts ...grpc.CallOption) (*BatchResp, error)
+}
+
+type batchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBatchServiceClient(cc grpc.ClientConnInterface) BatchServiceClient {
+	return &batchServiceClient{cc}
+}
+
+func (c *batchServiceClient) Batch(ctx context.Context, in *BatchReq, opts ...grpc.CallOption) (*BatchResp, error) {
+	out := new(BatchResp)
+	err := c.cc.Invoke(ctx, BatchService_Batch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BatchServiceServer is the server API for BatchService service.
+// All implementations must embed UnimplementedBatchServiceServer
+// for forward compatibility
+type BatchServiceServer interface {
+	Batch(context.Context, *BatchReq) (*BatchResp, error)
+	mustEmbedUnimplementedBatchServiceServer()
+}
+
+// UnimplementedBatchServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBatchServiceServer struct {
+}
+
+func (UnimplementedBatchServiceServer) Batch(context.Context, *BatchReq) (*BatchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (UnimplementedBatchServiceServer) mustEmbedUnimplementedBatchServiceServer() {}
+
+// UnsafeBatchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BatchServiceServer will
+// result in compilation errors.
+type UnsafeBatchServiceServer interface {
+	mustEmbedUnimplementedBatchServiceServer()
+}
+
+func RegisterBatchServiceServer(s grpc.ServiceRegistrar, srv BatchServiceServer) {
+	s.RegisterService(&BatchService_ServiceDesc, srv)
+}
+
+func _BatchService_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BatchServiceServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BatchService_Batch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BatchServiceServer).Batch(ctx, req.(*BatchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BatchService_ServiceDesc is the grpc.ServiceDesc for BatchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BatchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pwdm.BatchService",
+	HandlerType: (*BatchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Batch",
+			Handler:    _BatchService_Batch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/pwdm_server.proto",
+}
+
+const (
+	ItemsService_ListItems_FullMethodName       = "/pwdm.ItemsService/ListItems"
+	ItemsService_ListTags_FullMethodName        = "/pwdm.ItemsService/ListTags"
+	ItemsService_RenameTag_FullMethodName       = "/pwdm.ItemsService/RenameTag"
+	ItemsService_MergeTags_FullMethodName       = "/pwdm.ItemsService/MergeTags"
+	ItemsService_DeleteTag_FullMethodName       = "/pwdm.ItemsService/DeleteTag"
+	ItemsService_SetCustomFields_FullMethodName = "/pwdm.ItemsService/SetCustomFields"
+	ItemsService_SetFavorite_FullMethodName     = "/pwdm.ItemsService/SetFavorite"
+)
+
+// ItemsServiceClient is the client API for ItemsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ItemsServiceClient interface {
+	ListItems(ctx context.Context, in *ListItemsReq, opts ...grpc.CallOption) (*ListItemsResp, error)
+	ListTags(ctx context.Context, in *ListTagsReq, opts ...grpc.CallOption) (*ListTagsResp, error)
+	RenameTag(ctx context.Context, in *RenameTagReq, opts ...grpc.CallOption) (*TagsResp, error)
+	MergeTags(ctx context.Context, in *MergeTagsReq, opts ...grpc.CallOption) (*TagsResp, error)
+	DeleteTag(ctx context.Context, in *DeleteTagReq, opts ...grpc.CallOption) (*TagsResp, error)
+	SetCustomFields(ctx context.Context, in *SetCustomFieldsReq, opts ...grpc.CallOption) (*CustomFieldsResp, error)
+	SetFavorite(ctx context.Context, in *SetFavoriteReq, opts ...grpc.CallOption) (*SetFavoriteResp, error)
+}
+
+type itemsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewItemsServiceClient(cc grpc.ClientConnInterface) ItemsServiceClient {
+	return &itemsServiceClient{cc}
+}
+
+func (c *itemsServiceClient) ListItems(ctx context.Context, in *ListItemsReq, opts ...grpc.CallOption) (*ListItemsResp, error) {
+	out := new(ListItemsResp)
+	err := c.cc.Invoke(ctx, ItemsService_ListItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemsServiceClient) ListTags(ctx context.Context, in *ListTagsReq, opts ...grpc.CallOption) (*ListTagsResp, error) {
+	out := new(ListTagsResp)
+	err := c.cc.Invoke(ctx, ItemsService_ListTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemsServiceClient) RenameTag(ctx context.Context, in *RenameTagReq, opts ...grpc.CallOption) (*TagsResp, error) {
+	out := new(TagsResp)
+	err := c.cc.Invoke(ctx, ItemsService_RenameTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemsServiceClient) MergeTags(ctx context.Context, in *MergeTagsReq, opts ...grpc.CallOption) (*TagsResp, error) {
+	out := new(TagsResp)
+	err := c.cc.Invoke(ctx, ItemsService_MergeTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemsServiceClient) DeleteTag(ctx context.Context, in *DeleteTagReq, opts ...grpc.CallOption) (*TagsResp, error) {
+	out := new(TagsResp)
+	err := c.cc.Invoke(ctx, ItemsService_DeleteTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemsServiceClient) SetCustomFields(ctx context.Context, in *SetCustomFieldsReq, opts ...grpc.CallOption) (*CustomFieldsResp, error) {
+	out := new(CustomFieldsResp)
+	err := c.cc.Invoke(ctx, ItemsService_SetCustomFields_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemsServiceClient) SetFavorite(ctx context.Context, in *SetFavoriteReq, opts ...grpc.CallOption) (*SetFavoriteResp, error) {
+	out := new(SetFavoriteResp)
+	err := c.cc.Invoke(ctx, ItemsService_SetFavorite_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ItemsServiceServer is the server API for ItemsService service.
+// All implementations must embed UnimplementedItemsServiceServer
+// for forward compatibility
+type ItemsServiceServer interface {
+	ListItems(context.Context, *ListItemsReq) (*ListItemsResp, error)
+	ListTags(context.Context, *ListTagsReq) (*ListTagsResp, error)
+	RenameTag(context.Context, *RenameTagReq) (*TagsResp, error)
+	MergeTags(context.Context, *MergeTagsReq) (*TagsResp, error)
+	DeleteTag(context.Context, *DeleteTagReq) (*TagsResp, error)
+	SetCustomFields(context.Context, *SetCustomFieldsReq) (*CustomFieldsResp, error)
+	SetFavorite(context.Context, *SetFavoriteReq) (*SetFavoriteResp, error)
+	mustEmbedUnimplementedItemsServiceServer()
+}
+
+// UnimplementedItemsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedItemsServiceServer struct {
+}
+
+func (UnimplementedItemsServiceServer) ListItems(context.Context, *ListItemsReq) (*ListItemsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItems not implemented")
+}
+func (UnimplementedItemsServiceServer) ListTags(context.Context, *ListTagsReq) (*ListTagsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedItemsServiceServer) RenameTag(context.Context, *RenameTagReq) (*TagsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedItemsServiceServer) MergeTags(context.Context, *MergeTagsReq) (*TagsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTags not implemented")
+}
+func (UnimplementedItemsServiceServer) DeleteTag(context.Context, *DeleteTagReq) (*TagsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedItemsServiceServer) SetCustomFields(context.Context, *SetCustomFieldsReq) (*CustomFieldsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCustomFields not implemented")
+}
+func (UnimplementedItemsServiceServer) SetFavorite(context.Context, *SetFavoriteReq) (*SetFavoriteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFavorite not implemented")
+}
+func (UnimplementedItemsServiceServer) mustEmbedUnimplementedItemsServiceServer() {}
+
+// UnsafeItemsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ItemsServiceServer will
+// result in compilation errors.
+type UnsafeItemsServiceServer interface {
+	mustEmbedUnimplementedItemsServiceServer()
+}
+
+func RegisterItemsServiceServer(s grpc.ServiceRegistrar, srv ItemsServiceServer) {
+	s.RegisterService(&ItemsService_ServiceDesc, srv)
+}
+
+func _ItemsService_ListItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServiceServer).ListItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemsService_ListItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServiceServer).ListItems(ctx, req.(*ListItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemsService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemsService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServiceServer).ListTags(ctx, req.(*ListTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemsService_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServiceServer).RenameTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemsService_RenameTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServiceServer).RenameTag(ctx, req.(*RenameTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemsService_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServiceServer).MergeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemsService_MergeTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServiceServer).MergeTags(ctx, req.(*MergeTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemsService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServiceServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemsService_DeleteTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServiceServer).DeleteTag(ctx, req.(*DeleteTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemsService_SetCustomFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCustomFieldsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServiceServer).SetCustomFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemsService_SetCustomFields_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServiceServer).SetCustomFields(ctx, req.(*SetCustomFieldsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemsService_SetFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFavoriteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemsServiceServer).SetFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemsService_SetFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemsServiceServer).SetFavorite(ctx, req.(*SetFavoriteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ItemsService_ServiceDesc is the grpc.ServiceDesc for ItemsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ItemsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pwdm.ItemsService",
+	HandlerType: (*ItemsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListItems",
+			Handler:    _ItemsService_ListItems_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _ItemsService_ListTags_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _ItemsService_RenameTag_Handler,
+		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _ItemsService_MergeTags_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _ItemsService_DeleteTag_Handler,
+		},
+		{
+			MethodName: "SetCustomFields",
+			Handler:    _ItemsService_SetCustomFields_Handler,
+		},
+		{
+			MethodName: "SetFavorite",
+			Handler:    _ItemsService_SetFavorite_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/pwdm_server.proto",
+}
+
+const (
+	FoldersService_GetFolders_FullMethodName   = "/pwdm.FoldersService/GetFolders"
+	FoldersService_CreateFolder_FullMethodName = "/pwdm.FoldersService/CreateFolder"
+	FoldersService_RenameFolder_FullMethodName = "/pwdm.FoldersService/RenameFolder"
+	FoldersService_MoveFolder_FullMethodName   = "/pwdm.FoldersService/MoveFolder"
+	FoldersService_DeleteFolder_FullMethodName = "/pwdm.FoldersService/DeleteFolder"
+	FoldersService_MoveItems_FullMethodName    = "/pwdm.FoldersService/MoveItems"
+)
+
+// FoldersServiceClient is the client API for FoldersService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FoldersServiceClient interface {
+	GetFolders(ctx context.Context, in *GetFoldersReq, opts ...grpc.CallOption) (*GetFoldersResp, error)
+	CreateFolder(ctx context.Context, in *CreateFolderReq, opts ...grpc.CallOption) (*FolderResp, error)
+	RenameFolder(ctx context.Context, in *RenameFolderReq, opts ...grpc.CallOption) (*FolderResp, error)
+	MoveFolder(ctx context.Context, in *MoveFolderReq, opts ...grpc.CallOption) (*FolderResp, error)
+	DeleteFolder(ctx context.Context, in *DeleteFolderReq, opts ...grpc.CallOption) (*FolderResp, error)
+	MoveItems(ctx context.Context, in *MoveItemsReq, opts ...grpc.CallOption) (*FolderResp, error)
+}
+
+type foldersServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFoldersServiceClient(cc grpc.ClientConnInterface) FoldersServiceClient {
+	return &foldersServiceClient{cc}
+}
+
+func (c *foldersServiceClient) GetFolders(ctx context.Context, in *GetFoldersReq, opts ...grpc.CallOption) (*GetFoldersResp, error) {
+	out := new(GetFoldersResp)
+	err := c.cc.Invoke(ctx, FoldersService_GetFolders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foldersServiceClient) CreateFolder(ctx context.Context, in *CreateFolderReq, opts ...grpc.CallOption) (*FolderResp, error) {
+	out := new(FolderResp)
+	err := c.cc.Invoke(ctx, FoldersService_CreateFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foldersServiceClient) RenameFolder(ctx context.Context, in *RenameFolderReq, opts ...grpc.CallOption) (*FolderResp, error) {
+	out := new(FolderResp)
+	err := c.cc.Invoke(ctx, FoldersService_RenameFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foldersServiceClient) MoveFolder(ctx context.Context, in *MoveFolderReq, opts ...grpc.CallOption) (*FolderResp, error) {
+	out := new(FolderResp)
+	err := c.cc.Invoke(ctx, FoldersService_MoveFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foldersServiceClient) DeleteFolder(ctx context.Context, in *DeleteFolderReq, opts ...grpc.CallOption) (*FolderResp, error) {
+	out := new(FolderResp)
+	err := c.cc.Invoke(ctx, FoldersService_DeleteFolder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foldersServiceClient) MoveItems(ctx context.Context, in *MoveItemsReq, opts ...grpc.CallOption) (*FolderResp, error) {
+	out := new(FolderResp)
+	err := c.cc.Invoke(ctx, FoldersService_MoveItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FoldersServiceServer is the server API for FoldersService service.
+// All implementations must embed UnimplementedFoldersServiceServer
+// for forward compatibility
+type FoldersServiceServer interface {
+	GetFolders(context.Context, *GetFoldersReq) (*GetFoldersResp, error)
+	CreateFolder(context.Context, *CreateFolderReq) (*FolderResp, error)
+	RenameFolder(context.Context, *RenameFolderReq) (*FolderResp, error)
+	MoveFolder(context.Context, *MoveFolderReq) (*FolderResp, error)
+	DeleteFolder(context.Context, *DeleteFolderReq) (*FolderResp, error)
+	MoveItems(context.Context, *MoveItemsReq) (*FolderResp, error)
+	mustEmbedUnimplementedFoldersServiceServer()
+}
+
+// UnimplementedFoldersServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedFoldersServiceServer struct {
+}
+
+func (UnimplementedFoldersServiceServer) GetFolders(context.Context, *GetFoldersReq) (*GetFoldersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFolders not implemented")
+}
+func (UnimplementedFoldersServiceServer) CreateFolder(context.Context, *CreateFolderReq) (*FolderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFolder not implemented")
+}
+func (UnimplementedFoldersServiceServer) RenameFolder(context.Context, *RenameFolderReq) (*FolderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameFolder not implemented")
+}
+func (UnimplementedFoldersServiceServer) MoveFolder(context.Context, *MoveFolderReq) (*FolderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveFolder not implemented")
+}
+func (UnimplementedFoldersServiceServer) DeleteFolder(context.Context, *DeleteFolderReq) (*FolderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFolder not implemented")
+}
+func (UnimplementedFoldersServiceServer) MoveItems(context.Context, *MoveItemsReq) (*FolderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveItems not implemented")
+}
+func (UnimplementedFoldersServiceServer) mustEmbedUnimplementedFoldersServiceServer() {}
+
+// UnsafeFoldersServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FoldersServiceServer will
+// result in compilation errors.
+type UnsafeFoldersServiceServer interface {
+	mustEmbedUnimplementedFoldersServiceServer()
+}
+
+func RegisterFoldersServiceServer(s grpc.ServiceRegistrar, srv FoldersServiceServer) {
+	s.RegisterService(&FoldersService_ServiceDesc, srv)
+}
+
+func _FoldersService_GetFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFoldersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoldersServiceServer).GetFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FoldersService_GetFolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoldersServiceServer).GetFolders(ctx, req.(*GetFoldersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FoldersService_CreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFolderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoldersServiceServer).CreateFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FoldersService_CreateFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoldersServiceServer).CreateFolder(ctx, req.(*CreateFolderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FoldersService_RenameFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameFolderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoldersServiceServer).RenameFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FoldersService_RenameFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoldersServiceServer).RenameFolder(ctx, req.(*RenameFolderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FoldersService_MoveFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveFolderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoldersServiceServer).MoveFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FoldersService_MoveFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoldersServiceServer).MoveFolder(ctx, req.(*MoveFolderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FoldersService_DeleteFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFolderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoldersServiceServer).DeleteFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FoldersService_DeleteFolder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoldersServiceServer).DeleteFolder(ctx, req.(*DeleteFolderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FoldersService_MoveItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoldersServiceServer).MoveItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FoldersService_MoveItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoldersServiceServer).MoveItems(ctx, req.(*MoveItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FoldersService_ServiceDesc is the grpc.ServiceDesc for FoldersService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FoldersService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pwdm.FoldersService",
+	HandlerType: (*FoldersServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFolders",
+			Handler:    _FoldersService_GetFolders_Handler,
+		},
+		{
+			MethodName: "CreateFolder",
+			Handler:    _FoldersService_CreateFolder_Handler,
+		},
+		{
+			MethodName: "RenameFolder",
+			Handler:    _FoldersService_RenameFolder_Handler,
+		},
+		{
+			MethodName: "MoveFolder",
+			Handler:    _FoldersService_MoveFolder_Handler,
+		},
+		{
+			MethodName: "DeleteFolder",
+			Handler:    _FoldersService_DeleteFolder_Handler,
+		},
+		{
+			MethodName: "MoveItems",
+			Handler:    _FoldersService_MoveItems_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/pwdm_server.proto",
+}
+
+const (
+	AutofillService_SetLoginURIs_FullMethodName = "/pwdm.AutofillService/SetLoginURIs"
+	AutofillService_GetLoginURIs_FullMethodName = "/pwdm.AutofillService/GetLoginURIs"
+	AutofillService_LookupByURL_FullMethodName  = "/pwdm.AutofillService/LookupByURL"
+)
+
+// AutofillServiceClient is the client API for AutofillService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AutofillServiceClient interface {
+	SetLoginURIs(ctx context.Context, in *SetLoginURIsReq, opts ...grpc.CallOption) (*LoginURIsResp, error)
+	GetLoginURIs(ctx context.Context, in *GetLoginURIsReq, opts ...grpc.CallOption) (*LoginURIsResp, error)
+	LookupByURL(ctx context.Context, in *LookupByURLReq, opts ...grpc.CallOption) (*LookupByURLResp, error)
+}
+
+type autofillServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAutofillServiceClient(cc grpc.ClientConnInterface) AutofillServiceClient {
+	return &autofillServiceClient{cc}
+}
+
+func (c *autofillServiceClient) SetLoginURIs(ctx context.Context, in *SetLoginURIsReq, opts ...grpc.CallOption) (*LoginURIsResp, error) {
+	out := new(LoginURIsResp)
+	err := c.cc.Invoke(ctx, AutofillService_SetLoginURIs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autofillServiceClient) GetLoginURIs(ctx context.Context, in *GetLoginURIsReq, opts ...grpc.CallOption) (*LoginURIsResp, error) {
+	out := new(LoginURIsResp)
+	err := c.cc.Invoke(ctx, AutofillService_GetLoginURIs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autofillServiceClient) LookupByURL(ctx context.Context, in *LookupByURLReq, opts ...grpc.CallOption) (*LookupByURLResp, error) {
+	out := new(LookupByURLResp)
+	err := c.cc.Invoke(ctx, AutofillService_LookupByURL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AutofillServiceServer is the server API for AutofillService service.
+// All implementations must embed UnimplementedAutofillServiceServer
+// for forward compatibility
+type AutofillServiceServer interface {
+	SetLoginURIs(context.Context, *SetLoginURIsReq) (*LoginURIsResp, error)
+	GetLoginURIs(context.Context, *GetLoginURIsReq) (*LoginURIsResp, error)
+	LookupByURL(context.Context, *LookupByURLReq) (*LookupByURLResp, error)
+	mustEmbedUnimplementedAutofillServiceServer()
+}
+
+// UnimplementedAutofillServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAutofillServiceServer struct {
+}
+
+func (UnimplementedAutofillServiceServer) SetLoginURIs(context.Context, *SetLoginURIsReq) (*LoginURIsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLoginURIs not implemented")
+}
+func (UnimplementedAutofillServiceServer) GetLoginURIs(context.Context, *GetLoginURIsReq) (*LoginURIsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoginURIs not implemented")
+}
+func (UnimplementedAutofillServiceServer) LookupByURL(context.Context, *LookupByURLReq) (*LookupByURLResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupByURL not implemented")
+}
+func (UnimplementedAutofillServiceServer) mustEmbedUnimplementedAutofillServiceServer() {}
+
+// UnsafeAutofillServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AutofillServiceServer will
+// result in compilation errors.
+type UnsafeAutofillServiceServer interface {
+	mustEmbedUnimplementedAutofillServiceServer()
+}
+
+func RegisterAutofillServiceServer(s grpc.ServiceRegistrar, srv AutofillServiceServer) {
+	s.RegisterService(&AutofillService_ServiceDesc, srv)
+}
+
+func _AutofillService_SetLoginURIs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLoginURIsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutofillServiceServer).SetLoginURIs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutofillService_SetLoginURIs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutofillServiceServer).SetLoginURIs(ctx, req.(*SetLoginURIsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutofillService_GetLoginURIs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoginURIsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutofillServiceServer).GetLoginURIs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutofillService_GetLoginURIs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutofillServiceServer).GetLoginURIs(ctx, req.(*GetLoginURIsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutofillService_LookupByURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupByURLReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutofillServiceServer).LookupByURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutofillService_LookupByURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutofillServiceServer).LookupByURL(ctx, req.(*LookupByURLReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AutofillService_ServiceDesc is the grpc.ServiceDesc for AutofillService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AutofillService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pwdm.AutofillService",
+	HandlerType: (*AutofillServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetLoginURIs",
+			Handler:    _AutofillService_SetLoginURIs_Handler,
+		},
+		{
+			MethodName: "GetLoginURIs",
+			Handler:    _AutofillService_GetLoginURIs_Handler,
+		},
+		{
+			MethodName: "LookupByURL",
+			Handler:    _AutofillService_LookupByURL_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/pwdm_server.proto",
+}
+
+const (
+	TOTPService_SetTOTP_FullMethodName     = "/pwdm.TOTPService/SetTOTP"
+	TOTPService_GetTOTPCode_FullMethodName = "/pwdm.TOTPService/GetTOTPCode"
+)
+
+// TOTPServiceClient is the client API for TOTPService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TOTPServiceClient interface {
+	SetTOTP(ctx context.Context, in *SetTOTPReq, opts ...grpc.CallOption) (*TOTPResp, error)
+	GetTOTPCode(ctx context.Context, in *GetTOTPCodeReq, opts ...grpc.CallOption) (*TOTPResp, error)
+}
+
+type tOTPServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTOTPServiceClient(cc grpc.ClientConnInterface) TOTPServiceClient {
+	return &tOTPServiceClient{cc}
+}
+
+func (c *tOTPServiceClient) SetTOTP(ctx context.Context, in *SetTOTPReq, opts ...grpc.CallOption) (*TOTPResp, error) {
+	out := new(TOTPResp)
+	err := c.cc.Invoke(ctx, TOTPService_SetTOTP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tOTPServiceClient) GetTOTPCode(ctx context.Context, in *GetTOTPCodeReq, opts ...grpc.CallOption) (*TOTPResp, error) {
+	out := new(TOTPResp)
+	err := c.cc.Invoke(ctx, TOTPService_GetTOTPCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TOTPServiceServer is the server API for TOTPService service.
+// All implementations must embed UnimplementedTOTPServiceServer
+// for forward compatibility
+type TOTPServiceServer interface {
+	SetTOTP(context.Context, *SetTOTPReq) (*TOTPResp, error)
+	GetTOTPCode(context.Context, *GetTOTPCodeReq) (*TOTPResp, error)
+	mustEmbedUnimplementedTOTPServiceServer()
+}
+
+// UnimplementedTOTPServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTOTPServiceServer struct {
+}
+
+func (UnimplementedTOTPServiceServer) SetTOTP(context.Context, *SetTOTPReq) (*TOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTOTP not implemented")
+}
+func (UnimplementedTOTPServiceServer) GetTOTPCode(context.Context, *GetTOTPCodeReq) (*TOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTOTPCode not implemented")
+}
+func (UnimplementedTOTPServiceServer) mustEmbedUnimplementedTOTPServiceServer() {}
+
+// UnsafeTOTPServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TOTPServiceServer will
+// result in compilation errors.
+type UnsafeTOTPServiceServer interface {
+	mustEmbedUnimplementedTOTPServiceServer()
+}
+
+func RegisterTOTPServiceServer(s grpc.ServiceRegistrar, srv TOTPServiceServer) {
+	s.RegisterService(&TOTPService_ServiceDesc, srv)
+}
+
+func _TOTPService_SetTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTOTPReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TOTPServiceServer).SetTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TOTPService_SetTOTP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TOTPServiceServer).SetTOTP(ctx, req.(*SetTOTPReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TOTPService_GetTOTPCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTOTPCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TOTPServiceServer).GetTOTPCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TOTPService_GetTOTPCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TOTPServiceServer).GetTOTPCode(ctx, req.(*GetTOTPCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TOTPService_ServiceDesc is the grpc.ServiceDesc for TOTPService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TOTPService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pwdm.TOTPService",
+	HandlerType: (*TOTPServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetTOTP",
+			Handler:    _TOTPService_SetTOTP_Handler,
+		},
+		{
+			MethodName: "GetTOTPCode",
+			Handler:    _TOTPService_GetTOTPCode_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/pwdm_server.proto",
+}
+
+const (
+	SSHKeyService_InsSSHKey_FullMethodName    = "/pwdm.SSHKeyService/InsSSHKey"
+	SSHKeyService_GetSSHKey_FullMethodName    = "/pwdm.SSHKeyService/GetSSHKey"
+	SSHKeyService_UpdateSSHKey_FullMethodName = "/pwdm.SSHKeyService/UpdateSSHKey"
+)
+
+// SSHKeyServiceClient is the client API for SSHKeyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SSHKeyServiceClient interface {
+	InsSSHKey(ctx context.Context, in *SSHKeyReq, opts ...grpc.CallOption) (*SSHKeyResp, error)
+	GetSSHKey(ctx context.Context, in *GetSSHKeyReq, opts ...grpc.CallOption) (*SSHKeyResp, error)
+	UpdateSSHKey(ctx context.Context, in *SSHKeyReq, opts ...grpc.CallOption) (*SSHKeyResp, error)
+}
+
+type sSHKeyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSSHKeyServiceClient(cc grpc.ClientConnInterface) SSHKeyServiceClient {
+	return &sSHKeyServiceClient{cc}
+}
+
+func (c *sSHKeyServiceClient) InsSSHKey(ctx context.Context, in *SSHKeyReq, opts ...grpc.CallOption) (*SSHKeyResp, error) {
+	out := new(SSHKeyResp)
+	err := c.cc.Invoke(ctx, SSHKeyService_InsSSHKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSHKeyServiceClient) GetSSHKey(ctx context.Context, in *GetSSHKeyReq, opts ...grpc.CallOption) (*SSHKeyResp, error) {
+	out := new(SSHKeyResp)
+	err := c.cc.Invoke(ctx, SSHKeyService_GetSSHKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSHKeyServiceClient) UpdateSSHKey(ctx context.Context, in *SSHKeyReq, opts ...grpc.CallOption) (*SSHKeyResp, error) {
+	out := new(SSHKeyResp)
+	err := c.cc.Invoke(ctx, SSHKeyService_UpdateSSHKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SSHKeyServiceServer is the server API for SSHKeyService service.
+// All implementations must embed UnimplementedSSHKeyServiceServer
+// for forward compatibility
+type SSHKeyServiceServer interface {
+	InsSSHKey(context.Context, *SSHKeyReq) (*SSHKeyResp, error)
+	GetSSHKey(context.Context, *GetSSHKeyReq) (*SSHKeyResp, error)
+	UpdateSSHKey(context.Context, *SSHKeyReq) (*SSHKeyResp, error)
+	mustEmbedUnimplementedSSHKeyServiceServer()
+}
+
+// UnimplementedSSHKeyServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSSHKeyServiceServer struct {
+}
+
+func (UnimplementedSSHKeyServiceServer) InsSSHKey(context.Context, *SSHKeyReq) (*SSHKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsSSHKey not implemented")
+}
+func (UnimplementedSSHKeyServiceServer) GetSSHKey(context.Context, *GetSSHKeyReq) (*SSHKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSSHKey not implemented")
+}
+func (UnimplementedSSHKeyServiceServer) UpdateSSHKey(context.Context, *SSHKeyReq) (*SSHKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSSHKey not implemented")
+}
+func (UnimplementedSSHKeyServiceServer) mustEmbedUnimplementedSSHKeyServiceServer() {}
+
+// UnsafeSSHKeyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SSHKeyServiceServer will
+// result in compilation errors.
+type UnsafeSSHKeyServiceServer interface {
+	mustEmbedUnimplementedSSHKeyServiceServer()
+}
+
+func RegisterSSHKeyServiceServer(s grpc.ServiceRegistrar, srv SSHKeyServiceServer) {
+	s.RegisterService(&SSHKeyService_ServiceDesc, srv)
+}
+
+func _SSHKeyService_InsSSHKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSHKeyServiceServer).InsSSHKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSHKeyService_InsSSHKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSHKeyServiceServer).InsSSHKey(ctx, req.(*SSHKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSHKeyService_GetSSHKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSSHKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSHKeyServiceServer).GetSSHKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSHKeyService_GetSSHKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSHKeyServiceServer).GetSSHKey(ctx, req.(*GetSSHKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSHKeyService_UpdateSSHKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSHKeyServiceServer).UpdateSSHKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSHKeyService_UpdateSSHKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSHKeyServiceServer).UpdateSSHKey(ctx, req.(*SSHKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SSHKeyService_ServiceDesc is the grpc.ServiceDesc for SSHKeyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SSHKeyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pwdm.SSHKeyService",
+	HandlerType: (*SSHKeyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InsSSHKey",
+			Handler:    _SSHKeyService_InsSSHKey_Handler,
+		},
+		{
+			MethodName: "GetSSHKey",
+			Handler:    _SSHKeyService_GetSSHKey_Handler,
+		},
+		{
+			MethodName: "UpdateSSHKey",
+			Handler:    _SSHKeyService_UpdateSSHKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/pwdm_server.proto",
+}
+
+const (
+	IdentityService_InsIdentity_FullMethodName    = "/pwdm.IdentityService/InsIdentity"
+	IdentityService_GetIdentity_FullMethodName    = "/pwdm.IdentityService/GetIdentity"
+	IdentityService_UpdateIdentity_FullMethodName = "/pwdm.IdentityService/UpdateIdentity"
+)
+
+// IdentityServiceClient is the client API for IdentityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IdentityServiceClient interface {
+	InsIdentity(ctx context.Context, in *IdentityReq, opts ...grpc.CallOption) (*IdentityResp, error)
+	GetIdentity(ctx context.Context, in *GetIdentityReq, opts ...grpc.CallOption) (*IdentityResp, error)
+	UpdateIdentity(ctx context.Context, in *IdentityReq, opts ...grpc.CallOption) (*IdentityResp, error)
+}
+
+type identityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIdentityServiceClient(cc grpc.ClientConnInterface) IdentityServiceClient {
+	return &identityServiceClient{cc}
+}
+
+func (c *identityServiceClient) InsIdentity(ctx context.Context, in *IdentityReq, opts ...grpc.CallOption) (*IdentityResp, error) {
+	out := new(IdentityResp)
+	err := c.cc.Invoke(ctx, IdentityService_InsIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) GetIdentity(ctx context.Context, in *GetIdentityReq, opts ...grpc.CallOption) (*IdentityResp, error) {
+	out := new(IdentityResp)
+	err := c.cc.Invoke(ctx, IdentityService_GetIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) UpdateIdentity(ctx context.Context, in *IdentityReq, opts ...grpc.CallOption) (*IdentityResp, error) {
+	out := new(IdentityResp)
+	err := c.cc.Invoke(ctx, IdentityService_UpdateIdentity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IdentityServiceServer is the server API for IdentityService service.
+// All implementations must embed UnimplementedIdentityServiceServer
+// for forward compatibility
+type IdentityServiceServer interface {
+	InsIdentity(context.Context, *IdentityReq) (*IdentityResp, error)
+	GetIdentity(context.Context, *GetIdentityReq) (*IdentityResp, error)
+	UpdateIdentity(context.Context, *IdentityReq) (*IdentityResp, error)
+	mustEmbedUnimplementedIdentityServiceServer()
+}
+
+// UnimplementedIdentityServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedIdentityServiceServer struct {
+}
+
+func (UnimplementedIdentityServiceServer) InsIdentity(context.Context, *IdentityReq) (*IdentityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsIdentity not implemented")
+}
+func (UnimplementedIdentityServiceServer) GetIdentity(context.Context, *GetIdentityReq) (*IdentityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIdentity not implemented")
+}
+func (UnimplementedIdentityServiceServer) UpdateIdentity(context.Context, *IdentityReq) (*IdentityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIdentity not implemented")
+}
+func (UnimplementedIdentityServiceServer) mustEmbedUnimplementedIdentityServiceServer() {}
+
+// UnsafeIdentityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IdentityServiceServer will
+// result in compilation errors.
+type UnsafeIdentityServiceServer interface {
+	mustEmbedUnimplementedIdentityServiceServer()
+}
+
+func RegisterIdentityServiceServer(s grpc.ServiceRegistrar, srv IdentityServiceServer) {
+	s.RegisterService(&IdentityService_ServiceDesc, srv)
+}
+
+func _IdentityService_InsIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).InsIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_InsIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).InsIdentity(ctx, req.(*IdentityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_GetIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIdentityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).GetIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_GetIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).GetIdentity(ctx, req.(*GetIdentityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_UpdateIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).UpdateIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_UpdateIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).UpdateIdentity(ctx, req.(*IdentityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IdentityService_ServiceDesc is the grpc.ServiceDesc for IdentityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IdentityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pwdm.IdentityService",
+	HandlerType: (*IdentityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InsIdentity",
+			Handler:    _IdentityService_InsIdentity_Handler,
+		},
+		{
+			MethodName: "GetIdentity",
+			Handler:    _IdentityService_GetIdentity_Handler,
+		},
+		{
+			MethodName: "UpdateIdentity",
+			Handler:    _IdentityService_UpdateIdentity_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/pwdm_server.proto",
+}
+
+const (
+	ExpiryService_SetExpiry_FullMethodName     = "/pwdm.ExpiryService/SetExpiry"
+	ExpiryService_ExpiringItems_FullMethodName = "/pwdm.ExpiryService/ExpiringItems"
+)
+
+// ExpiryServiceClient is the client API for ExpiryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExpiryServiceClient interface {
+	SetExpiry(ctx context.Context, in *SetExpiryReq, opts ...grpc.CallOption) (*SetExpiryResp, error)
+	ExpiringItems(ctx context.Context, in *ExpiringItemsReq, opts ...grpc.CallOption) (*ExpiringItemsResp, error)
+}
+
+type expiryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExpiryServiceClient(cc grpc.ClientConnInterface) ExpiryServiceClient {
+	return &expiryServiceClient{cc}
+}
+
+func (c *expiryServiceClient) SetExpiry(ctx context.Context, in *SetExpiryReq, opts ...grpc.CallOption) (*SetExpiryResp, error) {
+	out := new(SetExpiryResp)
+	err := c.cc.Invoke(ctx, ExpiryService_SetExpiry_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expiryServiceClient) ExpiringItems(ctx context.Context, in *ExpiringItemsReq, opts ...grpc.CallOption) (*ExpiringItemsResp, error) {
+	out := new(ExpiringItemsResp)
+	err := c.cc.Invoke(ctx, ExpiryService_ExpiringItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExpiryServiceServer is the server API for ExpiryService service.
+// All implementations must embed UnimplementedExpiryServiceServer
+// for forward compatibility
+type ExpiryServiceServer interface {
+	SetExpiry(context.Context, *SetExpiryReq) (*SetExpiryResp, error)
+	ExpiringItems(context.Context, *ExpiringItemsReq) (*ExpiringItemsResp, error)
+	mustEmbedUnimplementedExpiryServiceServer()
+}
+
+// UnimplementedExpiryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedExpiryServiceServer struct {
+}
+
+func (UnimplementedExpiryServiceServer) SetExpiry(context.Context, *SetExpiryReq) (*SetExpiryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExpiry not implemented")
+}
+func (UnimplementedExpiryServiceServer) ExpiringItems(context.Context, *ExpiringItemsReq) (*ExpiringItemsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringItems not implemented")
+}
+func (UnimplementedExpiryServiceServer) mustEmbedUnimplementedExpiryServiceServer() {}
+
+// UnsafeExpiryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExpiryServiceServer will
+// result in compilation errors.
+type UnsafeExpiryServiceServer interface {
+	mustEmbedUnimplementedExpiryServiceServer()
+}
+
+func RegisterExpiryServiceServer(s grpc.ServiceRegistrar, srv ExpiryServiceServer) {
+	s.RegisterService(&ExpiryService_ServiceDesc, srv)
+}
+
+func _ExpiryService_SetExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExpiryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpiryServiceServer).SetExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpiryService_SetExpiry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpiryServiceServer).SetExpiry(ctx, req.(*SetExpiryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpiryService_ExpiringItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpiringItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpiryServiceServer).ExpiringItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpiryService_ExpiringItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpiryServiceServer).ExpiringItems(ctx, req.(*ExpiringItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExpiryService_ServiceDesc is the grpc.ServiceDesc for ExpiryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExpiryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pwdm.ExpiryService",
+	HandlerType: (*ExpiryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetExpiry",
+			Handler:    _ExpiryService_SetExpiry_Handler,
+		},
+		{
+			MethodName: "ExpiringItems",
+			Handler:    _ExpiryService_ExpiringItems_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/pwdm_server.proto",
+}
+
+const (
+	BinaryService_UploadBinary_FullMethodName   = "/pwdm.BinaryService/UploadBinary"
+	BinaryService_UploadStatus_FullMethodName   = "/pwdm.BinaryService/UploadStatus"
+	BinaryService_DownloadBinary_FullMethodName = "/pwdm.BinaryService/DownloadBinary"
+)
+
+// BinaryServiceClient is the client API for BinaryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BinaryServiceClient interface {
+	UploadBinary(ctx context.Context, opts ...grpc.CallOption) (BinaryService_UploadBinaryClient, error)
+	UploadStatus(ctx context.Context, in *UploadStatusReq, opts ...grpc.CallOption) (*UploadBinaryResp, error)
+	DownloadBinary(ctx context.Context, in *DownloadBinaryReq, opts ...grpc.CallOption) (BinaryService_DownloadBinaryClient, error)
+}
+
+type binaryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBinaryServiceClient(cc grpc.ClientConnInterface) BinaryServiceClient {
+	return &binaryServiceClient{cc}
+}
+
+func (c *binaryServiceClient) UploadBinary(ctx context.Context, opts ...grpc.CallOption) (BinaryService_UploadBinaryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BinaryService_ServiceDesc.Streams[0], BinaryService_UploadBinary_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &binaryServiceUploadBinaryClient{stream}
+	return x, nil
+}
+
+type BinaryService_UploadBinaryClient interface {
+	Send(*UploadBinaryReq) error
+	CloseAndRecv() (*UploadBinaryResp, error)
+	grpc.ClientStream
+}
+
+type binaryServiceUploadBinaryClient struct {
+	grpc.ClientStream
+}
+
+func (x *binaryServiceUploadBinaryClient) Send(m *UploadBinaryReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *binaryServiceUploadBinaryClient) CloseAndRecv() (*UploadBinaryResp, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadBinaryResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *binaryServiceClient) UploadStatus(ctx context.Context, in *UploadStatusReq, opts ...grpc.CallOption) (*UploadBinaryResp, error) {
+	out := new(UploadBinaryResp)
+	err := c.cc.Invoke(ctx, BinaryService_UploadStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryServiceClient) DownloadBinary(ctx context.Context, in *DownloadBinaryReq, opts ...grpc.CallOption) (BinaryService_DownloadBinaryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BinaryService_ServiceDesc.Streams[1], BinaryService_DownloadBinary_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &binaryServiceDownloadBinaryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BinaryService_DownloadBinaryClient interface {
+	Recv() (*DownloadBinaryResp, error)
+	grpc.ClientStream
+}
+
+type binaryServiceDownloadBinaryClient struct {
+	grpc.ClientStream
+}
+
+func (x *binaryServiceDownloadBinaryClient) Recv() (*DownloadBinaryResp, error) {
+	m := new(DownloadBinaryResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// BinaryServiceServer is the server API for BinaryService service.
+// All implementations must embed UnimplementedBinaryServiceServer
+// for forward compatibility
+type BinaryServiceServer interface {
+	UploadBinary(BinaryService_UploadBinaryServer) error
+	UploadStatus(context.Context, *UploadStatusReq) (*UploadBinaryResp, error)
+	DownloadBinary(*DownloadBinaryReq, BinaryService_DownloadBinaryServer) error
+	mustEmbedUnimplementedBinaryServiceServer()
+}
+
+// UnimplementedBinaryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBinaryServiceServer struct {
+}
+
+func (UnimplementedBinaryServiceServer) UploadBinary(BinaryService_UploadBinaryServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadBinary not implemented")
+}
+func (UnimplementedBinaryServiceServer) UploadStatus(context.Context, *UploadStatusReq) (*UploadBinaryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadStatus not implemented")
+}
+func (UnimplementedBinaryServiceServer) DownloadBinary(*DownloadBinaryReq, BinaryService_DownloadBinaryServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadBinary not implemented")
+}
+func (UnimplementedBinaryServiceServer) mustEmbedUnimplementedBinaryServiceServer() {}
+
+// UnsafeBinaryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BinaryServiceServer will
+// result in compilation errors.
+type UnsafeBinaryServiceServer interface {
+	mustEmbedUnimplementedBinaryServiceServer()
+}
+
+func RegisterBinaryServiceServer(s grpc.ServiceRegistrar, srv BinaryServiceServer) {
+	s.RegisterService(&BinaryService_ServiceDesc, srv)
+}
+
+func _BinaryService_UploadBinary_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BinaryServiceServer).UploadBinary(&binaryServiceUploadBinaryServer{stream})
+}
+
+type BinaryService_UploadBinaryServer interface {
+	SendAndClose(*UploadBinaryResp) error
+	Recv() (*UploadBinaryReq, error)
+	grpc.ServerStream
+}
+
+type binaryServiceUploadBinaryServer struct {
+	grpc.ServerStream
+}
+
+func (x *binaryServiceUploadBinaryServer) SendAndClose(m *UploadBinaryResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *binaryServiceUploadBinaryServer) Recv() (*UploadBinaryReq, error) {
+	m := new(UploadBinaryReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _BinaryService_UploadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryServiceServer).UploadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BinaryService_UploadStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryServiceServer).UploadStatus(ctx, req.(*UploadStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryService_DownloadBinary_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadBinaryReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BinaryServiceServer).DownloadBinary(m, &binaryServiceDownloadBinaryServer{stream})
+}
+
+type BinaryService_DownloadBinaryServer interface {
+	Send(*DownloadBinaryResp) error
+	grpc.ServerStream
+}
+
+type binaryServiceDownloadBinaryServer struct {
+	grpc.ServerStream
+}
+
+func (x *binaryServiceDownloadBinaryServer) Send(m *DownloadBinaryResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// BinaryService_ServiceDesc is the grpc.ServiceDesc for BinaryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BinaryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pwdm.BinaryService",
+	HandlerType: (*BinaryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UploadStatus",
+			Handler:    _BinaryService_UploadStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadBinary",
+			Handler:       _BinaryService_UploadBinary_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadBinary",
+			Handler:       _BinaryService_DownloadBinary_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/pwdm_server.proto",
+}
+
+const (
+	AttachmentService_Attach_FullMethodName             = "/pwdm.AttachmentService/Attach"
+	AttachmentService_ListAttachments_FullMethodName    = "/pwdm.AttachmentService/ListAttachments"
+	AttachmentService_DownloadAttachment_FullMethodName = "/pwdm.AttachmentService/DownloadAttachment"
+	AttachmentService_RemoveAttachment_FullMethodName   = "/pwdm.AttachmentService/RemoveAttachment"
+)
+
+// AttachmentServiceClient is the client API for AttachmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AttachmentServiceClient interface {
+	Attach(ctx context.Context, opts ...grpc.CallOption) (AttachmentService_AttachClient, error)
+	ListAttachments(ctx context.Context, in *ListAttachmentsReq, opts ...grpc.CallOption) (*ListAttachmentsResp, error)
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentReq, opts ...grpc.CallOption) (AttachmentService_DownloadAttachmentClient, error)
+	RemoveAttachment(ctx context.Context, in *RemoveAttachmentReq, opts ...grpc.CallOption) (*RemoveAttachmentResp, error)
+}
+
+type attachmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttachmentServiceClient(cc grpc.ClientConnInterface) AttachmentServiceClient {
+	return &attachmentServiceClient{cc}
+}
+
+func (c *attachmentServiceClient) Attach(ctx context.Context, opts ...grpc.CallOption) (AttachmentService_AttachClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AttachmentService_ServiceDesc.Streams[0], AttachmentService_Attach_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &attachmentServiceAttachClient{stream}
+	return x, nil
+}
+
+type AttachmentService_AttachClient interface {
+	Send(*UploadBinaryReq) error
+	CloseAndRecv() (*UploadBinaryResp, error)
+	grpc.ClientStream
+}
+
+type attachmentServiceAttachClient struct {
+	grpc.ClientStream
+}
+
+func (x *attachmentServiceAttachClient) Send(m *UploadBinaryReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *attachmentServiceAttachClient) CloseAndRecv() (*UploadBinaryResp, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadBinaryResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *attachmentServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsReq, opts ...grpc.CallOption) (*ListAttachmentsResp, error) {
+	out := new(ListAttachmentsResp)
+	err := c.cc.Invoke(ctx, AttachmentService_ListAttachments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attachmentServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentReq, opts ...grpc.CallOption) (AttachmentService_DownloadAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AttachmentService_ServiceDesc.Streams[1], AttachmentService_DownloadAttachment_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &attachmentServiceDownloadAttachmentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AttachmentService_DownloadAttachmentClient interface {
+	Recv() (*DownloadBinaryResp, error)
+	grpc.ClientStream
+}
+
+type attachmentServiceDownloadAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *attachmentServiceDownloadAttachmentClient) Recv() (*DownloadBinaryResp, error) {
+	m := new(DownloadBinaryResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *attachmentServiceClient) RemoveAttachment(ctx context.Context, in *RemoveAttachmentReq, opts ...grpc.CallOption) (*RemoveAttachmentResp, error) {
+	out := new(RemoveAttachmentResp)
+	err := c.cc.Invoke(ctx, AttachmentService_RemoveAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttachmentServiceServer is the server API for AttachmentService service.
+// All implementations must embed UnimplementedAttachmentServiceServer
+// for forward compatibility
+type AttachmentServiceServer interface {
+	Attach(AttachmentService_AttachServer) error
+	ListAttachments(context.Context, *ListAttachmentsReq) (*ListAttachmentsResp, error)
+	DownloadAttachment(*DownloadAttachmentReq, AttachmentService_DownloadAttachmentServer) error
+	RemoveAttachment(context.Context, *RemoveAttachmentReq) (*RemoveAttachmentResp, error)
+	mustEmbedUnimplementedAttachmentServiceServer()
+}
+
+// UnimplementedAttachmentServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAttachmentServiceServer struct {
+}
+
+func (UnimplementedAttachmentServiceServer) Attach(AttachmentService_AttachServer) error {
+	return status.Errorf(codes.Unimplemented, "method Attach not implemented")
+}
+func (UnimplementedAttachmentServiceServer) ListAttachments(context.Context, *ListAttachmentsReq) (*ListAttachmentsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedAttachmentServiceServer) DownloadAttachment(*DownloadAttachmentReq, AttachmentService_DownloadAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedAttachmentServiceServer) RemoveAttachment(context.Context, *RemoveAttachmentReq) (*RemoveAttachmentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAttachment not implemented")
+}
+func (UnimplementedAttachmentServiceServer) mustEmbedUnimplementedAttachmentServiceServer() {}
+
+// UnsafeAttachmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttachmentServiceServer will
+// result in compilation errors.
+type UnsafeAttachmentServiceServer interface {
+	mustEmbedUnimplementedAttachmentServiceServer()
+}
+
+func RegisterAttachmentServiceServer(s grpc.ServiceRegistrar, srv AttachmentServiceServer) {
+	s.RegisterService(&AttachmentService_ServiceDesc, srv)
+}
+
+func _AttachmentService_Attach_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AttachmentServiceServer).Attach(&attachmentServiceAttachServer{stream})
+}
+
+type AttachmentService_AttachServer interface {
+	SendAndClose(*UploadBinaryResp) error
+	Recv() (*UploadBinaryReq, error)
+	grpc.ServerStream
+}
+
+type attachmentServiceAttachServer struct {
+	grpc.ServerStream
+}
+
+func (x *attachmentServiceAttachServer) SendAndClose(m *UploadBinaryResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *attachmentServiceAttachServer) Recv() (*UploadBinaryReq, error) {
+	m := new(UploadBinaryReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _AttachmentService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).ListAttachments(ctx, req.(*ListAttachmentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttachmentService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AttachmentServiceServer).DownloadAttachment(m, &attachmentServiceDownloadAttachmentServer{stream})
+}
+
+type AttachmentService_DownloadAttachmentServer interface {
+	Send(*DownloadBinaryResp) error
+	grpc.ServerStream
+}
+
+type attachmentServiceDownloadAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *attachmentServiceDownloadAttachmentServer) Send(m *DownloadBinaryResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AttachmentService_RemoveAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAttachmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttachmentServiceServer).RemoveAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttachmentService_RemoveAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttachmentServiceServer).RemoveAttachment(ctx, req.(*RemoveAttachmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttachmentService_ServiceDesc is the grpc.ServiceDesc for AttachmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttachmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pwdm.AttachmentService",
+	HandlerType: (*AttachmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAttachments",
+			Handler:    _AttachmentService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "RemoveAttachment",
+			Handler:    _AttachmentService_RemoveAttachment_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Attach",
+			Handler:       _AttachmentService_Attach_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _AttachmentService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/pwdm_server.proto",
+}
+
+const (
+	UsageService_GetUsage_FullMethodName = "/pwdm.UsageService/GetUsage"
+)
+
+// UsageServiceClient is the client API for UsageService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UsageServiceClient interface {
+	GetUsage(ctx context.Context, in *UsageReq, opts ...grpc.CallOption) (*UsageResp, error)
+}
+
+type usageServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUsageServiceClient(cc grpc.ClientConnInterface) UsageServiceClient {
+	return &usageServiceClient{cc}
+}
+
+func (c *usageServiceClient) GetUsage(ctx context.Context, in *UsageReq, opts ...grpc.CallOption) (*UsageResp, error) {
+	out := new(UsageResp)
+	err := c.cc.Invoke(ctx, UsageService_GetUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UsageServiceServer is the server API for UsageService service.
+// All implementations must embed UnimplementedUsageServiceServer
+// for forward compatibility
+type UsageServiceServer interface {
+	GetUsage(context.Context, *UsageReq) (*UsageResp, error)
+	mustEmbedUnimplementedUsageServiceServer()
+}
+
+// UnimplementedUsageServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedUsageServiceServer struct {
+}
+
+func (UnimplementedUsageServiceServer) GetUsage(context.Context, *UsageReq) (*UsageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedUsageServiceServer) mustEmbedUnimplementedUsageServiceServer() {}
+
+// UnsafeUsageServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UsageServiceServer will
+// result in compilation errors.
+type UnsafeUsageServiceServer interface {
+	mustEmbedUnimplementedUsageServiceServer()
+}
+
+func RegisterUsageServiceServer(s grpc.ServiceRegistrar, srv UsageServiceServer) {
+	s.RegisterService(&UsageService_ServiceDesc, srv)
+}
+
+func _UsageService_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsageServiceServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UsageService_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsageServiceServer).GetUsage(ctx, req.(*UsageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UsageService_ServiceDesc is the grpc.ServiceDesc for UsageService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UsageService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pwdm.UsageService",
+	HandlerType: (*UsageServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUsage",
+			Handler:    _UsageService_GetUsage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/pwdm_server.proto",
+}
+
+const (
+	VaultService_SetupVault_FullMethodName      = "/pwdm.VaultService/SetupVault"
+	VaultService_GetVaultParams_FullMethodName  = "/pwdm.VaultService/GetVaultParams"
+	VaultService_PutVaultItem_FullMethodName    = "/pwdm.VaultService/PutVaultItem"
+	VaultService_GetVaultItem_FullMethodName    = "/pwdm.VaultService/GetVaultItem"
+	VaultService_DeleteVaultItem_FullMethodName = "/pwdm.VaultService/DeleteVaultItem"
+	VaultService_ListVaultItems_FullMethodName  = "/pwdm.VaultService/ListVaultItems"
+)
+
+// VaultServiceClient is the client API for VaultService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VaultServiceClient interface {
+	SetupVault(ctx context.Context, in *SetupVaultReq, opts ...grpc.CallOption) (*SetupVaultResp, error)
+	GetVaultParams(ctx context.Context, in *GetVaultParamsReq, opts ...grpc.CallOption) (*GetVaultParamsResp, error)
+	PutVaultItem(ctx context.Context, in *PutVaultItemReq, opts ...grpc.CallOption) (*PutVaultItemResp, error)
+	GetVaultItem(ctx context.Context, in *GetVaultItemReq, opts ...grpc.CallOption) (*GetVaultItemResp, error)
+	DeleteVaultItem(ctx context.Context, in *DeleteVaultItemReq, opts ...grpc.CallOption) (*DeleteVaultItemResp, error)
+	ListVaultItems(ctx context.Context, in *ListVaultItemsReq, opts ...grpc.CallOption) (*ListVaultItemsResp, error)
+}
+
+type vaultServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVaultServiceClient(cc grpc.ClientConnInterface) VaultServiceClient {
+	return &vaultServiceClient{cc}
+}
+
+func (c *vaultServiceClient) SetupVault(ctx context.Context, in *SetupVaultReq, opts ...grpc.CallOption) (*SetupVaultResp, error) {
+	out := new(SetupVaultResp)
+	err := c.cc.Invoke(ctx, VaultService_SetupVault_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) GetVaultParams(ctx context.Context, in *GetVaultParamsReq, opts ...grpc.CallOption) (*GetVaultParamsResp, error) {
+	out := new(GetVaultParamsResp)
+	err := c.cc.Invoke(ctx, VaultService_GetVaultParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) PutVaultItem(ctx context.Context, in *PutVaultItemReq, opts ...grpc.CallOption) (*PutVaultItemResp, error) {
+	out := new(PutVaultItemResp)
+	err := c.cc.Invoke(ctx, VaultService_PutVaultItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) GetVaultItem(ctx context.Context, in *GetVaultItemReq, opts ...grpc.CallOption) (*GetVaultItemResp, error) {
+	out := new(GetVaultItemResp)
+	err := c.cc.Invoke(ctx, VaultService_GetVaultItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) DeleteVaultItem(ctx context.Context, in *DeleteVaultItemReq, opts ...grpc.CallOption) (*DeleteVaultItemResp, error) {
+	out := new(DeleteVaultItemResp)
+	err := c.cc.Invoke(ctx, VaultService_DeleteVaultItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ListVaultItems(ctx context.Context, in *ListVaultItemsReq, opts ...grpc.CallOption) (*ListVaultItemsResp, error) {
+	out := new(ListVaultItemsResp)
+	err := c.cc.Invoke(ctx, VaultService_ListVaultItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VaultServiceServer is the server API for VaultService service.
+// All implementations must embed UnimplementedVaultServiceServer
+// for forward compatibility
+type VaultServiceServer interface {
+	SetupVault(context.Context, *SetupVaultReq) (*SetupVaultResp, error)
+	GetVaultParams(context.Context, *GetVaultParamsReq) (*GetVaultParamsResp, error)
+	PutVaultItem(context.Context, *PutVaultItemReq) (*PutVaultItemResp, error)
+	GetVaultItem(context.Context, *GetVaultItemReq) (*GetVaultItemResp, error)
+	DeleteVaultItem(context.Context, *DeleteVaultItemReq) (*DeleteVaultItemResp, error)
+	ListVaultItems(context.Context, *ListVaultItemsReq) (*ListVaultItemsResp, error)
+	mustEmbedUnimplementedVaultServiceServer()
+}
+
+// UnimplementedVaultServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedVaultServiceServer struct {
+}
+
+func (UnimplementedVaultServiceServer) SetupVault(context.Context, *SetupVaultReq) (*SetupVaultResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetupVault not implemented")
+}
+func (UnimplementedVaultServiceServer) GetVaultParams(context.Context, *GetVaultParamsReq) (*GetVaultParamsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVaultParams not implemented")
+}
+func (UnimplementedVaultServiceServer) PutVaultItem(context.Context, *PutVaultItemReq) (*PutVaultItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutVaultItem not implemented")
+}
+func (UnimplementedVaultServiceServer) GetVaultItem(context.Context, *GetVaultItemReq) (*GetVaultItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVaultItem not implemented")
+}
+func (UnimplementedVaultServiceServer) DeleteVaultItem(context.Context, *DeleteVaultItemReq) (*DeleteVaultItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVaultItem not implemented")
+}
+func (UnimplementedVaultServiceServer) ListVaultItems(context.Context, *ListVaultItemsReq) (*ListVaultItemsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVaultItems not implemented")
+}
+func (UnimplementedVaultServiceServer) mustEmbedUnimplementedVaultServiceServer() {}
+
+// UnsafeVaultServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VaultServiceServer will
+// result in compilation errors.
+type UnsafeVaultServiceServer interface {
+	mustEmbedUnimplementedVaultServiceServer()
+}
+
+func RegisterVaultServiceServer(s grpc.ServiceRegistrar, srv VaultServiceServer) {
+	s.RegisterService(&VaultService_ServiceDesc, srv)
+}
+
+func _VaultService_SetupVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetupVaultReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).SetupVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_SetupVault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).SetupVault(ctx, req.(*SetupVaultReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_GetVaultParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVaultParamsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetVaultParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GetVaultParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetVaultParams(ctx, req.(*GetVaultParamsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_PutVaultItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutVaultItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).PutVaultItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_PutVaultItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).PutVaultItem(ctx, req.(*PutVaultItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_GetVaultItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVaultItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetVaultItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GetVaultItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetVaultItem(ctx, req.(*GetVaultItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_DeleteVaultItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVaultItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).DeleteVaultItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_DeleteVaultItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).DeleteVaultItem(ctx, req.(*DeleteVaultItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ListVaultItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVaultItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ListVaultItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ListVaultItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ListVaultItems(ctx, req.(*ListVaultItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VaultService_ServiceDesc is the grpc.ServiceDesc for VaultService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VaultService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pwdm.VaultService",
+	HandlerType: (*VaultServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetupVault",
+			Handler:    _VaultService_SetupVault_Handler,
+		},
+		{
+			MethodName: "GetVaultParams",
+			Handler:    _VaultService_GetVaultParams_Handler,
+		},
+		{
+			MethodName: "PutVaultItem",
+			Handler:    _VaultService_PutVaultItem_Handler,
+		},
+		{
+			MethodName: "GetVaultItem",
+			Handler:    _VaultService_GetVaultItem_Handler,
+		},
+		{
+			MethodName: "DeleteVaultItem",
+			Handler:    _VaultService_DeleteVaultItem_Handler,
+		},
+		{
+			MethodName: "ListVaultItems",
+			Handler:    _VaultService_ListVaultItems_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/pwdm_server.proto",
+}
+
+const (
+	SRPService_Register_FullMethodName    = "/pwdm.SRPService/Register"
+	SRPService_StartLogin_FullMethodName  = "/pwdm.SRPService/StartLogin"
+	SRPService_FinishLogin_FullMethodName = "/pwdm.SRPService/FinishLogin"
+)
+
+// SRPServiceClient is the client API for SRPService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SRPServiceClient interface {
+	Register(ctx context.Context, in *SRPRegisterReq, opts ...grpc.CallOption) (*SRPRegisterResp, error)
+	StartLogin(ctx context.Context, in *SRPStartReq, opts ...grpc.CallOption) (*SRPStartResp, error)
+	FinishLogin(ctx context.Context, in *SRPFinishReq, opts ...grpc.CallOption) (*SRPFinishResp, error)
+}
+
+type sRPServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSRPServiceClient(cc grpc.ClientConnInterface) SRPServiceClient {
+	return &sRPServiceClient{cc}
+}
+
+func (c *sRPServiceClient) Register(ctx context.Context, in *SRPRegisterReq, opts ...grpc.CallOption) (*SRPRegisterResp, error) {
+	out := new(SRPRegisterResp)
+	err := c.cc.Invoke(ctx, SRPService_Register_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sRPServiceClient) StartLogin(ctx context.Context, in *SRPStartReq, opts ...grpc.CallOption) (*SRPStartResp, error) {
+	out := new(SRPStartResp)
+	err := c.cc.Invoke(ctx, SRPService_StartLogin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sRPServiceClient) FinishLogin(ctx context.Context, in *SRPFinishReq, opts ...grpc.CallOption) (*SRPFinishResp, error) {
+	out := new(SRPFinishResp)
+	err := c.cc.Invoke(ctx, SRPService_FinishLogin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SRPServiceServer is the server API for SRPService service.
+// All implementations must embed UnimplementedSRPServiceServer
+// for forward compatibility
+type SRPServiceServer interface {
+	Register(context.Context, *SRPRegisterReq) (*SRPRegisterResp, error)
+	StartLogin(context.Context, *SRPStartReq) (*SRPStartResp, error)
+	FinishLogin(context.Context, *SRPFinishReq) (*SRPFinishResp, error)
+	mustEmbedUnimplementedSRPServiceServer()
+}
+
+// UnimplementedSRPServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSRPServiceServer struct {
+}
+
+func (UnimplementedSRPServiceServer) Register(context.Context, *SRPRegisterReq) (*SRPRegisterResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedSRPServiceServer) StartLogin(context.Context, *SRPStartReq) (*SRPStartResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartLogin not implemented")
+}
+func (UnimplementedSRPServiceServer) FinishLogin(context.Context, *SRPFinishReq) (*SRPFinishResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishLogin not implemented")
+}
+func (UnimplementedSRPServiceServer) mustEmbedUnimplementedSRPServiceServer() {}
+
+// UnsafeSRPServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SRPServiceServer will
+// result in compilation errors.
+type UnsafeSRPServiceServer interface {
+	mustEmbedUnimplementedSRPServiceServer()
+}
+
+func RegisterSRPServiceServer(s grpc.ServiceRegistrar, srv SRPServiceServer) {
+	s.RegisterService(&SRPService_ServiceDesc, srv)
+}
+
+func _SRPService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SRPRegisterReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SRPServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SRPService_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SRPServiceServer).Register(ctx, req.(*SRPRegisterReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SRPService_StartLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SRPStartReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SRPServiceServer).StartLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SRPService_StartLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SRPServiceServer).StartLogin(ctx, req.(*SRPStartReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SRPService_FinishLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SRPFinishReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SRPServiceServer).FinishLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SRPService_FinishLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SRPServiceServer).FinishLogin(ctx, req.(*SRPFinishReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SRPService_ServiceDesc is the grpc.ServiceDesc for SRPService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SRPService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pwdm.SRPService",
+	HandlerType: (*SRPServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _SRPService_Register_Handler,
+		},
+		{
+			MethodName: "StartLogin",
+			Handler:    _SRPService_StartLogin_Handler,
+		},
+		{
+			MethodName: "FinishLogin",
+			Handler:    _SRPService_FinishLogin_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/pwdm_server.proto",
+}
+
+const (
+	ShareService_SetKeyPair_FullMethodName       = "/pwdm.ShareService/SetKeyPair"
+	ShareService_GetKeyPair_FullMethodName       = "/pwdm.ShareService/GetKeyPair"
+	ShareService_GetPublicKey_FullMethodName     = "/pwdm.ShareService/GetPublicKey"
+	ShareService_ShareItem_FullMethodName        = "/pwdm.ShareService/ShareItem"
+	ShareService_ListSharedWithMe_FullMethodName = "/pwdm.ShareService/ListSharedWithMe"
+	ShareService_RevokeShare_FullMethodName      = "/pwdm.ShareService/RevokeShare"
+)
+
+// ShareServiceClient is the client API for ShareService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ShareServiceClient interface {
+	SetKeyPair(ctx context.Context, in *SetKeyPairReq, opts ...grpc.CallOption) (*KeyPairResp, error)
+	GetKeyPair(ctx context.Context, in *GetKeyPairReq, opts ...grpc.CallOption) (*KeyPairResp, error)
+	GetPublicKey(ctx context.Context, in *GetPublicKeyReq, opts ...grpc.CallOption) (*KeyPairResp, error)
+	ShareItem(ctx context.Context, in *ShareItemReq, opts ...grpc.CallOption) (*ShareItemResp, error)
+	ListSharedWithMe(ctx context.Context, in *ListSharedWithMeReq, opts ...grpc.CallOption) (*ListSharedWithMeResp, error)
+	RevokeShare(ctx context.Context, in *RevokeShareReq, opts ...grpc.CallOption) (*RevokeShareResp, error)
+}
+
+type shareServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShareServiceClient(cc grpc.ClientConnInterface) ShareServiceClient {
+	return &shareServiceClient{cc}
+}
+
+func (c *shareServiceClient) SetKeyPair(ctx context.Context, in *SetKeyPairReq, opts ...grpc.CallOption) (*KeyPairResp, error) {
+	out := new(KeyPairResp)
+	err := c.cc.Invoke(ctx, ShareService_SetKeyPair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareServiceClient) GetKeyPair(ctx context.Context, in *GetKeyPairReq, opts ...grpc.CallOption) (*KeyPairResp, error) {
+	out := new(KeyPairResp)
+	err := c.cc.Invoke(ctx, ShareService_GetKeyPair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareServiceClient) GetPublicKey(ctx context.Context, in *GetPublicKeyReq, opts ...grpc.CallOption) (*KeyPairResp, error) {
+	out := new(KeyPairResp)
+	err := c.cc.Invoke(ctx, ShareService_GetPublicKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareServiceClient) ShareItem(ctx context.Context, in *ShareItemReq, opts ...grpc.CallOption) (*ShareItemResp, error) {
+	out := new(ShareItemResp)
+	err := c.cc.Invoke(ctx, ShareService_ShareItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareServiceClient) ListSharedWithMe(ctx context.Context, in *ListSharedWithMeReq, opts ...grpc.CallOption) (*ListSharedWithMeResp, error) {
+	out := new(ListSharedWithMeResp)
+	err := c.cc.Invoke(ctx, ShareService_ListSharedWithMe_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareServiceClient) RevokeShare(ctx context.Context, in *RevokeShareReq, opts ...grpc.CallOption) (*RevokeShareResp, error) {
+	out := new(RevokeShareResp)
+	err := c.cc.Invoke(ctx, ShareService_RevokeShare_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShareServiceServer is the server API for ShareService service.
+// All implementations must embed UnimplementedShareServiceServer
+// for forward compatibility
+type ShareServiceServer interface {
+	SetKeyPair(context.Context, *SetKeyPairReq) (*KeyPairResp, error)
+	GetKeyPair(context.Context, *GetKeyPairReq) (*KeyPairResp, error)
+	GetPublicKey(context.Context, *GetPublicKeyReq) (*KeyPairResp, error)
+	ShareItem(context.Context, *ShareItemReq) (*ShareItemResp, error)
+	ListSharedWithMe(context.Context, *ListSharedWithMeReq) (*ListSharedWithMeResp, error)
+	RevokeShare(context.Context, *RevokeShareReq) (*RevokeShareResp, error)
+	mustEmbedUnimplementedShareServiceServer()
+}
+
+// UnimplementedShareServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedShareServiceServer struct {
+}
+
+func (UnimplementedShareServiceServer) SetKeyPair(context.Context, *SetKeyPairReq) (*KeyPairResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKeyPair not implemented")
+}
+func (UnimplementedShareServiceServer) GetKeyPair(context.Context, *GetKeyPairReq) (*KeyPairResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeyPair not implemented")
+}
+func (UnimplementedShareServiceServer) GetPublicKey(context.Context, *GetPublicKeyReq) (*KeyPairResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicKey not implemented")
+}
+func (UnimplementedShareServiceServer) ShareItem(context.Context, *ShareItemReq) (*ShareItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareItem not implemented")
+}
+func (UnimplementedShareServiceServer) ListSharedWithMe(context.Context, *ListSharedWithMeReq) (*ListSharedWithMeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSharedWithMe not implemented")
+}
+func (UnimplementedShareServiceServer) RevokeShare(context.Context, *RevokeShareReq) (*RevokeShareResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeShare not implemented")
+}
+func (UnimplementedShareServiceServer) mustEmbedUnimplementedShareServiceServer() {}
+
+// UnsafeShareServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShareServiceServer will
+// result in compilation errors.
+type UnsafeShareServiceServer interface {
+	mustEmbedUnimplementedShareServiceServer()
+}
+
+func RegisterShareServiceServer(s grpc.ServiceRegistrar, srv ShareServiceServer) {
+	s.RegisterService(&ShareService_ServiceDesc, srv)
+}
+
+func _ShareService_SetKeyPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKeyPairReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServiceServer).SetKeyPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShareService_SetKeyPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServiceServer).SetKeyPair(ctx, req.(*SetKeyPairReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShareService_GetKeyPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKeyPairReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServiceServer).GetKeyPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShareService_GetKeyPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServiceServer).GetKeyPair(ctx, req.(*GetKeyPairReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShareService_GetPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicKeyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServiceServer).GetPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShareService_GetPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServiceServer).GetPublicKey(ctx, req.(*GetPublicKeyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShareService_ShareItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServiceServer).ShareItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShareService_ShareItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServiceServer).ShareItem(ctx, req.(*ShareItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShareService_ListSharedWithMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharedWithMeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServiceServer).ListSharedWithMe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShareService_ListSharedWithMe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServiceServer).ListSharedWithMe(ctx, req.(*ListSharedWithMeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShareService_RevokeShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServiceServer).RevokeShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShareService_RevokeShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServiceServer).RevokeShare(ctx, req.(*RevokeShareReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ShareService_ServiceDesc is the grpc.ServiceDesc for ShareService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ShareService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pwdm.ShareService",
+	HandlerType: (*ShareServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetKeyPair",
+			Handler:    _ShareService_SetKeyPair_Handler,
+		},
+		{
+			MethodName: "GetKeyPair",
+			Handler:    _ShareService_GetKeyPair_Handler,
+		},
+		{
+			MethodName: "GetPublicKey",
+			Handler:    _ShareService_GetPublicKey_Handler,
+		},
+		{
+			MethodName: "ShareItem",
+			Handler:    _ShareService_ShareItem_Handler,
+		},
+		{
+			MethodName: "ListSharedWithMe",
+			Handler:    _ShareService_ListSharedWithMe_Handler,
+		},
+		{
+			MethodName: "RevokeShare",
+			Handler:    _ShareService_RevokeShare_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/pwdm_server.proto",
+}
+
+const (
+	OrganizationService_CreateOrganization_FullMethodName    = "/pwdm.OrganizationService/CreateOrganization"
+	OrganizationService_ListOrganizations_FullMethodName     = "/pwdm.OrganizationService/ListOrganizations"
+	OrganizationService_ListMembers_FullMethodName           = "/pwdm.OrganizationService/ListMembers"
+	OrganizationService_RemoveMember_FullMethodName          = "/pwdm.OrganizationService/RemoveMember"
+	OrganizationService_InviteMember_FullMethodName          = "/pwdm.OrganizationService/InviteMember"
+	OrganizationService_ListInvitations_FullMethodName       = "/pwdm.OrganizationService/ListInvitations"
+	OrganizationService_AcceptInvitation_FullMethodName      = "/pwdm.OrganizationService/AcceptInvitation"
+	OrganizationService_DeclineInvitation_FullMethodName     = "/pwdm.OrganizationService/DeclineInvitation"
+	OrganizationService_CreateCollection_FullMethodName      = "/pwdm.OrganizationService/CreateCollection"
+	OrganizationService_ListCollections_FullMethodName       = "/pwdm.OrganizationService/ListCollections"
+	OrganizationService_SetCollectionRole_FullMethodName     = "/pwdm.OrganizationService/SetCollectionRole"
+	OrganizationService_ListCollectionMembers_FullMethodName = "/pwdm.OrganizationService/ListCollectionMembers"
+	OrganizationService_ListCollectionItems_FullMethodName   = "/pwdm.OrganizationService/ListCollectionItems"
+	OrganizationService_MoveToCollection_FullMethodName      = "/pwdm.OrganizationService/MoveToCollection"
+)
+
+// OrganizationServiceClient is the client API for OrganizationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OrganizationServiceClient interface {
+	CreateOrganization(ctx context.Context, in *CreateOrganizationReq, opts ...grpc.CallOption) (*CreateOrganizationResp, error)
+	ListOrganizations(ctx context.Context, in *ListOrganizationsReq, opts ...grpc.CallOption) (*ListOrganizationsResp, error)
+	ListMembers(ctx context.Context, in *ListMembersReq, opts ...grpc.CallOption) (*ListMembersResp, error)
+	RemoveMember(ctx context.Context, in *RemoveMemberReq, opts ...grpc.CallOption) (*OrgResp, error)
+	InviteMember(ctx context.Context, in *InviteMemberReq, opts ...grpc.CallOption) (*OrgResp, error)
+	ListInvitations(ctx context.Context, in *ListInvitationsReq, opts ...grpc.CallOption) (*ListInvitationsResp, error)
+	AcceptInvitation(ctx context.Context, in *InvitationReq, opts ...grpc.CallOption) (*OrgResp, error)
+	DeclineInvitation(ctx context.Context, in *InvitationReq, opts ...grpc.CallOption) (*OrgResp, error)
+	CreateCollection(ctx context.Context, in *CreateCollectionReq, opts ...grpc.CallOption) (*CreateCollectionResp, error)
+	ListCollections(ctx context.Context, in *ListCollectionsReq, opts ...grpc.CallOption) (*ListCollectionsResp, error)
+	SetCollectionRole(ctx context.Context, in *SetCollectionRoleReq, opts ...grpc.CallOption) (*OrgResp, error)
+	ListCollectionMembers(ctx context.Context, in *ListCollectionMembersReq, opts ...grpc.CallOption) (*ListCollectionMembersResp, error)
+	ListCollectionItems(ctx context.Context, in *ListCollectionItemsReq, opts ...grpc.CallOption) (*ListItemsResp, error)
+	MoveToCollection(ctx context.Context, in *MoveToCollectionReq, opts ...grpc.CallOption) (*OrgResp, error)
+}
+
+type organizationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrganizationServiceClient(cc grpc.ClientConnInterface) OrganizationServiceClient {
+	return &organizationServiceClient{cc}
+}
+
+func (c *organizationServiceClient) CreateOrganization(ctx context.Context, in *CreateOrganizationReq, opts ...grpc.CallOption) (*CreateOrganizationResp, error) {
+	out := new(CreateOrganizationResp)
+	err := c.cc.Invoke(ctx, OrganizationService_CreateOrganization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ListOrganizations(ctx context.Context, in *ListOrganizationsReq, opts ...grpc.CallOption) (*ListOrganizationsResp, error) {
+	out := new(ListOrganizationsResp)
+	err := c.cc.Invoke(ctx, OrganizationService_ListOrganizations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ListMembers(ctx context.Context, in *ListMembersReq, opts ...grpc.CallOption) (*ListMembersResp, error) {
+	out := new(ListMembersResp)
+	err := c.cc.Invoke(ctx, OrganizationService_ListMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) RemoveMember(ctx context.Context, in *RemoveMemberReq, opts ...grpc.CallOption) (*OrgResp, error) {
+	out := new(OrgResp)
+	err := c.cc.Invoke(ctx, OrganizationService_RemoveMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) InviteMember(ctx context.Context, in *InviteMemberReq, opts ...grpc.CallOption) (*OrgResp, error) {
+	out := new(OrgResp)
+	err := c.cc.Invoke(ctx, OrganizationService_InviteMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ListInvitations(ctx context.Context, in *ListInvitationsReq, opts ...grpc.CallOption) (*ListInvitationsResp, error) {
+	out := new(ListInvitationsResp)
+	err := c.cc.Invoke(ctx, OrganizationService_ListInvitations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) AcceptInvitation(ctx context.Context, in *InvitationReq, opts ...grpc.CallOption) (*OrgResp, error) {
+	out := new(OrgResp)
+	err := c.cc.Invoke(ctx, OrganizationService_AcceptInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) DeclineInvitation(ctx context.Context, in *InvitationReq, opts ...grpc.CallOption) (*OrgResp, error) {
+	out := new(OrgResp)
+	err := c.cc.Invoke(ctx, OrganizationService_DeclineInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionReq, opts ...grpc.CallOption) (*CreateCollectionResp, error) {
+	out := new(CreateCollectionResp)
+	err := c.cc.Invoke(ctx, OrganizationService_CreateCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ListCollections(ctx context.Context, in *ListCollectionsReq, opts ...grpc.CallOption) (*ListCollectionsResp, error) {
+	out := new(ListCollectionsResp)
+	err := c.cc.Invoke(ctx, OrganizationService_ListCollections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) SetCollectionRole(ctx context.Context, in *SetCollectionRoleReq, opts ...grpc.CallOption) (*OrgResp, error) {
+	out := new(OrgResp)
+	err := c.cc.Invoke(ctx, OrganizationService_SetCollectionRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ListCollectionMembers(ctx context.Context, in *ListCollectionMembersReq, opts ...grpc.CallOption) (*ListCollectionMembersResp, error) {
+	out := new(ListCollectionMembersResp)
+	err := c.cc.Invoke(ctx, OrganizationService_ListCollectionMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ListCollectionItems(ctx context.Context, in *ListCollectionItemsReq, opts ...grpc.CallOption) (*ListItemsResp, error) {
+	out := new(ListItemsResp)
+	err := c.cc.Invoke(ctx, OrganizationService_ListCollectionItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) MoveToCollection(ctx context.Context, in *MoveToCollectionReq, opts ...grpc.CallOption) (*OrgResp, error) {
+	out := new(OrgResp)
+	err := c.cc.Invoke(ctx, OrganizationService_MoveToCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrganizationServiceServer is the server API for OrganizationService service.
+// All implementations must embed UnimplementedOrganizationServiceServer
+// for forward compatibility
+type OrganizationServiceServer interface {
+	CreateOrganization(context.Context, *CreateOrganizationReq) (*CreateOrganizationResp, error)
+	ListOrganizations(context.Context, *ListOrganizationsReq) (*ListOrganizationsResp, error)
+	ListMembers(context.Context, *ListMembersReq) (*ListMembersResp, error)
+	RemoveMember(context.Context, *RemoveMemberReq) (*OrgResp, error)
+	InviteMember(context.Context, *InviteMemberReq) (*OrgResp, error)
+	ListInvitations(context.Context, *ListInvitationsReq) (*ListInvitationsResp, error)
+	AcceptInvitation(context.Context, *InvitationReq) (*OrgResp, error)
+	DeclineInvitation(context.Context, *InvitationReq) (*OrgResp, error)
+	CreateCollection(context.Context, *CreateCollectionReq) (*CreateCollectionResp, error)
+	ListCollections(context.Context, *ListCollectionsReq) (*ListCollectionsResp, error)
+	SetCollectionRole(context.Context, *SetCollectionRoleReq) (*OrgResp, error)
+	ListCollectionMembers(context.Context, *ListCollectionMembersReq) (*ListCollectionMembersResp, error)
+	ListCollectionItems(context.Context, *ListCollectionItemsReq) (*ListItemsResp, error)
+	MoveToCollection(context.Context, *MoveToCollectionReq) (*OrgResp, error)
+	mustEmbedUnimplementedOrganizationServiceServer()
+}
+
+// UnimplementedOrganizationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedOrganizationServiceServer struct {
+}
+
+func (UnimplementedOrganizationServiceServer) CreateOrganization(context.Context, *CreateOrganizationReq) (*CreateOrganizationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ListOrganizations(context.Context, *ListOrganizationsReq) (*ListOrganizationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizations not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ListMembers(context.Context, *ListMembersReq) (*ListMembersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
+}
+func (UnimplementedOrganizationServiceServer) RemoveMember(context.Context, *RemoveMemberReq) (*OrgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedOrganizationServiceServer) InviteMember(context.Context, *InviteMemberReq) (*OrgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteMember not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ListInvitations(context.Context, *ListInvitationsReq) (*ListInvitationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvitations not implemented")
+}
+func (UnimplementedOrganizationServiceServer) AcceptInvitation(context.Context, *InvitationReq) (*OrgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvitation not implemented")
+}
+func (UnimplementedOrganizationServiceServer) DeclineInvitation(context.Context, *InvitationReq) (*OrgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineInvitation not implemented")
+}
+func (UnimplementedOrganizationServiceServer) CreateCollection(context.Context, *CreateCollectionReq) (*CreateCollectionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ListCollections(context.Context, *ListCollectionsReq) (*ListCollectionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedOrganizationServiceServer) SetCollectionRole(context.Context, *SetCollectionRoleReq) (*OrgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionRole not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ListCollectionMembers(context.Context, *ListCollectionMembersReq) (*ListCollectionMembersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectionMembers not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ListCollectionItems(context.Context, *ListCollectionItemsReq) (*ListItemsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectionItems not implemented")
+}
+func (UnimplementedOrganizationServiceServer) MoveToCollection(context.Context, *MoveToCollectionReq) (*OrgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveToCollection not implemented")
+}
+func (UnimplementedOrganizationServiceServer) mustEmbedUnimplementedOrganizationServiceServer() {}
+
+// UnsafeOrganizationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrganizationServiceServer will
+// result in compilation errors.
+type UnsafeOrganizationServiceServer interface {
+	mustEmbedUnimplementedOrganizationServiceServer()
+}
+
+func RegisterOrganizationServiceServer(s grpc.ServiceRegistrar, srv OrganizationServiceServer) {
+	s.RegisterService(&OrganizationService_ServiceDesc, srv)
+}
+
+func _OrganizationService_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_CreateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).CreateOrganization(ctx, req.(*CreateOrganizationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ListOrganizations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListOrganizations(ctx, req.(*ListOrganizationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ListMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListMembers(ctx, req.(*ListMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_RemoveMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).RemoveMember(ctx, req.(*RemoveMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_InviteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).InviteMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_InviteMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).InviteMember(ctx, req.(*InviteMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ListInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListInvitations(ctx, req.(*ListInvitationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvitationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).AcceptInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_AcceptInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).AcceptInvitation(ctx, req.(*InvitationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_DeclineInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvitationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).DeclineInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_DeclineInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).DeclineInvitation(ctx, req.(*InvitationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).CreateCollection(ctx, req.(*CreateCollectionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ListCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListCollections(ctx, req.(*ListCollectionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_SetCollectionRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).SetCollectionRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_SetCollectionRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).SetCollectionRole(ctx, req.(*SetCollectionRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListCollectionMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListCollectionMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ListCollectionMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListCollectionMembers(ctx, req.(*ListCollectionMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListCollectionItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListCollectionItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ListCollectionItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListCollectionItems(ctx, req.(*ListCollectionItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_MoveToCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveToCollectionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).MoveToCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_MoveToCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).MoveToCollection(ctx, req.(*MoveToCollectionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrganizationService_ServiceDesc is the grpc.ServiceDesc for OrganizationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrganizationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pwdm.OrganizationService",
+	HandlerType: (*OrganizationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _OrganizationService_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "ListOrganizations",
+			Handler:    _OrganizationService_ListOrganizations_Handler,
+		},
+		{
+			MethodName: "ListMembers",
+			Handler:    _OrganizationService_ListMembers_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _OrganizationService_RemoveMember_Handler,
+		},
+		{
+			MethodName: "InviteMember",
+			Handler:    _OrganizationService_InviteMember_Handler,
+		},
+		{
+			MethodName: "ListInvitations",
+			Handler:    _OrganizationService_ListInvitations_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _OrganizationService_AcceptInvitation_Handler,
+		},
+		{
+			MethodName: "DeclineInvitation",
+			Handler:    _OrganizationService_DeclineInvitation_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _OrganizationService_CreateCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _OrganizationService_ListCollections_Handler,
+		},
+		{
+			MethodName: "SetCollectionRole",
+			Handler:    _OrganizationService_SetCollectionRole_Handler,
+		},
+		{
+			MethodName: "ListCollectionMembers",
+			Handler:    _OrganizationService_ListCollectionMembers_Handler,
+		},
+		{
+			MethodName: "ListCollectionItems",
+			Handler:    _OrganizationService_ListCollectionItems_Handler,
+		},
+		{
+			MethodName: "MoveToCollection",
+			Handler:    _OrganizationService_MoveToCollection_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/pwdm_server.proto",
+}
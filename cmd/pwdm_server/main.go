@@ -6,10 +6,19 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/BillyBones007/pwdm_server/internal/app/admin"
 	"github.com/BillyBones007/pwdm_server/internal/app/servergrpc"
 )
 
 func main() {
+	// the arguments are the administration command
+	if len(os.Args) > 1 {
+		if err := admin.Run(os.Args[1:], os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	app := servergrpc.NewServer()
 	closed := make(chan struct{})
 
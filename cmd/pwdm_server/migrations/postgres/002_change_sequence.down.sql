@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS log_pwd_data_changes ON log_pwd_data;
+DROP TRIGGER IF EXISTS card_data_changes ON card_data;
+DROP TRIGGER IF EXISTS text_data_changes ON text_data;
+DROP TRIGGER IF EXISTS binary_data_changes ON binary_data;
+DROP FUNCTION IF EXISTS register_change();
+DROP TABLE IF EXISTS changes;
+ALTER TABLE users DROP COLUMN IF EXISTS change_seq;
@@ -0,0 +1,25 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS change_seq BIGINT NOT NULL DEFAULT 0;
+CREATE TABLE IF NOT EXISTS changes(uuid UUID NOT NULL, type INTEGER NOT NULL, id INTEGER NOT NULL, seq BIGINT NOT NULL, deleted BOOLEAN NOT NULL DEFAULT false, PRIMARY KEY (uuid, type, id));
+CREATE INDEX IF NOT EXISTS changes_uuid_seq_idx ON changes(uuid, seq);
+CREATE OR REPLACE FUNCTION register_change() RETURNS TRIGGER AS $$
+DECLARE
+    next_seq BIGINT;
+BEGIN
+    UPDATE users SET change_seq = change_seq + 1 WHERE uuid = NEW.uuid RETURNING change_seq INTO next_seq;
+    INSERT INTO changes(uuid, type, id, seq, deleted) VALUES (NEW.uuid, TG_ARGV[0]::INTEGER, NEW.id, next_seq, NEW.deleted)
+        ON CONFLICT (uuid, type, id) DO UPDATE SET seq = EXCLUDED.seq, deleted = EXCLUDED.deleted;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+CREATE TRIGGER log_pwd_data_changes AFTER INSERT OR UPDATE ON log_pwd_data FOR EACH ROW EXECUTE FUNCTION register_change(1);
+CREATE TRIGGER card_data_changes AFTER INSERT OR UPDATE ON card_data FOR EACH ROW EXECUTE FUNCTION register_change(2);
+CREATE TRIGGER text_data_changes AFTER INSERT OR UPDATE ON text_data FOR EACH ROW EXECUTE FUNCTION register_change(3);
+CREATE TRIGGER binary_data_changes AFTER INSERT OR UPDATE ON binary_data FOR EACH ROW EXECUTE FUNCTION register_change(4);
+INSERT INTO changes(uuid, type, id, seq, deleted)
+    SELECT uuid, type, id, ROW_NUMBER() OVER (PARTITION BY uuid ORDER BY type, id), deleted FROM (
+        SELECT uuid, 1 AS type, id, deleted FROM log_pwd_data
+        UNION ALL SELECT uuid, 2 AS type, id, deleted FROM card_data
+        UNION ALL SELECT uuid, 3 AS type, id, deleted FROM text_data
+        UNION ALL SELECT uuid, 4 AS type, id, deleted FROM binary_data) AS items
+    ON CONFLICT DO NOTHING;
+UPDATE users SET change_seq = COALESCE((SELECT MAX(seq) FROM changes WHERE changes.uuid = users.uuid), 0);
@@ -0,0 +1,11 @@
+CREATE OR REPLACE FUNCTION register_change() RETURNS TRIGGER AS $$
+DECLARE
+    next_seq BIGINT;
+BEGIN
+    UPDATE users SET change_seq = change_seq + 1 WHERE uuid = NEW.uuid RETURNING change_seq INTO next_seq;
+    INSERT INTO changes(uuid, type, id, seq, deleted) VALUES (NEW.uuid, TG_ARGV[0]::INTEGER, NEW.id, next_seq, NEW.deleted)
+        ON CONFLICT (uuid, type, id) DO UPDATE SET seq = EXCLUDED.seq, deleted = EXCLUDED.deleted;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+ALTER TABLE changes DROP COLUMN IF EXISTS created_seq;
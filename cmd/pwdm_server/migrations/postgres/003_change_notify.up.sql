@@ -0,0 +1,13 @@
+ALTER TABLE changes ADD COLUMN IF NOT EXISTS created_seq BIGINT NOT NULL DEFAULT 0;
+UPDATE changes SET created_seq = seq;
+CREATE OR REPLACE FUNCTION register_change() RETURNS TRIGGER AS $$
+DECLARE
+    next_seq BIGINT;
+BEGIN
+    UPDATE users SET change_seq = change_seq + 1 WHERE uuid = NEW.uuid RETURNING change_seq INTO next_seq;
+    INSERT INTO changes(uuid, type, id, seq, created_seq, deleted) VALUES (NEW.uuid, TG_ARGV[0]::INTEGER, NEW.id, next_seq, next_seq, NEW.deleted)
+        ON CONFLICT (uuid, type, id) DO UPDATE SET seq = EXCLUDED.seq, deleted = EXCLUDED.deleted;
+    PERFORM pg_notify('changes', NEW.uuid::TEXT);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,2 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys(uuid UUID NOT NULL, key VARCHAR(255) NOT NULL, method VARCHAR(255) NOT NULL, response BYTEA, created_at TIMESTAMPTZ NOT NULL DEFAULT now(), PRIMARY KEY (uuid, key));
+CREATE INDEX IF NOT EXISTS idempotency_keys_created_at_idx ON idempotency_keys(created_at);
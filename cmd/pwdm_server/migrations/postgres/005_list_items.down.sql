@@ -0,0 +1,22 @@
+DROP VIEW IF EXISTS items;
+DROP INDEX IF EXISTS log_pwd_data_title_idx;
+DROP INDEX IF EXISTS card_data_title_idx;
+DROP INDEX IF EXISTS text_data_title_idx;
+DROP INDEX IF EXISTS binary_data_title_idx;
+DROP INDEX IF EXISTS log_pwd_data_uuid_tag_idx;
+DROP INDEX IF EXISTS card_data_uuid_tag_idx;
+DROP INDEX IF EXISTS text_data_uuid_tag_idx;
+DROP INDEX IF EXISTS binary_data_uuid_tag_idx;
+DROP INDEX IF EXISTS log_pwd_data_uuid_deleted_idx;
+DROP INDEX IF EXISTS card_data_uuid_deleted_idx;
+DROP INDEX IF EXISTS text_data_uuid_deleted_idx;
+DROP INDEX IF EXISTS binary_data_uuid_deleted_idx;
+DROP TRIGGER IF EXISTS log_pwd_data_updated_at ON log_pwd_data;
+DROP TRIGGER IF EXISTS card_data_updated_at ON card_data;
+DROP TRIGGER IF EXISTS text_data_updated_at ON text_data;
+DROP TRIGGER IF EXISTS binary_data_updated_at ON binary_data;
+DROP FUNCTION IF EXISTS set_updated_at();
+ALTER TABLE log_pwd_data DROP COLUMN IF EXISTS created_at, DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE card_data DROP COLUMN IF EXISTS created_at, DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE text_data DROP COLUMN IF EXISTS created_at, DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS created_at, DROP COLUMN IF EXISTS updated_at;
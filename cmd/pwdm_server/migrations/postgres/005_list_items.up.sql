@@ -0,0 +1,32 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+ALTER TABLE log_pwd_data ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now(), ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+ALTER TABLE card_data ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now(), ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+ALTER TABLE text_data ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now(), ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+ALTER TABLE binary_data ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT now(), ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+CREATE TRIGGER log_pwd_data_updated_at BEFORE UPDATE ON log_pwd_data FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER card_data_updated_at BEFORE UPDATE ON card_data FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER text_data_updated_at BEFORE UPDATE ON text_data FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE TRIGGER binary_data_updated_at BEFORE UPDATE ON binary_data FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE INDEX IF NOT EXISTS log_pwd_data_uuid_deleted_idx ON log_pwd_data(uuid, deleted);
+CREATE INDEX IF NOT EXISTS card_data_uuid_deleted_idx ON card_data(uuid, deleted);
+CREATE INDEX IF NOT EXISTS text_data_uuid_deleted_idx ON text_data(uuid, deleted);
+CREATE INDEX IF NOT EXISTS binary_data_uuid_deleted_idx ON binary_data(uuid, deleted);
+CREATE INDEX IF NOT EXISTS log_pwd_data_uuid_tag_idx ON log_pwd_data(uuid, tag);
+CREATE INDEX IF NOT EXISTS card_data_uuid_tag_idx ON card_data(uuid, tag);
+CREATE INDEX IF NOT EXISTS text_data_uuid_tag_idx ON text_data(uuid, tag);
+CREATE INDEX IF NOT EXISTS binary_data_uuid_tag_idx ON binary_data(uuid, tag);
+CREATE INDEX IF NOT EXISTS log_pwd_data_title_idx ON log_pwd_data USING gin (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS card_data_title_idx ON card_data USING gin (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS text_data_title_idx ON text_data USING gin (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS binary_data_title_idx ON binary_data USING gin (title gin_trgm_ops);
+CREATE OR REPLACE VIEW items AS
+    SELECT uuid, id, 1 AS type, title, tag, comment, created_at, updated_at, deleted FROM log_pwd_data
+    UNION ALL SELECT uuid, id, 2 AS type, title, tag, comment, created_at, updated_at, deleted FROM card_data
+    UNION ALL SELECT uuid, id, 3 AS type, title, tag, comment, created_at, updated_at, deleted FROM text_data
+    UNION ALL SELECT uuid, id, 4 AS type, title, tag, comment, created_at, updated_at, deleted FROM binary_data;
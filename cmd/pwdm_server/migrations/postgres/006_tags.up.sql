@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS tags(id SERIAL UNIQUE NOT NULL PRIMARY KEY, uuid UUID NOT NULL, name VARCHAR(255) NOT NULL, UNIQUE (uuid, name));
+CREATE TABLE IF NOT EXISTS item_tags(tag_id INTEGER NOT NULL REFERENCES tags(id) ON DELETE CASCADE, type INTEGER NOT NULL, item_id INTEGER NOT NULL, PRIMARY KEY (tag_id, type, item_id));
+CREATE INDEX IF NOT EXISTS item_tags_item_idx ON item_tags(type, item_id);
+INSERT INTO tags(uuid, name)
+    SELECT DISTINCT uuid, TRIM(name) FROM (
+        SELECT uuid, regexp_split_to_table(tag, ',') AS name FROM log_pwd_data
+        UNION ALL SELECT uuid, regexp_split_to_table(tag, ',') FROM card_data
+        UNION ALL SELECT uuid, regexp_split_to_table(tag, ',') FROM text_data
+        UNION ALL SELECT uuid, regexp_split_to_table(tag, ',') FROM binary_data) AS names
+    WHERE TRIM(name) <> ''
+    ON CONFLICT DO NOTHING;
+INSERT INTO item_tags(tag_id, type, item_id)
+    SELECT DISTINCT t.id, i.type, i.id FROM (
+        SELECT uuid, 1 AS type, id, TRIM(regexp_split_to_table(tag, ',')) AS name FROM log_pwd_data
+        UNION ALL SELECT uuid, 2, id, TRIM(regexp_split_to_table(tag, ',')) FROM card_data
+        UNION ALL SELECT uuid, 3, id, TRIM(regexp_split_to_table(tag, ',')) FROM text_data
+        UNION ALL SELECT uuid, 4, id, TRIM(regexp_split_to_table(tag, ',')) FROM binary_data) AS i
+    JOIN tags t ON t.uuid = i.uuid AND t.name = i.name
+    ON CONFLICT DO NOTHING;
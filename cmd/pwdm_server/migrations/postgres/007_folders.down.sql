@@ -0,0 +1,11 @@
+DROP VIEW IF EXISTS items;
+CREATE VIEW items AS
+    SELECT uuid, id, 1 AS type, title, tag, comment, created_at, updated_at, deleted FROM log_pwd_data
+    UNION ALL SELECT uuid, id, 2 AS type, title, tag, comment, created_at, updated_at, deleted FROM card_data
+    UNION ALL SELECT uuid, id, 3 AS type, title, tag, comment, created_at, updated_at, deleted FROM text_data
+    UNION ALL SELECT uuid, id, 4 AS type, title, tag, comment, created_at, updated_at, deleted FROM binary_data;
+ALTER TABLE log_pwd_data DROP COLUMN IF EXISTS folder_id;
+ALTER TABLE card_data DROP COLUMN IF EXISTS folder_id;
+ALTER TABLE text_data DROP COLUMN IF EXISTS folder_id;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS folder_id;
+DROP TABLE IF EXISTS folders;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS folders(id SERIAL UNIQUE NOT NULL PRIMARY KEY, uuid UUID NOT NULL, parent_id INTEGER REFERENCES folders(id) ON DELETE CASCADE, name VARCHAR(255) NOT NULL);
+CREATE UNIQUE INDEX IF NOT EXISTS folders_uuid_parent_name_idx ON folders(uuid, COALESCE(parent_id, 0), name);
+ALTER TABLE log_pwd_data ADD COLUMN IF NOT EXISTS folder_id INTEGER REFERENCES folders(id) ON DELETE SET NULL;
+ALTER TABLE card_data ADD COLUMN IF NOT EXISTS folder_id INTEGER REFERENCES folders(id) ON DELETE SET NULL;
+ALTER TABLE text_data ADD COLUMN IF NOT EXISTS folder_id INTEGER REFERENCES folders(id) ON DELETE SET NULL;
+ALTER TABLE binary_data ADD COLUMN IF NOT EXISTS folder_id INTEGER REFERENCES folders(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS log_pwd_data_folder_idx ON log_pwd_data(folder_id);
+CREATE INDEX IF NOT EXISTS card_data_folder_idx ON card_data(folder_id);
+CREATE INDEX IF NOT EXISTS text_data_folder_idx ON text_data(folder_id);
+CREATE INDEX IF NOT EXISTS binary_data_folder_idx ON binary_data(folder_id);
+CREATE OR REPLACE VIEW items AS
+    SELECT uuid, id, 1 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id FROM log_pwd_data
+    UNION ALL SELECT uuid, id, 2 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id FROM card_data
+    UNION ALL SELECT uuid, id, 3 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id FROM text_data
+    UNION ALL SELECT uuid, id, 4 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id FROM binary_data;
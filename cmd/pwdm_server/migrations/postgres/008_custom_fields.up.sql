@@ -0,0 +1,9 @@
+ALTER TABLE log_pwd_data ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE card_data ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE text_data ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '[]';
+ALTER TABLE binary_data ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '[]';
+CREATE OR REPLACE VIEW items AS
+    SELECT uuid, id, 1 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM log_pwd_data
+    UNION ALL SELECT uuid, id, 2 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM card_data
+    UNION ALL SELECT uuid, id, 3 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM text_data
+    UNION ALL SELECT uuid, id, 4 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM binary_data;
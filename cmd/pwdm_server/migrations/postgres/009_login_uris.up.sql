@@ -0,0 +1,3 @@
+CREATE TABLE IF NOT EXISTS login_uris(id SERIAL UNIQUE NOT NULL PRIMARY KEY, item_id INTEGER NOT NULL REFERENCES log_pwd_data(id) ON DELETE CASCADE, position INTEGER NOT NULL, uri TEXT NOT NULL, match SMALLINT NOT NULL DEFAULT 0, host VARCHAR(255) NOT NULL DEFAULT '', base_domain VARCHAR(255) NOT NULL DEFAULT '');
+CREATE INDEX IF NOT EXISTS login_uris_item_idx ON login_uris(item_id);
+CREATE INDEX IF NOT EXISTS login_uris_base_domain_idx ON login_uris(base_domain);
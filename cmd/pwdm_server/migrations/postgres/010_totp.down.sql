@@ -0,0 +1 @@
+ALTER TABLE log_pwd_data DROP COLUMN IF EXISTS totp;
@@ -0,0 +1 @@
+ALTER TABLE log_pwd_data ADD COLUMN IF NOT EXISTS totp TEXT NOT NULL DEFAULT '';
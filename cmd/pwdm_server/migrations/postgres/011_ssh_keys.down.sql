@@ -0,0 +1,8 @@
+CREATE OR REPLACE VIEW items AS
+    SELECT uuid, id, 1 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM log_pwd_data
+    UNION ALL SELECT uuid, id, 2 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM card_data
+    UNION ALL SELECT uuid, id, 3 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM text_data
+    UNION ALL SELECT uuid, id, 4 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM binary_data;
+DELETE FROM changes WHERE type = 5;
+DELETE FROM item_tags WHERE type = 5;
+DROP TABLE IF EXISTS ssh_key_data;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS ssh_key_data(uuid UUID NOT NULL, id SERIAL UNIQUE NOT NULL PRIMARY KEY, type INTEGER NOT NULL, title VARCHAR(255), private_key TEXT, public_key TEXT, passphrase VARCHAR(255), key_type VARCHAR(255), fingerprint VARCHAR(255), tag VARCHAR(255), comment TEXT, deleted BOOLEAN DEFAULT false, created_at TIMESTAMPTZ NOT NULL DEFAULT now(), updated_at TIMESTAMPTZ NOT NULL DEFAULT now(), folder_id INTEGER REFERENCES folders(id) ON DELETE SET NULL, custom_fields JSONB NOT NULL DEFAULT '[]');
+CREATE TRIGGER ssh_key_data_changes AFTER INSERT OR UPDATE ON ssh_key_data FOR EACH ROW EXECUTE FUNCTION register_change(5);
+CREATE TRIGGER ssh_key_data_updated_at BEFORE UPDATE ON ssh_key_data FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE INDEX IF NOT EXISTS ssh_key_data_uuid_deleted_idx ON ssh_key_data(uuid, deleted);
+CREATE INDEX IF NOT EXISTS ssh_key_data_uuid_tag_idx ON ssh_key_data(uuid, tag);
+CREATE INDEX IF NOT EXISTS ssh_key_data_title_idx ON ssh_key_data USING gin (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS ssh_key_data_folder_idx ON ssh_key_data(folder_id);
+CREATE OR REPLACE VIEW items AS
+    SELECT uuid, id, 1 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM log_pwd_data
+    UNION ALL SELECT uuid, id, 2 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM card_data
+    UNION ALL SELECT uuid, id, 3 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM text_data
+    UNION ALL SELECT uuid, id, 4 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM binary_data
+    UNION ALL SELECT uuid, id, 5 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM ssh_key_data;
@@ -0,0 +1,9 @@
+CREATE OR REPLACE VIEW items AS
+    SELECT uuid, id, 1 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM log_pwd_data
+    UNION ALL SELECT uuid, id, 2 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM card_data
+    UNION ALL SELECT uuid, id, 3 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM text_data
+    UNION ALL SELECT uuid, id, 4 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM binary_data
+    UNION ALL SELECT uuid, id, 5 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM ssh_key_data;
+DELETE FROM changes WHERE type = 6;
+DELETE FROM item_tags WHERE type = 6;
+DROP TABLE IF EXISTS identity_data;
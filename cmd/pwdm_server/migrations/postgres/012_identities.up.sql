@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS identity_data(uuid UUID NOT NULL, id SERIAL UNIQUE NOT NULL PRIMARY KEY, type INTEGER NOT NULL, title VARCHAR(255), first_name VARCHAR(255), middle_name VARCHAR(255), last_name VARCHAR(255), address1 VARCHAR(255), address2 VARCHAR(255), city VARCHAR(255), state VARCHAR(255), postal_code VARCHAR(64), country VARCHAR(255), phone VARCHAR(64), email VARCHAR(255), passport_number VARCHAR(64), passport_expiry DATE, license_number VARCHAR(64), license_expiry DATE, national_id VARCHAR(64), tax_id VARCHAR(64), tag VARCHAR(255), comment TEXT, deleted BOOLEAN DEFAULT false, created_at TIMESTAMPTZ NOT NULL DEFAULT now(), updated_at TIMESTAMPTZ NOT NULL DEFAULT now(), folder_id INTEGER REFERENCES folders(id) ON DELETE SET NULL, custom_fields JSONB NOT NULL DEFAULT '[]');
+CREATE TRIGGER identity_data_changes AFTER INSERT OR UPDATE ON identity_data FOR EACH ROW EXECUTE FUNCTION register_change(6);
+CREATE TRIGGER identity_data_updated_at BEFORE UPDATE ON identity_data FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+CREATE INDEX IF NOT EXISTS identity_data_uuid_deleted_idx ON identity_data(uuid, deleted);
+CREATE INDEX IF NOT EXISTS identity_data_uuid_tag_idx ON identity_data(uuid, tag);
+CREATE INDEX IF NOT EXISTS identity_data_title_idx ON identity_data USING gin (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS identity_data_folder_idx ON identity_data(folder_id);
+CREATE OR REPLACE VIEW items AS
+    SELECT uuid, id, 1 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM log_pwd_data
+    UNION ALL SELECT uuid, id, 2 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM card_data
+    UNION ALL SELECT uuid, id, 3 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM text_data
+    UNION ALL SELECT uuid, id, 4 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM binary_data
+    UNION ALL SELECT uuid, id, 5 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM ssh_key_data
+    UNION ALL SELECT uuid, id, 6 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM identity_data;
@@ -0,0 +1,15 @@
+DROP VIEW IF EXISTS items;
+CREATE VIEW items AS
+    SELECT uuid, id, 1 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM log_pwd_data
+    UNION ALL SELECT uuid, id, 2 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM card_data
+    UNION ALL SELECT uuid, id, 3 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM text_data
+    UNION ALL SELECT uuid, id, 4 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM binary_data
+    UNION ALL SELECT uuid, id, 5 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM ssh_key_data
+    UNION ALL SELECT uuid, id, 6 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields FROM identity_data;
+DROP TABLE IF EXISTS expiry_reminders;
+ALTER TABLE log_pwd_data DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE card_data DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE text_data DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE ssh_key_data DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE identity_data DROP COLUMN IF EXISTS expires_at;
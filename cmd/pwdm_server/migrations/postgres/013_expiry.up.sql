@@ -0,0 +1,26 @@
+ALTER TABLE log_pwd_data ADD COLUMN IF NOT EXISTS expires_at DATE;
+ALTER TABLE card_data ADD COLUMN IF NOT EXISTS expires_at DATE;
+ALTER TABLE text_data ADD COLUMN IF NOT EXISTS expires_at DATE;
+ALTER TABLE binary_data ADD COLUMN IF NOT EXISTS expires_at DATE;
+ALTER TABLE ssh_key_data ADD COLUMN IF NOT EXISTS expires_at DATE;
+ALTER TABLE identity_data ADD COLUMN IF NOT EXISTS expires_at DATE;
+-- the card is valid through the last day of the month of its validity period (MM/YY),
+-- filling the derived dates is not a change of the records
+ALTER TABLE card_data DISABLE TRIGGER USER;
+UPDATE card_data SET expires_at = (make_date(2000 + right(card_data.date, 2)::INTEGER, left(card_data.date, 2)::INTEGER, 1) + INTERVAL '1 month - 1 day')::DATE
+    WHERE card_data.date ~ '^(0[1-9]|1[0-2])/[0-9]{2}$';
+ALTER TABLE card_data ENABLE TRIGGER USER;
+CREATE INDEX IF NOT EXISTS log_pwd_data_expires_at_idx ON log_pwd_data(expires_at) WHERE expires_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS card_data_expires_at_idx ON card_data(expires_at) WHERE expires_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS text_data_expires_at_idx ON text_data(expires_at) WHERE expires_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS binary_data_expires_at_idx ON binary_data(expires_at) WHERE expires_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS ssh_key_data_expires_at_idx ON ssh_key_data(expires_at) WHERE expires_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS identity_data_expires_at_idx ON identity_data(expires_at) WHERE expires_at IS NOT NULL;
+CREATE TABLE IF NOT EXISTS expiry_reminders(type INTEGER NOT NULL, id INTEGER NOT NULL, expires_at DATE NOT NULL, sent_at TIMESTAMPTZ NOT NULL DEFAULT now(), PRIMARY KEY (type, id, expires_at));
+CREATE OR REPLACE VIEW items AS
+    SELECT uuid, id, 1 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at FROM log_pwd_data
+    UNION ALL SELECT uuid, id, 2 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at FROM card_data
+    UNION ALL SELECT uuid, id, 3 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at FROM text_data
+    UNION ALL SELECT uuid, id, 4 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at FROM binary_data
+    UNION ALL SELECT uuid, id, 5 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at FROM ssh_key_data
+    UNION ALL SELECT uuid, id, 6 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at FROM identity_data;
@@ -0,0 +1,15 @@
+DROP VIEW IF EXISTS items;
+CREATE VIEW items AS
+    SELECT uuid, id, 1 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at FROM log_pwd_data
+    UNION ALL SELECT uuid, id, 2 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at FROM card_data
+    UNION ALL SELECT uuid, id, 3 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at FROM text_data
+    UNION ALL SELECT uuid, id, 4 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at FROM binary_data
+    UNION ALL SELECT uuid, id, 5 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at FROM ssh_key_data
+    UNION ALL SELECT uuid, id, 6 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at FROM identity_data;
+DROP TABLE IF EXISTS item_access;
+ALTER TABLE log_pwd_data DROP COLUMN IF EXISTS favorite;
+ALTER TABLE card_data DROP COLUMN IF EXISTS favorite;
+ALTER TABLE text_data DROP COLUMN IF EXISTS favorite;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS favorite;
+ALTER TABLE ssh_key_data DROP COLUMN IF EXISTS favorite;
+ALTER TABLE identity_data DROP COLUMN IF EXISTS favorite;
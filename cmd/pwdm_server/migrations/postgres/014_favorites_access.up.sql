@@ -0,0 +1,16 @@
+ALTER TABLE log_pwd_data ADD COLUMN IF NOT EXISTS favorite BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE card_data ADD COLUMN IF NOT EXISTS favorite BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE text_data ADD COLUMN IF NOT EXISTS favorite BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE binary_data ADD COLUMN IF NOT EXISTS favorite BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE ssh_key_data ADD COLUMN IF NOT EXISTS favorite BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE identity_data ADD COLUMN IF NOT EXISTS favorite BOOLEAN NOT NULL DEFAULT false;
+-- the access statistics are kept apart from the records, so reading a record is not a change for the synchronization
+CREATE TABLE IF NOT EXISTS item_access(uuid UUID NOT NULL, type INTEGER NOT NULL, id INTEGER NOT NULL, last_accessed_at TIMESTAMPTZ NOT NULL DEFAULT now(), access_count INTEGER NOT NULL DEFAULT 1, PRIMARY KEY (type, id));
+CREATE INDEX IF NOT EXISTS item_access_uuid_last_accessed_idx ON item_access(uuid, last_accessed_at);
+CREATE OR REPLACE VIEW items AS
+    SELECT uuid, id, 1 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite FROM log_pwd_data
+    UNION ALL SELECT uuid, id, 2 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite FROM card_data
+    UNION ALL SELECT uuid, id, 3 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite FROM text_data
+    UNION ALL SELECT uuid, id, 4 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite FROM binary_data
+    UNION ALL SELECT uuid, id, 5 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite FROM ssh_key_data
+    UNION ALL SELECT uuid, id, 6 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite FROM identity_data;
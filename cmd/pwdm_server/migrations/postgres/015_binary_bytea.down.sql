@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS binary_upload_chunks;
+DROP TABLE IF EXISTS binary_uploads;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS sha256;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS size;
+ALTER TABLE binary_data ALTER COLUMN data SET STORAGE EXTENDED;
+ALTER TABLE binary_data ALTER COLUMN data TYPE TEXT USING encode(data, 'hex');
@@ -0,0 +1,13 @@
+-- the binary data was stored hex encoded, the conversion is not a change of the records;
+-- the rows that are not valid hex (for example, encoded by the client) are kept as raw bytes
+ALTER TABLE binary_data ALTER COLUMN data TYPE BYTEA USING CASE WHEN data ~ '^([0-9a-fA-F]{2})*$'
+    THEN decode(data, 'hex') ELSE convert_to(data, 'UTF8') END;
+-- uncompressed out of line storage makes reading the data in chunks cheap
+ALTER TABLE binary_data ALTER COLUMN data SET STORAGE EXTERNAL;
+ALTER TABLE binary_data ADD COLUMN IF NOT EXISTS size BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE binary_data ADD COLUMN IF NOT EXISTS sha256 VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE binary_data DISABLE TRIGGER USER;
+UPDATE binary_data SET size = length(data), sha256 = encode(sha256(data), 'hex') WHERE data IS NOT NULL;
+ALTER TABLE binary_data ENABLE TRIGGER USER;
+CREATE TABLE IF NOT EXISTS binary_uploads(id UUID NOT NULL PRIMARY KEY DEFAULT uuid_generate_v4(), uuid UUID NOT NULL, item_id INTEGER NOT NULL DEFAULT 0, title VARCHAR(255), tag VARCHAR(255), comment TEXT, size BIGINT NOT NULL, sha256 VARCHAR(64) NOT NULL, received BIGINT NOT NULL DEFAULT 0, hash_state BYTEA, created_at TIMESTAMPTZ NOT NULL DEFAULT now());
+CREATE TABLE IF NOT EXISTS binary_upload_chunks(upload_id UUID NOT NULL REFERENCES binary_uploads(id) ON DELETE CASCADE, pos BIGINT NOT NULL, data BYTEA NOT NULL, PRIMARY KEY (upload_id, pos));
@@ -0,0 +1,10 @@
+-- only the contents that are not moved to the blob store yet are restored
+DROP INDEX IF EXISTS binary_data_sha256_idx;
+ALTER TABLE binary_data ADD COLUMN IF NOT EXISTS data BYTEA;
+ALTER TABLE binary_data ALTER COLUMN data SET STORAGE EXTERNAL;
+ALTER TABLE binary_data DISABLE TRIGGER USER;
+UPDATE binary_data b SET data = p.data FROM blob_pending p WHERE b.sha256 = p.sha256;
+UPDATE binary_data SET data = ''::bytea WHERE size = 0;
+ALTER TABLE binary_data ENABLE TRIGGER USER;
+DROP TABLE IF EXISTS blob_pending;
+DROP TABLE IF EXISTS blobs;
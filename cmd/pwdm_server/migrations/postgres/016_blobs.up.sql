@@ -0,0 +1,7 @@
+-- registry of the contents in the blob store, the content is collected when no record refers to it
+CREATE TABLE IF NOT EXISTS blobs(sha256 VARCHAR(64) NOT NULL PRIMARY KEY, size BIGINT NOT NULL, created_at TIMESTAMPTZ NOT NULL DEFAULT now());
+-- the contents to move to the blob store at the start of the server
+CREATE TABLE IF NOT EXISTS blob_pending(sha256 VARCHAR(64) NOT NULL PRIMARY KEY, data BYTEA NOT NULL);
+INSERT INTO blob_pending(sha256, data) SELECT DISTINCT ON (sha256) sha256, data FROM binary_data WHERE data IS NOT NULL AND size > 0 ON CONFLICT DO NOTHING;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS data;
+CREATE INDEX IF NOT EXISTS binary_data_sha256_idx ON binary_data(sha256);
@@ -0,0 +1,5 @@
+DELETE FROM binary_uploads WHERE attachment = true;
+ALTER TABLE binary_uploads DROP COLUMN IF EXISTS mime_type;
+ALTER TABLE binary_uploads DROP COLUMN IF EXISTS type;
+ALTER TABLE binary_uploads DROP COLUMN IF EXISTS attachment;
+DROP TABLE IF EXISTS attachments;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS attachments(id SERIAL NOT NULL PRIMARY KEY, uuid UUID NOT NULL, type INTEGER NOT NULL, item_id INTEGER NOT NULL, filename VARCHAR(255) NOT NULL, mime_type VARCHAR(255) NOT NULL DEFAULT '', size BIGINT NOT NULL, sha256 VARCHAR(64) NOT NULL, created_at TIMESTAMPTZ NOT NULL DEFAULT now());
+CREATE INDEX IF NOT EXISTS attachments_item_idx ON attachments(type, item_id);
+CREATE INDEX IF NOT EXISTS attachments_uuid_idx ON attachments(uuid);
+CREATE INDEX IF NOT EXISTS attachments_sha256_idx ON attachments(sha256);
+-- the uploads of the attachments share the staging of the binary uploads
+ALTER TABLE binary_uploads ADD COLUMN IF NOT EXISTS attachment BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE binary_uploads ADD COLUMN IF NOT EXISTS type INTEGER NOT NULL DEFAULT 4;
+ALTER TABLE binary_uploads ADD COLUMN IF NOT EXISTS mime_type VARCHAR(255) NOT NULL DEFAULT '';
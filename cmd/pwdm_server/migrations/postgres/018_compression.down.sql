@@ -0,0 +1,14 @@
+-- the compressed data cannot be decompressed by SQL
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM text_data WHERE data_z IS NOT NULL) OR EXISTS (SELECT 1 FROM blobs WHERE encoding <> 0) THEN
+        RAISE EXCEPTION 'compressed data exists, the migration cannot be reverted';
+    END IF;
+END $$;
+DROP INDEX IF EXISTS blobs_object_key_idx;
+ALTER TABLE blobs DROP COLUMN IF EXISTS frames;
+ALTER TABLE blobs DROP COLUMN IF EXISTS encoding;
+ALTER TABLE blobs DROP COLUMN IF EXISTS stored_size;
+ALTER TABLE blobs DROP COLUMN IF EXISTS object_key;
+ALTER TABLE text_data DROP COLUMN IF EXISTS size;
+ALTER TABLE text_data DROP COLUMN IF EXISTS data_z;
@@ -0,0 +1,10 @@
+-- the new notes are stored in data_z with the format marker, the old rows keep the note in data
+ALTER TABLE text_data ADD COLUMN IF NOT EXISTS data_z BYTEA;
+ALTER TABLE text_data ADD COLUMN IF NOT EXISTS size BIGINT;
+-- the compressed content is stored under the SHA-256 of its frames, the old contents are stored as is
+ALTER TABLE blobs ADD COLUMN IF NOT EXISTS object_key VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE blobs ADD COLUMN IF NOT EXISTS stored_size BIGINT;
+ALTER TABLE blobs ADD COLUMN IF NOT EXISTS encoding SMALLINT NOT NULL DEFAULT 0;
+ALTER TABLE blobs ADD COLUMN IF NOT EXISTS frames BYTEA;
+UPDATE blobs SET object_key = sha256, stored_size = size;
+CREATE INDEX IF NOT EXISTS blobs_object_key_idx ON blobs(object_key);
@@ -0,0 +1,30 @@
+-- the encrypted secrets cannot be decrypted by SQL
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM user_keys) THEN
+        RAISE EXCEPTION 'encrypted secrets exist, the migration cannot be reverted';
+    END IF;
+END $$;
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+CREATE OR REPLACE FUNCTION register_change() RETURNS TRIGGER AS $$
+DECLARE
+    next_seq BIGINT;
+BEGIN
+    UPDATE users SET change_seq = change_seq + 1 WHERE uuid = NEW.uuid RETURNING change_seq INTO next_seq;
+    INSERT INTO changes(uuid, type, id, seq, created_seq, deleted) VALUES (NEW.uuid, TG_ARGV[0]::INTEGER, NEW.id, next_seq, next_seq, NEW.deleted)
+        ON CONFLICT (uuid, type, id) DO UPDATE SET seq = EXCLUDED.seq, deleted = EXCLUDED.deleted;
+    PERFORM pg_notify('changes', NEW.uuid::TEXT);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+ALTER TABLE identity_data ALTER COLUMN passport_number TYPE VARCHAR(64), ALTER COLUMN license_number TYPE VARCHAR(64),
+    ALTER COLUMN national_id TYPE VARCHAR(64), ALTER COLUMN tax_id TYPE VARCHAR(64);
+ALTER TABLE ssh_key_data ALTER COLUMN passphrase TYPE VARCHAR(255);
+ALTER TABLE card_data ALTER COLUMN num TYPE VARCHAR(255), ALTER COLUMN cvc TYPE VARCHAR(255);
+ALTER TABLE log_pwd_data ALTER COLUMN password TYPE VARCHAR(255);
+DROP TABLE IF EXISTS user_keys;
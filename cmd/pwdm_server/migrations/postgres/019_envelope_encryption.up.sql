@@ -0,0 +1,33 @@
+-- the data key of the user wrapped with the version of the master key
+CREATE TABLE IF NOT EXISTS user_keys(uuid UUID NOT NULL PRIMARY KEY, version INTEGER NOT NULL, wrapped BYTEA NOT NULL, created_at TIMESTAMPTZ NOT NULL DEFAULT now());
+CREATE INDEX IF NOT EXISTS user_keys_version_idx ON user_keys(version);
+-- the encrypted secrets are longer than the plain ones
+ALTER TABLE log_pwd_data ALTER COLUMN password TYPE TEXT;
+ALTER TABLE card_data ALTER COLUMN num TYPE TEXT, ALTER COLUMN cvc TYPE TEXT;
+ALTER TABLE ssh_key_data ALTER COLUMN passphrase TYPE TEXT;
+ALTER TABLE identity_data ALTER COLUMN passport_number TYPE TEXT, ALTER COLUMN license_number TYPE TEXT,
+    ALTER COLUMN national_id TYPE TEXT, ALTER COLUMN tax_id TYPE TEXT;
+-- the server encrypts the old plain secrets in the background, it is not a change of the record
+CREATE OR REPLACE FUNCTION register_change() RETURNS TRIGGER AS $$
+DECLARE
+    next_seq BIGINT;
+BEGIN
+    IF current_setting('pwdm.skip_changes', true) = 'on' THEN
+        RETURN NEW;
+    END IF;
+    UPDATE users SET change_seq = change_seq + 1 WHERE uuid = NEW.uuid RETURNING change_seq INTO next_seq;
+    INSERT INTO changes(uuid, type, id, seq, created_seq, deleted) VALUES (NEW.uuid, TG_ARGV[0]::INTEGER, NEW.id, next_seq, next_seq, NEW.deleted)
+        ON CONFLICT (uuid, type, id) DO UPDATE SET seq = EXCLUDED.seq, deleted = EXCLUDED.deleted;
+    PERFORM pg_notify('changes', NEW.uuid::TEXT);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+CREATE OR REPLACE FUNCTION set_updated_at() RETURNS TRIGGER AS $$
+BEGIN
+    IF current_setting('pwdm.skip_changes', true) = 'on' THEN
+        RETURN NEW;
+    END IF;
+    NEW.updated_at = now();
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
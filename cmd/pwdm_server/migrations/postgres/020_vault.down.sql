@@ -0,0 +1,9 @@
+-- the vault items are encrypted by the client, they cannot be moved to the plain records
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM vault_params) THEN
+        RAISE EXCEPTION 'vault accounts exist, the migration cannot be reverted';
+    END IF;
+END $$;
+DROP TABLE IF EXISTS vault_items;
+DROP TABLE IF EXISTS vault_params;
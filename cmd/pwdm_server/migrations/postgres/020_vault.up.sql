@@ -0,0 +1,10 @@
+-- the key derivation parameters of the user in the vault mode, the revision counts the changes of the vault items
+CREATE TABLE IF NOT EXISTS vault_params(uuid UUID NOT NULL PRIMARY KEY, kdf VARCHAR(32) NOT NULL, salt BYTEA NOT NULL,
+    iterations INTEGER NOT NULL, memory INTEGER NOT NULL DEFAULT 0, parallelism INTEGER NOT NULL DEFAULT 0,
+    revision BIGINT NOT NULL DEFAULT 0, created_at TIMESTAMPTZ NOT NULL DEFAULT now());
+-- the items encrypted by the client, only the metadata exposed by the client is plain
+CREATE TABLE IF NOT EXISTS vault_items(id SERIAL PRIMARY KEY, uuid UUID NOT NULL, blob BYTEA NOT NULL,
+    metadata JSONB NOT NULL DEFAULT '{}', revision BIGINT NOT NULL, deleted BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(), updated_at TIMESTAMPTZ NOT NULL DEFAULT now());
+CREATE INDEX IF NOT EXISTS vault_items_revision_idx ON vault_items(uuid, revision);
+CREATE INDEX IF NOT EXISTS vault_items_metadata_idx ON vault_items USING GIN (metadata jsonb_path_ops);
@@ -0,0 +1,10 @@
+-- the users who logged in with SRP have no password to log in with
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM users WHERE password = '') THEN
+        RAISE EXCEPTION 'users without the password exist, the migration cannot be reverted';
+    END IF;
+END $$;
+DROP TABLE IF EXISTS srp_sessions;
+ALTER TABLE users DROP COLUMN IF EXISTS srp_verifier;
+ALTER TABLE users DROP COLUMN IF EXISTS srp_salt;
@@ -0,0 +1,7 @@
+-- the salt and the verifier of the SRP login, the password of the user who logged in with SRP is not kept
+ALTER TABLE users ADD COLUMN IF NOT EXISTS srp_salt BYTEA;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS srp_verifier BYTEA;
+-- the started SRP logins, the secret value of the server is used once
+CREATE TABLE IF NOT EXISTS srp_sessions(id VARCHAR(64) NOT NULL PRIMARY KEY, uuid UUID NOT NULL,
+    client_public BYTEA NOT NULL, secret BYTEA NOT NULL, expires_at TIMESTAMPTZ NOT NULL);
+CREATE INDEX IF NOT EXISTS srp_sessions_uuid_idx ON srp_sessions(uuid);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS vault_shares;
+DROP TABLE IF EXISTS user_public_keys;
@@ -0,0 +1,9 @@
+-- the public key of the user and its private key encrypted by the client
+CREATE TABLE IF NOT EXISTS user_public_keys(uuid UUID NOT NULL PRIMARY KEY, algorithm VARCHAR(32) NOT NULL, public_key BYTEA NOT NULL,
+    encrypted_private_key BYTEA NOT NULL, fingerprint VARCHAR(64) NOT NULL, updated_at TIMESTAMPTZ NOT NULL DEFAULT now());
+-- the key of the vault item wrapped with the public key of the recipient
+CREATE TABLE IF NOT EXISTS vault_shares(id SERIAL PRIMARY KEY, owner UUID NOT NULL,
+    item_id INTEGER NOT NULL REFERENCES vault_items(id) ON DELETE CASCADE, recipient UUID NOT NULL, wrapped_key BYTEA NOT NULL,
+    fingerprint VARCHAR(64) NOT NULL, permission SMALLINT NOT NULL, created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (item_id, recipient));
+CREATE INDEX IF NOT EXISTS vault_shares_recipient_idx ON vault_shares(recipient);
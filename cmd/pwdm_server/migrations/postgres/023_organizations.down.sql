@@ -0,0 +1,32 @@
+-- the records of the organizations have no user to be returned to
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM organizations) THEN
+        RAISE EXCEPTION 'organizations exist, the migration cannot be reverted';
+    END IF;
+END $$;
+CREATE OR REPLACE FUNCTION register_change() RETURNS TRIGGER AS $$
+DECLARE
+    next_seq BIGINT;
+BEGIN
+    IF current_setting('pwdm.skip_changes', true) = 'on' THEN
+        RETURN NEW;
+    END IF;
+    UPDATE users SET change_seq = change_seq + 1 WHERE uuid = NEW.uuid RETURNING change_seq INTO next_seq;
+    INSERT INTO changes(uuid, type, id, seq, created_seq, deleted) VALUES (NEW.uuid, TG_ARGV[0]::INTEGER, NEW.id, next_seq, next_seq, NEW.deleted)
+        ON CONFLICT (uuid, type, id) DO UPDATE SET seq = EXCLUDED.seq, deleted = EXCLUDED.deleted;
+    PERFORM pg_notify('changes', NEW.uuid::TEXT);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+ALTER TABLE identity_data DROP COLUMN IF EXISTS collection_id;
+ALTER TABLE ssh_key_data DROP COLUMN IF EXISTS collection_id;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS collection_id;
+ALTER TABLE text_data DROP COLUMN IF EXISTS collection_id;
+ALTER TABLE card_data DROP COLUMN IF EXISTS collection_id;
+ALTER TABLE log_pwd_data DROP COLUMN IF EXISTS collection_id;
+DROP TABLE IF EXISTS collection_members;
+DROP TABLE IF EXISTS collections;
+DROP TABLE IF EXISTS org_invitations;
+DROP TABLE IF EXISTS org_members;
+DROP TABLE IF EXISTS organizations;
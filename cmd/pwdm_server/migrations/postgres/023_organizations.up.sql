@@ -0,0 +1,45 @@
+-- the organization owns the records of its collections, its id is stored as the uuid of the records
+CREATE TABLE IF NOT EXISTS organizations(id UUID NOT NULL PRIMARY KEY DEFAULT uuid_generate_v4(), name VARCHAR(255) NOT NULL,
+    change_seq BIGINT NOT NULL DEFAULT 0, created_at TIMESTAMPTZ NOT NULL DEFAULT now());
+CREATE TABLE IF NOT EXISTS org_members(org_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE, uuid UUID NOT NULL,
+    admin BOOLEAN NOT NULL DEFAULT false, joined_at TIMESTAMPTZ NOT NULL DEFAULT now(), PRIMARY KEY (org_id, uuid));
+CREATE INDEX IF NOT EXISTS org_members_uuid_idx ON org_members(uuid);
+CREATE TABLE IF NOT EXISTS org_invitations(org_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE, uuid UUID NOT NULL,
+    invited_by UUID NOT NULL, created_at TIMESTAMPTZ NOT NULL DEFAULT now(), PRIMARY KEY (org_id, uuid));
+CREATE INDEX IF NOT EXISTS org_invitations_uuid_idx ON org_invitations(uuid);
+CREATE TABLE IF NOT EXISTS collections(id SERIAL PRIMARY KEY, org_id UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL, created_at TIMESTAMPTZ NOT NULL DEFAULT now(), UNIQUE (org_id, name));
+-- the role of the member in the collection: 1 - viewer, 2 - editor, 3 - manager
+CREATE TABLE IF NOT EXISTS collection_members(collection_id INTEGER NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+    uuid UUID NOT NULL, role SMALLINT NOT NULL, PRIMARY KEY (collection_id, uuid));
+CREATE INDEX IF NOT EXISTS collection_members_uuid_idx ON collection_members(uuid);
+ALTER TABLE log_pwd_data ADD COLUMN IF NOT EXISTS collection_id INTEGER REFERENCES collections(id);
+ALTER TABLE card_data ADD COLUMN IF NOT EXISTS collection_id INTEGER REFERENCES collections(id);
+ALTER TABLE text_data ADD COLUMN IF NOT EXISTS collection_id INTEGER REFERENCES collections(id);
+ALTER TABLE binary_data ADD COLUMN IF NOT EXISTS collection_id INTEGER REFERENCES collections(id);
+ALTER TABLE ssh_key_data ADD COLUMN IF NOT EXISTS collection_id INTEGER REFERENCES collections(id);
+ALTER TABLE identity_data ADD COLUMN IF NOT EXISTS collection_id INTEGER REFERENCES collections(id);
+CREATE INDEX IF NOT EXISTS log_pwd_data_collection_idx ON log_pwd_data(collection_id) WHERE collection_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS card_data_collection_idx ON card_data(collection_id) WHERE collection_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS text_data_collection_idx ON text_data(collection_id) WHERE collection_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS binary_data_collection_idx ON binary_data(collection_id) WHERE collection_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS ssh_key_data_collection_idx ON ssh_key_data(collection_id) WHERE collection_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS identity_data_collection_idx ON identity_data(collection_id) WHERE collection_id IS NOT NULL;
+-- the changes of the records of the organization are counted by the organization
+CREATE OR REPLACE FUNCTION register_change() RETURNS TRIGGER AS $$
+DECLARE
+    next_seq BIGINT;
+BEGIN
+    IF current_setting('pwdm.skip_changes', true) = 'on' THEN
+        RETURN NEW;
+    END IF;
+    UPDATE users SET change_seq = change_seq + 1 WHERE uuid = NEW.uuid RETURNING change_seq INTO next_seq;
+    IF next_seq IS NULL THEN
+        UPDATE organizations SET change_seq = change_seq + 1 WHERE id = NEW.uuid RETURNING change_seq INTO next_seq;
+    END IF;
+    INSERT INTO changes(uuid, type, id, seq, created_seq, deleted) VALUES (NEW.uuid, TG_ARGV[0]::INTEGER, NEW.id, next_seq, next_seq, NEW.deleted)
+        ON CONFLICT (uuid, type, id) DO UPDATE SET seq = EXCLUDED.seq, deleted = EXCLUDED.deleted;
+    PERFORM pg_notify('changes', NEW.uuid::TEXT);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
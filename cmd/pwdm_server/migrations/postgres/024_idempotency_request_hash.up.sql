@@ -0,0 +1,2 @@
+-- the keys stored before the migration have no hash and are matched by the method only
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS request_hash BYTEA;
@@ -0,0 +1,16 @@
+DROP VIEW IF EXISTS items;
+CREATE VIEW items AS
+    SELECT uuid, id, 1 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite FROM log_pwd_data
+    UNION ALL SELECT uuid, id, 2 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite FROM card_data
+    UNION ALL SELECT uuid, id, 3 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite FROM text_data
+    UNION ALL SELECT uuid, id, 4 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite FROM binary_data
+    UNION ALL SELECT uuid, id, 5 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite FROM ssh_key_data
+    UNION ALL SELECT uuid, id, 6 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite FROM identity_data;
+-- the secrets of the started SRP logins cannot be opened by the older server
+DELETE FROM srp_sessions;
+ALTER TABLE log_pwd_data DROP COLUMN IF EXISTS seal_version;
+ALTER TABLE card_data DROP COLUMN IF EXISTS seal_version;
+ALTER TABLE text_data DROP COLUMN IF EXISTS seal_version;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS seal_version;
+ALTER TABLE ssh_key_data DROP COLUMN IF EXISTS seal_version;
+ALTER TABLE identity_data DROP COLUMN IF EXISTS seal_version;
@@ -0,0 +1,41 @@
+-- the version of the encryption of the record: 0 - plain, 1 - the secret columns are authenticated with the owner,
+-- 2 - the secret columns and the hidden custom fields are authenticated with the owner and the record;
+-- -1 - the values only look encrypted, they are checked by decrypting them
+ALTER TABLE log_pwd_data ADD COLUMN IF NOT EXISTS seal_version SMALLINT NOT NULL DEFAULT 0;
+ALTER TABLE card_data ADD COLUMN IF NOT EXISTS seal_version SMALLINT NOT NULL DEFAULT 0;
+ALTER TABLE text_data ADD COLUMN IF NOT EXISTS seal_version SMALLINT NOT NULL DEFAULT 0;
+ALTER TABLE binary_data ADD COLUMN IF NOT EXISTS seal_version SMALLINT NOT NULL DEFAULT 0;
+ALTER TABLE ssh_key_data ADD COLUMN IF NOT EXISTS seal_version SMALLINT NOT NULL DEFAULT 0;
+ALTER TABLE identity_data ADD COLUMN IF NOT EXISTS seal_version SMALLINT NOT NULL DEFAULT 0;
+-- marking the records is not a change of them
+ALTER TABLE log_pwd_data DISABLE TRIGGER USER;
+UPDATE log_pwd_data SET seal_version = -1 WHERE password LIKE '$pwe1$%' OR totp LIKE '$pwe1$%';
+ALTER TABLE log_pwd_data ENABLE TRIGGER USER;
+ALTER TABLE card_data DISABLE TRIGGER USER;
+UPDATE card_data SET seal_version = -1 WHERE num LIKE '$pwe1$%' OR cvc LIKE '$pwe1$%';
+ALTER TABLE card_data ENABLE TRIGGER USER;
+ALTER TABLE text_data DISABLE TRIGGER USER;
+UPDATE text_data SET seal_version = -1 WHERE substring(data_z FROM 1 FOR 4) = 'PWE1'::BYTEA;
+ALTER TABLE text_data ENABLE TRIGGER USER;
+ALTER TABLE ssh_key_data DISABLE TRIGGER USER;
+UPDATE ssh_key_data SET seal_version = -1 WHERE private_key LIKE '$pwe1$%' OR passphrase LIKE '$pwe1$%';
+ALTER TABLE ssh_key_data ENABLE TRIGGER USER;
+ALTER TABLE identity_data DISABLE TRIGGER USER;
+UPDATE identity_data SET seal_version = -1 WHERE passport_number LIKE '$pwe1$%' OR license_number LIKE '$pwe1$%'
+    OR national_id LIKE '$pwe1$%' OR tax_id LIKE '$pwe1$%';
+ALTER TABLE identity_data ENABLE TRIGGER USER;
+CREATE INDEX IF NOT EXISTS log_pwd_data_seal_version_idx ON log_pwd_data(id) WHERE seal_version <> 2;
+CREATE INDEX IF NOT EXISTS card_data_seal_version_idx ON card_data(id) WHERE seal_version <> 2;
+CREATE INDEX IF NOT EXISTS text_data_seal_version_idx ON text_data(id) WHERE seal_version <> 2;
+CREATE INDEX IF NOT EXISTS binary_data_seal_version_idx ON binary_data(id) WHERE seal_version <> 2;
+CREATE INDEX IF NOT EXISTS ssh_key_data_seal_version_idx ON ssh_key_data(id) WHERE seal_version <> 2;
+CREATE INDEX IF NOT EXISTS identity_data_seal_version_idx ON identity_data(id) WHERE seal_version <> 2;
+-- the secrets of the started SRP logins are authenticated with the login now
+DELETE FROM srp_sessions;
+CREATE OR REPLACE VIEW items AS
+    SELECT uuid, id, 1 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite, seal_version FROM log_pwd_data
+    UNION ALL SELECT uuid, id, 2 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite, seal_version FROM card_data
+    UNION ALL SELECT uuid, id, 3 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite, seal_version FROM text_data
+    UNION ALL SELECT uuid, id, 4 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite, seal_version FROM binary_data
+    UNION ALL SELECT uuid, id, 5 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite, seal_version FROM ssh_key_data
+    UNION ALL SELECT uuid, id, 6 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite, seal_version FROM identity_data;
@@ -0,0 +1,41 @@
+-- registers the change of the record for the user with the next number of the sequence of the user
+CREATE OR REPLACE FUNCTION register_user_change(member UUID, item_type INTEGER, item_id INTEGER, item_deleted BOOLEAN) RETURNS VOID AS $$
+DECLARE
+    next_seq BIGINT;
+BEGIN
+    UPDATE users SET change_seq = change_seq + 1 WHERE uuid = member RETURNING change_seq INTO next_seq;
+    IF next_seq IS NULL THEN
+        RETURN;
+    END IF;
+    INSERT INTO changes(uuid, type, id, seq, created_seq, deleted) VALUES (member, item_type, item_id, next_seq, next_seq, item_deleted)
+        ON CONFLICT (uuid, type, id) DO UPDATE SET seq = EXCLUDED.seq, deleted = EXCLUDED.deleted;
+    PERFORM pg_notify('changes', member::TEXT);
+END;
+$$ LANGUAGE plpgsql;
+-- the change of the record of the collection is registered for each member of the collection,
+-- so the members synchronize it with their own cursors
+CREATE OR REPLACE FUNCTION register_change() RETURNS TRIGGER AS $$
+BEGIN
+    IF current_setting('pwdm.skip_changes', true) = 'on' THEN
+        RETURN NEW;
+    END IF;
+    IF NEW.collection_id IS NULL THEN
+        PERFORM register_user_change(NEW.uuid, TG_ARGV[0]::INTEGER, NEW.id, NEW.deleted);
+    ELSE
+        PERFORM register_user_change(m.uuid, TG_ARGV[0]::INTEGER, NEW.id, NEW.deleted) FROM collection_members m
+            WHERE m.collection_id = NEW.collection_id ORDER BY m.uuid;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+CREATE OR REPLACE VIEW items AS
+    SELECT uuid, id, 1 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite, seal_version, collection_id FROM log_pwd_data
+    UNION ALL SELECT uuid, id, 2 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite, seal_version, collection_id FROM card_data
+    UNION ALL SELECT uuid, id, 3 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite, seal_version, collection_id FROM text_data
+    UNION ALL SELECT uuid, id, 4 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite, seal_version, collection_id FROM binary_data
+    UNION ALL SELECT uuid, id, 5 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite, seal_version, collection_id FROM ssh_key_data
+    UNION ALL SELECT uuid, id, 6 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite, seal_version, collection_id FROM identity_data;
+-- the changes counted by the organizations are not read by the members, the members get the records of their collections
+DELETE FROM changes WHERE uuid IN (SELECT id FROM organizations);
+SELECT register_user_change(m.uuid, i.type, i.id, false) FROM collection_members m
+    JOIN items i ON i.collection_id = m.collection_id WHERE i.deleted = false ORDER BY m.uuid, i.type, i.id;
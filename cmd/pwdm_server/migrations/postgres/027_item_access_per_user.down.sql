@@ -0,0 +1,5 @@
+-- the statistics of the record are kept once, the last read is kept
+DELETE FROM item_access a USING item_access b WHERE a.type = b.type AND a.id = b.id
+    AND (a.last_accessed_at, a.uuid) < (b.last_accessed_at, b.uuid);
+ALTER TABLE item_access DROP CONSTRAINT IF EXISTS item_access_pkey;
+ALTER TABLE item_access ADD PRIMARY KEY (type, id);
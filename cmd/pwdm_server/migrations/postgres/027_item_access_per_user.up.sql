@@ -0,0 +1,3 @@
+-- the access statistics are kept for each reader, the members of a collection read the same records
+ALTER TABLE item_access DROP CONSTRAINT IF EXISTS item_access_pkey;
+ALTER TABLE item_access ADD PRIMARY KEY (uuid, type, id);
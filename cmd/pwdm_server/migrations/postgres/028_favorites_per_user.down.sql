@@ -0,0 +1,21 @@
+ALTER TABLE log_pwd_data ADD COLUMN IF NOT EXISTS favorite BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE card_data ADD COLUMN IF NOT EXISTS favorite BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE text_data ADD COLUMN IF NOT EXISTS favorite BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE binary_data ADD COLUMN IF NOT EXISTS favorite BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE ssh_key_data ADD COLUMN IF NOT EXISTS favorite BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE identity_data ADD COLUMN IF NOT EXISTS favorite BOOLEAN NOT NULL DEFAULT false;
+UPDATE log_pwd_data d SET favorite = true FROM item_favorites f WHERE f.type = 1 AND f.id = d.id;
+UPDATE card_data d SET favorite = true FROM item_favorites f WHERE f.type = 2 AND f.id = d.id;
+UPDATE text_data d SET favorite = true FROM item_favorites f WHERE f.type = 3 AND f.id = d.id;
+UPDATE binary_data d SET favorite = true FROM item_favorites f WHERE f.type = 4 AND f.id = d.id;
+UPDATE ssh_key_data d SET favorite = true FROM item_favorites f WHERE f.type = 5 AND f.id = d.id;
+UPDATE identity_data d SET favorite = true FROM item_favorites f WHERE f.type = 6 AND f.id = d.id;
+DROP VIEW IF EXISTS items;
+CREATE VIEW items AS
+    SELECT uuid, id, 1 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite, seal_version, collection_id FROM log_pwd_data
+    UNION ALL SELECT uuid, id, 2 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite, seal_version, collection_id FROM card_data
+    UNION ALL SELECT uuid, id, 3 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite, seal_version, collection_id FROM text_data
+    UNION ALL SELECT uuid, id, 4 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite, seal_version, collection_id FROM binary_data
+    UNION ALL SELECT uuid, id, 5 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite, seal_version, collection_id FROM ssh_key_data
+    UNION ALL SELECT uuid, id, 6 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, favorite, seal_version, collection_id FROM identity_data;
+DROP TABLE IF EXISTS item_favorites;
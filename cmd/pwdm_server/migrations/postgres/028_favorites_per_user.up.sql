@@ -0,0 +1,21 @@
+-- the favorite marks are kept for each user, the members of a collection mark the same records
+CREATE TABLE IF NOT EXISTS item_favorites(uuid UUID NOT NULL, type INTEGER NOT NULL, id INTEGER NOT NULL, PRIMARY KEY (uuid, type, id));
+INSERT INTO item_favorites(uuid, type, id) SELECT uuid, type, id FROM items WHERE favorite AND collection_id IS NULL
+    ON CONFLICT DO NOTHING;
+-- the mark of the record of the collection was seen by every member, so every member keeps it
+INSERT INTO item_favorites(uuid, type, id) SELECT m.uuid, i.type, i.id FROM items i
+    JOIN collection_members m ON m.collection_id = i.collection_id WHERE i.favorite ON CONFLICT DO NOTHING;
+DROP VIEW IF EXISTS items;
+CREATE VIEW items AS
+    SELECT uuid, id, 1 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, seal_version, collection_id FROM log_pwd_data
+    UNION ALL SELECT uuid, id, 2 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, seal_version, collection_id FROM card_data
+    UNION ALL SELECT uuid, id, 3 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, seal_version, collection_id FROM text_data
+    UNION ALL SELECT uuid, id, 4 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, seal_version, collection_id FROM binary_data
+    UNION ALL SELECT uuid, id, 5 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, seal_version, collection_id FROM ssh_key_data
+    UNION ALL SELECT uuid, id, 6 AS type, title, tag, comment, created_at, updated_at, deleted, folder_id, custom_fields, expires_at, seal_version, collection_id FROM identity_data;
+ALTER TABLE log_pwd_data DROP COLUMN IF EXISTS favorite;
+ALTER TABLE card_data DROP COLUMN IF EXISTS favorite;
+ALTER TABLE text_data DROP COLUMN IF EXISTS favorite;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS favorite;
+ALTER TABLE ssh_key_data DROP COLUMN IF EXISTS favorite;
+ALTER TABLE identity_data DROP COLUMN IF EXISTS favorite;
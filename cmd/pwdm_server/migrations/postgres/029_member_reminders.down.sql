@@ -0,0 +1,6 @@
+-- the reminder about the record is kept once
+DELETE FROM expiry_reminders a USING expiry_reminders b
+    WHERE a.type = b.type AND a.id = b.id AND a.expires_at = b.expires_at AND a.uuid < b.uuid;
+ALTER TABLE expiry_reminders DROP CONSTRAINT IF EXISTS expiry_reminders_pkey;
+ALTER TABLE expiry_reminders DROP COLUMN IF EXISTS uuid;
+ALTER TABLE expiry_reminders ADD PRIMARY KEY (type, id, expires_at);
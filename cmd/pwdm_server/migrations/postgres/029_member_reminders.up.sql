@@ -0,0 +1,14 @@
+-- the reminder about the record is kept for each user who gets it, the members of a collection get the reminders
+-- about its records
+ALTER TABLE expiry_reminders ADD COLUMN IF NOT EXISTS uuid UUID;
+ALTER TABLE expiry_reminders DROP CONSTRAINT IF EXISTS expiry_reminders_pkey;
+-- the reminder already sent about the record of the collection is not sent to its members again
+INSERT INTO expiry_reminders(uuid, type, id, expires_at, sent_at)
+    SELECT m.uuid, r.type, r.id, r.expires_at, r.sent_at FROM expiry_reminders r
+    JOIN items i ON i.type = r.type AND i.id = r.id JOIN collection_members m ON m.collection_id = i.collection_id
+    WHERE r.uuid IS NULL;
+UPDATE expiry_reminders r SET uuid = i.uuid FROM items i
+    WHERE r.uuid IS NULL AND i.type = r.type AND i.id = r.id AND i.collection_id IS NULL;
+DELETE FROM expiry_reminders WHERE uuid IS NULL;
+ALTER TABLE expiry_reminders ALTER COLUMN uuid SET NOT NULL;
+ALTER TABLE expiry_reminders ADD PRIMARY KEY (uuid, type, id, expires_at);
@@ -0,0 +1,210 @@
+// Admin package runs the administration commands of the server.
+// The commands use the configuration of the server.
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/BillyBones007/pwdm_server/internal/app/servergrpc"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/keyprovider"
+	"github.com/BillyBones007/pwdm_server/internal/storage/postgres"
+)
+
+// Number of the data keys rewrapped in one transaction.
+const rewrapBatchSize = 100
+
+// Time after the check of the retired version when the data keys are counted again before the version is removed.
+// The servers read the key file again before the data key is wrapped and reload the master keys once an hour,
+// so the data keys wrapped with the old version by the servers that have not read the new version yet are met.
+const retireGracePeriod = time.Hour
+
+// Usage of the administration commands.
+const usage = `Usage:
+  pwdm_server keys status            versions of the master key and the number of the data keys wrapped with them
+  pwdm_server keys rotate            adds the new version of the master key
+  pwdm_server keys rewrap            rewraps the data keys with the current version of the master key
+  pwdm_server keys retire <version>  removes the version of the master key that no data keys use for an hour`
+
+// ErrUsage - wrong arguments of the command.
+var ErrUsage = errors.New(usage)
+
+// Run - runs the administration command, args are the arguments after the name of the program.
+func Run(args []string, out io.Writer) error {
+	if len(args) < 2 || args[0] != "keys" {
+		return ErrUsage
+	}
+	cfg := servergrpc.LoadServerConfig()
+	ctx := context.Background()
+	switch args[1] {
+	case "status":
+		return status(ctx, cfg, out)
+	case "rotate":
+		return rotate(cfg, out)
+	case "rewrap":
+		return rewrap(ctx, cfg, out)
+	case "retire":
+		if len(args) != 3 {
+			return ErrUsage
+		}
+		version, err := strconv.ParseInt(args[2], 10, 32)
+		if err != nil {
+			return ErrUsage
+		}
+		return retire(ctx, cfg, int32(version), out)
+	}
+	return ErrUsage
+}
+
+// openStorage - returns the storage with the master keys of the configuration.
+func openStorage(cfg *servergrpc.ServerConfig) (*postgres.ClientPostgres, keyprovider.KeyProvider, error) {
+	keys, err := cfg.GetKeyProvider()
+	if err != nil {
+		return nil, nil, err
+	}
+	stor, err := postgres.NewClientPostgres(cfg.DSN, nil, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	return stor, keys, nil
+}
+
+// status - shows the number of the data keys wrapped with each version of the master key.
+func status(ctx context.Context, cfg *servergrpc.ServerConfig, out io.Writer) error {
+	stor, keys, err := openStorage(cfg)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+	versions, err := stor.SelectKeyVersions(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Current version: %d\n", keys.CurrentVersion())
+	var remaining int64
+	for _, v := range versions {
+		mark := ""
+		if v.Current {
+			mark = " (current)"
+		} else if v.Version < keys.CurrentVersion() {
+			remaining += v.Keys
+		}
+		fmt.Fprintf(out, "Version %d%s: %d data keys\n", v.Version, mark, v.Keys)
+	}
+	fmt.Fprintf(out, "Data keys to rewrap: %d\n", remaining)
+	return nil
+}
+
+// rotate - adds the new version of the master key. The key file is changed in place,
+// the new value of the environment variable is shown. The keys of the KMS are created by the KMS.
+func rotate(cfg *servergrpc.ServerConfig, out io.Writer) error {
+	switch cfg.KeyProvider {
+	case "", "file":
+		version, err := keyprovider.RotateKeyFile(cfg.GetKeyFile())
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Master key version %d is added to %s\n", version, cfg.GetKeyFile())
+		fmt.Fprintln(out, "The servers read the file again within an hour and rewrap the data keys in the background")
+		return nil
+	case "env":
+		text, version, err := keyprovider.AddKey(cfg.MasterKeys)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Master key version %d is added, restart the servers with:\nMASTER_KEYS=%s\n", version, text)
+		return nil
+	case "kms":
+		fmt.Fprintln(out, "Create the key in the KMS and add it to kms_key_ids with the next version")
+		return customerror.ErrRotationUnsupported
+	}
+	return customerror.ErrUnknownKeyProvider
+}
+
+// rewrap - rewraps the data keys with the current version of the master key and shows the progress.
+// The rewrapped batches are saved, so the stopped command continues from the remaining keys.
+func rewrap(ctx context.Context, cfg *servergrpc.ServerConfig, out io.Writer) error {
+	stor, keys, err := openStorage(cfg)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+	total := 0
+	for {
+		n, err := stor.RewrapDataKeys(ctx, rewrapBatchSize)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			break
+		}
+		total += n
+		fmt.Fprintf(out, "Rewrapped %d data keys\n", total)
+	}
+	fmt.Fprintf(out, "All data keys use version %d\n", keys.CurrentVersion())
+	return nil
+}
+
+// retire - removes the version of the master key. The version is removed only if no data keys use it
+// before and after the grace period, so the data keys wrapped by the lagging servers are not lost.
+func retire(ctx context.Context, cfg *servergrpc.ServerConfig, version int32, out io.Writer) error {
+	stor, keys, err := openStorage(cfg)
+	if err != nil {
+		return err
+	}
+	defer stor.Close()
+	if version == keys.CurrentVersion() {
+		return customerror.ErrRetireCurrentKey
+	}
+	if err := checkVersionUnused(ctx, stor, version, out); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Version %d wraps no data keys, checking again in %s\n", version, retireGracePeriod)
+	select {
+	case <-time.After(retireGracePeriod):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if err := checkVersionUnused(ctx, stor, version, out); err != nil {
+		return err
+	}
+	switch cfg.KeyProvider {
+	case "", "file":
+		if err := keyprovider.RetireKeyFile(cfg.GetKeyFile(), version); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Master key version %d is removed from %s\n", version, cfg.GetKeyFile())
+		return nil
+	case "env":
+		text, err := keyprovider.RemoveKey(cfg.MasterKeys, version)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Master key version %d is not used, restart the servers with:\nMASTER_KEYS=%s\n", version, text)
+		return nil
+	case "kms":
+		fmt.Fprintf(out, "Master key version %d is not used, remove it from kms_key_ids\n", version)
+		return nil
+	}
+	return customerror.ErrUnknownKeyProvider
+}
+
+// checkVersionUnused - returns customerror.ErrKeyVersionInUse if the data keys are wrapped with the version.
+func checkVersionUnused(ctx context.Context, stor *postgres.ClientPostgres, version int32, out io.Writer) error {
+	versions, err := stor.SelectKeyVersions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, v := range versions {
+		if v.Version == version && v.Keys > 0 {
+			fmt.Fprintf(out, "Version %d wraps %d data keys, run: pwdm_server keys rewrap\n", version, v.Keys)
+			return customerror.ErrKeyVersionInUse
+		}
+	}
+	return nil
+}
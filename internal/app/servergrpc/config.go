@@ -6,18 +6,139 @@ import (
 	"log"
 	"os"
 	"reflect"
+	"time"
 
+	"github.com/BillyBones007/pwdm_server/internal/blobstore"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/keyprovider"
 	"github.com/caarlos0/env"
 )
 
 // Default config file.
 const DefaultConfigFile = "config.json"
 
+// Default time of storing the responses for the idempotency keys.
+const DefaultIdempotencyTTL = "24h"
+
+// Default time before the expiry date when the reminder is sent.
+const DefaultReminderHorizon = "720h"
+
+// Default interval of searching the records for the reminders.
+const DefaultReminderInterval = "1h"
+
+// Default blob store of the contents of the binary records.
+const DefaultBlobStore = "fs"
+
+// Default directory of the filesystem blob store.
+const DefaultBlobDir = "blobs"
+
+// Default region of the S3 blob store.
+const DefaultS3Region = "us-east-1"
+
+// Default key provider of the master keys.
+const DefaultKeyProvider = "file"
+
+// Default file of the master keys, it is created if it does not exist.
+const DefaultKeyFile = "master.key"
+
 // ServerConfig - configuration server structure.
 type ServerConfig struct {
-	PortgRPC   string `env:"GRPC_PORT" json:"grpc_port,omitempty"`
-	DSN        string `env:"DSN" json:"dsn,omitempty"`
-	ConfigFile string `env:"CONFIG_FILE"`
+	PortgRPC         string `env:"GRPC_PORT" json:"grpc_port,omitempty"`
+	DSN              string `env:"DSN" json:"dsn,omitempty"`
+	IdempotencyTTL   string `env:"IDEMPOTENCY_TTL" json:"idempotency_ttl,omitempty"`
+	ReminderHorizon  string `env:"REMINDER_HORIZON" json:"reminder_horizon,omitempty"`
+	ReminderInterval string `env:"REMINDER_INTERVAL" json:"reminder_interval,omitempty"`
+	BlobStore        string `env:"BLOB_STORE" json:"blob_store,omitempty"` // fs or s3
+	BlobDir          string `env:"BLOB_DIR" json:"blob_dir,omitempty"`
+	S3Endpoint       string `env:"S3_ENDPOINT" json:"s3_endpoint,omitempty"`
+	S3Region         string `env:"S3_REGION" json:"s3_region,omitempty"`
+	S3Bucket         string `env:"S3_BUCKET" json:"s3_bucket,omitempty"`
+	S3AccessKey      string `env:"S3_ACCESS_KEY" json:"s3_access_key,omitempty"`
+	S3SecretKey      string `env:"S3_SECRET_KEY" json:"s3_secret_key,omitempty"`
+	StorageQuota     int64  `env:"STORAGE_QUOTA" json:"storage_quota,omitempty"` // bytes per user, 0 - unlimited
+	KeyProvider      string `env:"KEY_PROVIDER" json:"key_provider,omitempty"`   // file, env or kms
+	KeyFile          string `env:"KEY_FILE" json:"key_file,omitempty"`
+	MasterKeys       string `env:"MASTER_KEYS" json:"-"`
+	KMSKeyIDs        string `env:"KMS_KEY_IDS" json:"kms_key_ids,omitempty"`
+	KMSKeyFile       string `env:"KMS_KEY_FILE" json:"kms_key_file,omitempty"` // keys of the local KMS
+	ConfigFile       string `env:"CONFIG_FILE"`
+}
+
+// GetIdempotencyTTL - returns the time of storing the responses for the idempotency keys.
+func (s *ServerConfig) GetIdempotencyTTL() (time.Duration, error) {
+	if s.IdempotencyTTL == "" {
+		return time.ParseDuration(DefaultIdempotencyTTL)
+	}
+	return time.ParseDuration(s.IdempotencyTTL)
+}
+
+// GetReminderHorizon - returns the time before the expiry date when the reminder is sent.
+func (s *ServerConfig) GetReminderHorizon() (time.Duration, error) {
+	if s.ReminderHorizon == "" {
+		return time.ParseDuration(DefaultReminderHorizon)
+	}
+	return time.ParseDuration(s.ReminderHorizon)
+}
+
+// GetReminderInterval - returns the interval of searching the records for the reminders.
+func (s *ServerConfig) GetReminderInterval() (time.Duration, error) {
+	if s.ReminderInterval == "" {
+		return time.ParseDuration(DefaultReminderInterval)
+	}
+	return time.ParseDuration(s.ReminderInterval)
+}
+
+// GetBlobStore - returns the blob store of the contents of the binary records.
+func (s *ServerConfig) GetBlobStore() (blobstore.BlobStore, error) {
+	switch s.BlobStore {
+	case "", "fs":
+		dir := s.BlobDir
+		if dir == "" {
+			dir = DefaultBlobDir
+		}
+		return blobstore.NewFSStore(dir)
+	case "s3":
+		region := s.S3Region
+		if region == "" {
+			region = DefaultS3Region
+		}
+		return blobstore.NewS3Store(s.S3Endpoint, region, s.S3Bucket, s.S3AccessKey, s.S3SecretKey)
+	}
+	return nil, customerror.ErrUnknownBlobStore
+}
+
+// GetKeyFile - returns the file of the master keys of the file key provider.
+func (s *ServerConfig) GetKeyFile() string {
+	if s.KeyFile == "" {
+		return DefaultKeyFile
+	}
+	return s.KeyFile
+}
+
+// GetKeyProvider - returns the provider of the master keys that wrap the data keys of the users.
+// The kms provider uses the local stand-in of the key management service with the keys from KMSKeyFile.
+func (s *ServerConfig) GetKeyProvider() (keyprovider.KeyProvider, error) {
+	switch s.KeyProvider {
+	case "", "file":
+		return keyprovider.NewFileProvider(s.GetKeyFile())
+	case "env":
+		return keyprovider.NewEnvProvider(s.MasterKeys)
+	case "kms":
+		data, err := os.ReadFile(s.KMSKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		kms, err := keyprovider.NewLocalKMS(string(data))
+		if err != nil {
+			return nil, err
+		}
+		ids, err := keyprovider.ParseKeyIDs(s.KMSKeyIDs)
+		if err != nil {
+			return nil, err
+		}
+		return keyprovider.NewKMSProvider(kms, ids)
+	}
+	return nil, customerror.ErrUnknownKeyProvider
 }
 
 // Set config from config file.
@@ -49,11 +170,18 @@ func (s *ServerConfig) replaceConfig(newCfg ServerConfig) {
 	}
 }
 
-// InitServerConfig - initializing the server configuration.
+// InitServerConfig - initializing the server configuration and displays it.
+func InitServerConfig() *ServerConfig {
+	cfg := LoadServerConfig()
+	paramConfigServerInfo(cfg)
+	return cfg
+}
+
+// LoadServerConfig - loads the server configuration.
 // The values have the following priority:
 // 1 - values from environment variables are prioritized.
 // 2 - values from confing file.
-func InitServerConfig() *ServerConfig {
+func LoadServerConfig() *ServerConfig {
 	mainConf := ServerConfig{}
 	envConf := ServerConfig{}
 	fileConf := ServerConfig{}
@@ -77,8 +205,6 @@ func InitServerConfig() *ServerConfig {
 	mainConf.replaceConfig(fileConf)
 	mainConf.replaceConfig(envConf)
 
-	paramConfigServerInfo(&mainConf)
-
 	return &mainConf
 }
 
@@ -87,6 +213,23 @@ func paramConfigServerInfo(cfg *ServerConfig) {
 	fmt.Println("Server configuration:")
 	fmt.Printf("Port gRPC: %s\n", cfg.PortgRPC)
 	fmt.Printf("DSN: %s\n", cfg.DSN)
+	fmt.Printf("Idempotency TTL: %s\n", cfg.IdempotencyTTL)
+	fmt.Printf("Reminder horizon: %s\n", cfg.ReminderHorizon)
+	fmt.Printf("Reminder interval: %s\n", cfg.ReminderInterval)
+	fmt.Printf("Blob store: %s\n", cfg.BlobStore)
+	if cfg.BlobStore == "s3" {
+		fmt.Printf("S3 endpoint: %s\n", cfg.S3Endpoint)
+		fmt.Printf("S3 bucket: %s\n", cfg.S3Bucket)
+	} else {
+		fmt.Printf("Blob directory: %s\n", cfg.BlobDir)
+	}
+	fmt.Printf("Storage quota: %d\n", cfg.StorageQuota)
+	fmt.Printf("Key provider: %s\n", cfg.KeyProvider)
+	if cfg.KeyProvider == "kms" {
+		fmt.Printf("KMS key ids: %s\n", cfg.KMSKeyIDs)
+	} else if cfg.KeyProvider != "env" {
+		fmt.Printf("Key file: %s\n", cfg.KeyFile)
+	}
 	fmt.Printf("Config file: %s\n", cfg.ConfigFile)
 }
 
@@ -1,31 +1,52 @@
 package servergrpc
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
 	"net"
 	"os"
+	"time"
 
+	srvpb "github.com/BillyBones007/pwdm_server/api"
 	"github.com/BillyBones007/pwdm_server/internal/grpcservices"
+	"github.com/BillyBones007/pwdm_server/internal/keyprovider"
 	"github.com/BillyBones007/pwdm_server/internal/logger"
+	"github.com/BillyBones007/pwdm_server/internal/reminder"
 	"github.com/BillyBones007/pwdm_server/internal/storage"
 	"github.com/BillyBones007/pwdm_server/internal/storage/postgres"
 	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
+	"github.com/BillyBones007/pwdm_server/internal/watcher"
 	pb "github.com/BillyBones007/pwdm_service_api/api"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 )
 
+// Unfinished uploads of the binary data are deleted after this time.
+const uploadTTL time.Duration = 24 * time.Hour
+
+// Contents of the binary records are collected if no record refers to them longer than this time.
+const blobGracePeriod time.Duration = time.Hour
+
+// Number of the data keys rewrapped with the new version of the master key in one transaction.
+const rewrapBatchSize = 100
+
 // Server - the main structure of the server gRPC.
 type Server struct {
 	Config       *ServerConfig
 	Storage      storage.Storage
+	Keys         keyprovider.KeyProvider
 	TokenTools   *tokentools.JWTTools
 	GRPCServer   *grpc.Server
 	Interceptors *grpcservices.InterceptorsService
+	Hub          *watcher.Hub
+	Reminder     *reminder.Scheduler
 	Logger       *logrus.Logger
+
+	idempotencyTTL time.Duration
+	stopWorkers    context.CancelFunc // stops the background workers
 }
 
 // NewServer - returns a pointer to the Server.
@@ -34,15 +55,43 @@ func NewServer() *Server {
 	server.Config = InitServerConfig()
 	server.Logger = logger.NewLogger()
 	server.TokenTools = tokentools.NewJWTTools()
-	stor, err := postgres.NewClientPostgres(server.Config.DSN)
+	blobs, err := server.Config.GetBlobStore()
+	if err != nil {
+		server.Logger.WithField("err", err).Fatal("Failed blob store")
+	}
+	keys, err := server.Config.GetKeyProvider()
+	if err != nil {
+		server.Logger.WithField("err", err).Fatal("Failed key provider")
+	}
+	server.Keys = keys
+	stor, err := postgres.NewClientPostgres(server.Config.DSN, blobs, keys)
 	if err != nil {
 		server.Logger.WithField("err", err).Fatalf("Failed database: %s", err)
 	}
+	stor.Quota = server.Config.StorageQuota
 	server.Storage = stor
+	server.Hub = watcher.NewHub(server.Storage, server.Logger)
+
+	idempotencyTTL, err := server.Config.GetIdempotencyTTL()
+	if err != nil {
+		server.Logger.WithField("err", err).Fatal("Failed idempotency TTL")
+	}
+
+	reminderHorizon, err := server.Config.GetReminderHorizon()
+	if err != nil {
+		server.Logger.WithField("err", err).Fatal("Failed reminder horizon")
+	}
+	reminderInterval, err := server.Config.GetReminderInterval()
+	if err != nil {
+		server.Logger.WithField("err", err).Fatal("Failed reminder interval")
+	}
+	server.Reminder = reminder.NewScheduler(server.Storage, reminder.NewLogNotifier(server.Logger),
+		reminderHorizon, reminderInterval, server.Logger)
 
 	// Interceptors - the pointer to InterceptorsService.
-	// Uses tools for working with jwt.
-	server.Interceptors = grpcservices.NewInterceptorsService(server.TokenTools, server.Logger)
+	// Uses tools for working with jwt and the storage for idempotency keys.
+	server.Interceptors = grpcservices.NewInterceptorsService(server.Storage, server.TokenTools, idempotencyTTL, server.Logger)
+	server.idempotencyTTL = idempotencyTTL
 
 	cert, err := tls.LoadX509KeyPair("cert/server.crt", "cert/server.key")
 	if err != nil {
@@ -61,7 +110,11 @@ func NewServer() *Server {
 		ClientCAs:    caCertPool,
 	}
 	creds := credentials.NewTLS(tlsConfig)
-	opts := []grpc.ServerOption{grpc.Creds(creds), grpc.UnaryInterceptor(server.Interceptors.AuthInterceptor)}
+	opts := []grpc.ServerOption{
+		grpc.Creds(creds),
+		grpc.ChainUnaryInterceptor(server.Interceptors.AuthInterceptor, server.Interceptors.VaultInterceptor, server.Interceptors.IdempotencyInterceptor),
+		grpc.ChainStreamInterceptor(server.Interceptors.AuthStreamInterceptor, server.Interceptors.VaultStreamInterceptor),
+	}
 	server.GRPCServer = grpc.NewServer(opts...)
 
 	pb.RegisterAuthServiceServer(server.GRPCServer, grpcservices.NewAuthService(server.Storage, server.TokenTools, server.Logger))
@@ -69,6 +122,23 @@ func NewServer() *Server {
 	pb.RegisterUpdateServiceServer(server.GRPCServer, grpcservices.NewUpdateService(server.Storage, server.TokenTools, server.Logger))
 	pb.RegisterDeleteServiceServer(server.GRPCServer, grpcservices.NewDeleteService(server.Storage, server.TokenTools, server.Logger))
 	pb.RegisterShowInfoServiceServer(server.GRPCServer, grpcservices.NewShowInfoService(server.Storage, server.TokenTools, server.Logger))
+	srvpb.RegisterSyncServiceServer(server.GRPCServer, grpcservices.NewSyncService(server.Storage, server.TokenTools, server.Logger))
+	srvpb.RegisterBatchServiceServer(server.GRPCServer, grpcservices.NewBatchService(server.Storage, server.TokenTools, server.Logger))
+	srvpb.RegisterWatchServiceServer(server.GRPCServer, grpcservices.NewWatchService(server.Storage, server.Hub, server.Logger))
+	srvpb.RegisterItemsServiceServer(server.GRPCServer, grpcservices.NewItemsService(server.Storage, server.TokenTools, server.Logger))
+	srvpb.RegisterFoldersServiceServer(server.GRPCServer, grpcservices.NewFoldersService(server.Storage, server.TokenTools, server.Logger))
+	srvpb.RegisterAutofillServiceServer(server.GRPCServer, grpcservices.NewAutofillService(server.Storage, server.TokenTools, server.Logger))
+	srvpb.RegisterTOTPServiceServer(server.GRPCServer, grpcservices.NewTOTPService(server.Storage, server.TokenTools, server.Logger))
+	srvpb.RegisterSSHKeyServiceServer(server.GRPCServer, grpcservices.NewSSHKeyService(server.Storage, server.TokenTools, server.Logger))
+	srvpb.RegisterIdentityServiceServer(server.GRPCServer, grpcservices.NewIdentityService(server.Storage, server.TokenTools, server.Logger))
+	srvpb.RegisterExpiryServiceServer(server.GRPCServer, grpcservices.NewExpiryService(server.Storage, server.TokenTools, server.Logger))
+	srvpb.RegisterBinaryServiceServer(server.GRPCServer, grpcservices.NewBinaryService(server.Storage, server.TokenTools, server.Logger))
+	srvpb.RegisterAttachmentServiceServer(server.GRPCServer, grpcservices.NewAttachmentService(server.Storage, server.TokenTools, server.Logger))
+	srvpb.RegisterUsageServiceServer(server.GRPCServer, grpcservices.NewUsageService(server.Storage, server.TokenTools, server.Logger))
+	srvpb.RegisterVaultServiceServer(server.GRPCServer, grpcservices.NewVaultService(server.Storage, server.TokenTools, server.Logger))
+	srvpb.RegisterSRPServiceServer(server.GRPCServer, grpcservices.NewSRPService(server.Storage, server.TokenTools, server.Logger))
+	srvpb.RegisterShareServiceServer(server.GRPCServer, grpcservices.NewShareService(server.Storage, server.TokenTools, server.Logger))
+	srvpb.RegisterOrganizationServiceServer(server.GRPCServer, grpcservices.NewOrganizationService(server.Storage, server.TokenTools, server.Logger))
 
 	return &server
 }
@@ -80,6 +150,17 @@ func (s *Server) StartServer() {
 		s.Logger.WithField("err", err).Fatal("The server crashed")
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopWorkers = cancel
+	go s.Hub.Run(ctx)
+	go s.cleanIdempotencyKeys(ctx)
+	go s.cleanUploads(ctx)
+	go s.cleanSRPSessions(ctx)
+	go s.collectBlobs(ctx)
+	go s.sealPlainSecrets(ctx)
+	go s.rewrapDataKeys(ctx)
+	go s.Reminder.Run(ctx)
+
 	go func() {
 		s.Logger.WithFields(logrus.Fields{
 			"grpc_port": s.Config.PortgRPC,
@@ -95,6 +176,134 @@ func (s *Server) StartServer() {
 // Shutdown - gracefully stoped the server.
 func (s *Server) Shutdown() {
 	s.Logger.Info("Interrupt signal received, server shutting down")
+	// watch streams are closed by the hub, otherwise the graceful stop waits for them
+	if s.stopWorkers != nil {
+		s.stopWorkers()
+	}
 	s.GRPCServer.GracefulStop()
 	s.Storage.Close()
 }
+
+// cleanIdempotencyKeys - periodically deletes the expired idempotency keys.
+func (s *Server) cleanIdempotencyKeys(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.Storage.DeleteExpiredIdempotencyKeys(ctx, time.Now().Add(-s.idempotencyTTL)); err != nil {
+				s.Logger.WithField("err", err).Error("Failed to delete expired idempotency keys")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// cleanUploads - periodically deletes the unfinished uploads of the binary data.
+func (s *Server) cleanUploads(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.Storage.DeleteExpiredUploads(ctx, time.Now().Add(-uploadTTL)); err != nil {
+				s.Logger.WithField("err", err).Error("Failed to delete expired uploads")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// cleanSRPSessions - periodically deletes the SRP logins that were not finished.
+func (s *Server) cleanSRPSessions(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.Storage.DeleteExpiredSRPSessions(ctx, time.Now()); err != nil {
+				s.Logger.WithField("err", err).Error("Failed to delete expired SRP sessions")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// collectBlobs - moves the contents left in the database by the migration to the blob store
+// and then periodically deletes the contents that no binary record refers to.
+func (s *Server) collectBlobs(ctx context.Context) {
+	if n, err := s.Storage.MovePendingBlobs(ctx); err != nil {
+		s.Logger.WithField("err", err).Error("Failed to move binary contents to the blob store")
+	} else if n > 0 {
+		s.Logger.WithField("count", n).Info("Binary contents moved to the blob store")
+	}
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			n, err := s.Storage.CollectBlobs(ctx, time.Now().Add(-blobGracePeriod))
+			if err != nil {
+				s.Logger.WithField("err", err).Error("Failed to collect unreferenced blobs")
+			} else if n > 0 {
+				s.Logger.WithField("count", n).Info("Unreferenced blobs deleted")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// sealPlainSecrets - encrypts the secrets stored before the encryption was enabled.
+func (s *Server) sealPlainSecrets(ctx context.Context) {
+	if n, err := s.Storage.SealPlainSecrets(ctx); err != nil {
+		s.Logger.WithField("err", err).Error("Failed to encrypt plain secrets")
+	} else if n > 0 {
+		s.Logger.WithField("count", n).Info("Plain secrets encrypted")
+	}
+}
+
+// rewrapDataKeys - periodically reads the master keys again and rewraps the data keys
+// wrapped with the old versions of the master key, so the old versions can be retired.
+func (s *Server) rewrapDataKeys(ctx context.Context) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+	for {
+		s.rewrapOldKeys(ctx)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// rewrapOldKeys - rewraps the data keys with the current version of the master key in batches and logs the progress.
+// The rewrapped batches are saved, so the stopped job continues from the remaining keys.
+func (s *Server) rewrapOldKeys(ctx context.Context) {
+	if r, ok := s.Keys.(keyprovider.Reloader); ok {
+		if err := r.Reload(); err != nil {
+			s.Logger.WithField("err", err).Error("Failed to reload master keys")
+			return
+		}
+	}
+	total := 0
+	for {
+		n, err := s.Storage.RewrapDataKeys(ctx, rewrapBatchSize)
+		if err != nil {
+			s.Logger.WithField("err", err).Error("Failed to rewrap data keys")
+			return
+		}
+		if n == 0 {
+			return
+		}
+		total += n
+		s.Logger.WithFields(logrus.Fields{
+			"count":   total,
+			"version": s.Keys.CurrentVersion(),
+		}).Info("Data keys rewrapped")
+	}
+}
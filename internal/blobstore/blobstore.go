@@ -0,0 +1,67 @@
+// Blobstore package stores the contents of the binary records outside the database.
+// The contents are addressed by their hex SHA-256, so the same content is stored once.
+package blobstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+)
+
+// BlobStore - storage of the contents addressed by their hex SHA-256.
+type BlobStore interface {
+	// Put - saves the content under its key. The content is checked against the key,
+	// saving the content that is already stored does nothing.
+	Put(ctx context.Context, key string, r io.Reader, size int64) error
+	// Get - returns the part of the content starting at the offset, the negative length is up to the end.
+	Get(ctx context.Context, key string, offset int64, length int64) (io.ReadCloser, error)
+	// Exists - checks if the content is stored.
+	Exists(ctx context.Context, key string) (bool, error)
+	// Delete - deletes the content, deleting the missing content is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// ValidKey - checks that the key is the hex SHA-256 in lower case.
+func ValidKey(key string) bool {
+	if len(key) != sha256.Size*2 {
+		return false
+	}
+	for _, r := range key {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// checkedReader - reader that checks the size and the SHA-256 of the content at its end.
+type checkedReader struct {
+	r    io.Reader
+	h    hash.Hash
+	key  string
+	size int64
+	n    int64
+}
+
+// newCheckedReader - returns the reader of the content that must have the size and the key.
+func newCheckedReader(r io.Reader, key string, size int64) *checkedReader {
+	return &checkedReader{r: r, h: sha256.New(), key: key, size: size}
+}
+
+// Read - reads the content, at the end returns ErrBlobChecksum if the content does not match.
+func (c *checkedReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.h.Write(p[:n])
+	c.n += int64(n)
+	if c.n > c.size {
+		return n, customerror.ErrBlobChecksum
+	}
+	if err == io.EOF && (c.n != c.size || hex.EncodeToString(c.h.Sum(nil)) != c.key) {
+		return n, customerror.ErrBlobChecksum
+	}
+	return n, err
+}
@@ -0,0 +1,116 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+)
+
+// FSStore - blob store in the local directory. The content is stored in the file
+// <root>/<first two characters of the key>/<key>.
+type FSStore struct {
+	Root string
+}
+
+// NewFSStore - constructor FSStore. Creates the root directory if it does not exist.
+func NewFSStore(root string) (*FSStore, error) {
+	if err := os.MkdirAll(root, 0o700); err != nil {
+		return nil, err
+	}
+	return &FSStore{Root: root}, nil
+}
+
+// path - returns the path of the content file.
+func (s *FSStore) path(key string) string {
+	return filepath.Join(s.Root, key[:2], key)
+}
+
+// Put - saves the content to the temporary file and renames it, so the content file is never partial.
+func (s *FSStore) Put(ctx context.Context, key string, r io.Reader, size int64) error {
+	if !ValidKey(key) {
+		return customerror.ErrInvalidBlobKey
+	}
+	if ok, err := s.Exists(ctx, key); err != nil || ok {
+		return err
+	}
+	dir := filepath.Dir(s.path(key))
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	// does nothing if the file is renamed
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, newCheckedReader(r, key, size)); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(key))
+}
+
+// Get - opens the content file at the offset.
+func (s *FSStore) Get(ctx context.Context, key string, offset int64, length int64) (io.ReadCloser, error) {
+	if !ValidKey(key) {
+		return nil, customerror.ErrInvalidBlobKey
+	}
+	file, err := os.Open(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, customerror.ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if length < 0 {
+		return file, nil
+	}
+	return &limitedReadCloser{Reader: io.LimitReader(file, length), Closer: file}, nil
+}
+
+// Exists - checks if the content file exists.
+func (s *FSStore) Exists(ctx context.Context, key string) (bool, error) {
+	if !ValidKey(key) {
+		return false, customerror.ErrInvalidBlobKey
+	}
+	_, err := os.Stat(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Delete - deletes the content file.
+func (s *FSStore) Delete(ctx context.Context, key string) error {
+	if !ValidKey(key) {
+		return customerror.ErrInvalidBlobKey
+	}
+	err := os.Remove(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// limitedReadCloser - limited reader that closes the underlying file.
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
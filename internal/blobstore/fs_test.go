@@ -0,0 +1,72 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/stretchr/testify/assert"
+)
+
+// testContent - content for the tests of the stores.
+var testContent = []byte("content of the binary record")
+
+func TestFSStore(t *testing.T) {
+	store, err := NewFSStore(t.TempDir())
+	assert.NoError(t, err)
+	testStore(t, store)
+}
+
+// testStore - common tests of the blob stores.
+func testStore(t *testing.T, store BlobStore) {
+	ctx := context.Background()
+	key := sum(testContent)
+
+	ok, err := store.Exists(ctx, key)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	_, err = store.Get(ctx, key, 0, -1)
+	assert.ErrorIs(t, err, customerror.ErrBlobNotFound)
+
+	assert.NoError(t, store.Put(ctx, key, bytes.NewReader(testContent), int64(len(testContent))))
+	// the same content is saved once
+	assert.NoError(t, store.Put(ctx, key, bytes.NewReader(testContent), int64(len(testContent))))
+	ok, err = store.Exists(ctx, key)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	assert.Equal(t, testContent, read(t, store, key, 0, -1))
+	assert.Equal(t, testContent[8:11], read(t, store, key, 8, 3))
+	assert.Equal(t, testContent[8:], read(t, store, key, 8, -1))
+
+	other := []byte("other content")
+	err = store.Put(ctx, key[:63]+"0", bytes.NewReader(other), int64(len(other)))
+	assert.ErrorIs(t, err, customerror.ErrBlobChecksum)
+	err = store.Put(ctx, "key", bytes.NewReader(other), int64(len(other)))
+	assert.ErrorIs(t, err, customerror.ErrInvalidBlobKey)
+
+	assert.NoError(t, store.Delete(ctx, key))
+	assert.NoError(t, store.Delete(ctx, key))
+	ok, err = store.Exists(ctx, key)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+// read - reads the part of the content from the store.
+func read(t *testing.T, store BlobStore, key string, offset int64, length int64) []byte {
+	r, err := store.Get(context.Background(), key, offset, length)
+	if !assert.NoError(t, err) {
+		return nil
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	return data
+}
+
+// sum - returns the hex SHA-256 of the data.
+func sum(data []byte) string {
+	return hashHex(data)
+}
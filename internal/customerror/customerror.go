@@ -8,14 +8,119 @@ import (
 
 // Custom errors.
 var (
-	ErrNoRows               error = pgx.ErrNoRows
-	ErrLoginOrPassIncorrect error = errors.New("login or password incorrect")
-	ErrInternalServer       error = errors.New("internal server error")
-	ErrUserIsExists         error = errors.New("user is exists")
-	ErrCreateUser           error = errors.New("create user error")
-	ErrLogIn                error = errors.New("error log in")
-	ErrMissingToken         error = errors.New("missing token")
-	ErrMissingMD            error = errors.New("missing metadata")
-	ErrDSNEmpty             error = errors.New("dsn is empty")
-	ErrMigrations           error = errors.New("migrations error")
+	ErrNoRows                error = pgx.ErrNoRows
+	ErrLoginOrPassIncorrect  error = errors.New("login or password incorrect")
+	ErrInternalServer        error = errors.New("internal server error")
+	ErrUserIsExists          error = errors.New("user is exists")
+	ErrCreateUser            error = errors.New("create user error")
+	ErrLogIn                 error = errors.New("error log in")
+	ErrMissingToken          error = errors.New("missing token")
+	ErrMissingMD             error = errors.New("missing metadata")
+	ErrDSNEmpty              error = errors.New("dsn is empty")
+	ErrMigrations            error = errors.New("migrations error")
+	ErrServerStopped         error = errors.New("server is stopped")
+	ErrUnknownDataType       error = errors.New("unknown data type")
+	ErrUnknownAction         error = errors.New("unknown action")
+	ErrRecordNotFound        error = errors.New("record not found")
+	ErrBatchRolledBack       error = errors.New("batch is rolled back")
+	ErrBatchTooLarge         error = errors.New("too many operations in batch")
+	ErrMissingData           error = errors.New("missing data")
+	ErrIdempotencyKeyTooLong error = errors.New("idempotency key is too long")
+	ErrIdempotencyKeyReused  error = errors.New("idempotency key is used by another request")
+	ErrRequestInProgress     error = errors.New("request with this idempotency key is in progress")
+	ErrNotProtoMessage       error = errors.New("not a protobuf message")
+	ErrInvalidCursor         error = errors.New("invalid cursor")
+	ErrUnknownSortOrder      error = errors.New("unknown sort order")
+	ErrTagIsExists           error = errors.New("tag is exists")
+	ErrTagNotFound           error = errors.New("tag not found")
+	ErrInvalidTag            error = errors.New("invalid tag name")
+	ErrFolderIsExists        error = errors.New("folder is exists")
+	ErrFolderNotFound        error = errors.New("folder not found")
+	ErrFolderCycle           error = errors.New("folder cannot be moved into itself or its subfolder")
+	ErrInvalidFolderName     error = errors.New("invalid folder name")
+	ErrInvalidFolderID       error = errors.New("invalid folder id")
+	ErrInvalidCustomField    error = errors.New("invalid custom field")
+	ErrUnknownFieldType      error = errors.New("unknown custom field type")
+	ErrTooManyFields         error = errors.New("too many custom fields")
+	ErrInvalidURI            error = errors.New("invalid uri")
+	ErrUnknownMatchType      error = errors.New("unknown uri match type")
+	ErrTooManyURIs           error = errors.New("too many uris")
+	ErrInvalidTOTP           error = errors.New("invalid totp secret")
+	ErrTOTPNotSet            error = errors.New("totp secret is not set")
+	ErrInvalidSSHKey         error = errors.New("invalid ssh key")
+	ErrSSHKeyPassphrase      error = errors.New("wrong or missing ssh key passphrase")
+	ErrSSHKeyMismatch        error = errors.New("public key does not match private key")
+	ErrInvalidDate           error = errors.New("invalid date, expected YYYY-MM-DD")
+	ErrInvalidEmail          error = errors.New("invalid email")
+	ErrInvalidCardData       error = errors.New("invalid card data")
+	ErrInvalidCardNumber     error = errors.New("invalid card number")
+	ErrInvalidCardDate       error = errors.New("invalid card expiry date, expected MM/YY")
+	ErrInvalidCVC            error = errors.New("invalid card cvc")
+	ErrExpiryDerived         error = errors.New("expiry date of the card is derived from its validity period")
+	ErrInvalidHorizon        error = errors.New("invalid horizon")
+	ErrInvalidRecentLimit    error = errors.New("invalid number of the recent records")
+	ErrUploadNotFound        error = errors.New("upload not found")
+	ErrInvalidOffset         error = errors.New("offset of the chunk does not match the received data")
+	ErrInvalidUploadSize     error = errors.New("invalid size of the upload")
+	ErrChunkTooLarge         error = errors.New("chunk is too large")
+	ErrChunkChecksum         error = errors.New("checksum of the chunk does not match")
+	ErrChecksumMismatch      error = errors.New("checksum of the data does not match")
+	ErrInvalidChecksum       error = errors.New("invalid checksum, expected hex SHA-256")
+	ErrMissingHeader         error = errors.New("first message of the stream must be the header")
+	ErrBlobNotFound          error = errors.New("blob not found")
+	ErrBlobChecksum          error = errors.New("content of the blob does not match its key")
+	ErrInvalidBlobKey        error = errors.New("invalid blob key, expected hex SHA-256")
+	ErrUnknownBlobStore      error = errors.New("unknown blob store")
+	ErrAttachmentNotFound    error = errors.New("attachment not found")
+	ErrAttachmentType        error = errors.New("files cannot be attached to the binary records")
+	ErrInvalidFilename       error = errors.New("invalid filename")
+	ErrQuotaExceeded         error = errors.New("storage quota exceeded")
+	ErrUnknownFormat         error = errors.New("unknown or corrupted format of the stored data")
+	ErrInvalidKeySize        error = errors.New("invalid key size, expected 32 bytes")
+	ErrDecrypt               error = errors.New("secret cannot be decrypted, wrong key or corrupted data")
+	ErrInvalidMasterKey      error = errors.New("invalid master key, expected version:base64 key")
+	ErrNoMasterKey           error = errors.New("no master key is configured")
+	ErrUnknownKeyVersion     error = errors.New("unknown version of the master key")
+	ErrUnknownKeyProvider    error = errors.New("unknown key provider")
+	ErrKMSKeyNotFound        error = errors.New("kms key not found")
+	ErrRetireCurrentKey      error = errors.New("current master key cannot be retired")
+	ErrKeyVersionInUse       error = errors.New("master key version still wraps data keys")
+	ErrRotationUnsupported   error = errors.New("key provider does not support rotation by the server")
+	ErrUnknownKDF            error = errors.New("unknown key derivation function, expected pbkdf2-sha256 or argon2id")
+	ErrWeakKDFParams         error = errors.New("key derivation parameters are too weak")
+	ErrInvalidSalt           error = errors.New("invalid salt, expected 16 to 64 bytes")
+	ErrVaultExists           error = errors.New("vault is already set up")
+	ErrVaultNotSetUp         error = errors.New("vault is not set up")
+	ErrVaultAccount          error = errors.New("account uses the vault, the records must be encrypted by the client")
+	ErrVaultConflict         error = errors.New("vault item was changed by another client")
+	ErrEmptyVaultItem        error = errors.New("vault item is empty")
+	ErrVaultItemTooLarge     error = errors.New("vault item is too large")
+	ErrInvalidVaultMetadata  error = errors.New("invalid metadata of the vault item")
+	ErrInvalidSRPVerifier    error = errors.New("invalid salt or verifier of the password")
+	ErrInvalidSRPPublic      error = errors.New("invalid public ephemeral value")
+	ErrSRPNotSetUp           error = errors.New("srp login is not set up, log in with the password to set it up")
+	ErrSRPSessionNotFound    error = errors.New("login session not found or expired")
+	ErrSRPAccount            error = errors.New("account uses the srp login")
+	ErrInvalidPublicKey      error = errors.New("invalid public key")
+	ErrInvalidPrivateKey     error = errors.New("invalid encrypted private key")
+	ErrUnknownKeyAlgorithm   error = errors.New("unknown key algorithm, expected x25519 or rsa-oaep-sha256")
+	ErrNoPublicKey           error = errors.New("user has no public key")
+	ErrKeyFingerprint        error = errors.New("public key of the recipient was replaced, wrap the item key again")
+	ErrInvalidWrappedKey     error = errors.New("invalid wrapped item key")
+	ErrUnknownPermission     error = errors.New("unknown permission, expected 1 - read-only or 2 - read-write")
+	ErrShareWithSelf         error = errors.New("item cannot be shared with its owner")
+	ErrShareNotFound         error = errors.New("share not found")
+	ErrReadOnlyShare         error = errors.New("item is shared read-only")
+	ErrInvalidOrgName        error = errors.New("invalid name of the organization or the collection")
+	ErrOrgNotFound           error = errors.New("organization not found")
+	ErrNotOrgAdmin           error = errors.New("only the administrator of the organization can do this")
+	ErrNotOrgMember          error = errors.New("user is not a member of the organization")
+	ErrAlreadyOrgMember      error = errors.New("user is already a member of the organization")
+	ErrUserNotFound          error = errors.New("user not found")
+	ErrInvitationNotFound    error = errors.New("invitation not found")
+	ErrLastOrgAdmin          error = errors.New("last administrator cannot leave the organization")
+	ErrCollectionIsExists    error = errors.New("collection is exists")
+	ErrCollectionNotFound    error = errors.New("collection not found")
+	ErrUnknownRole           error = errors.New("unknown role, expected 1 - viewer, 2 - editor or 3 - manager")
+	ErrCollectionRole        error = errors.New("role in the collection does not allow this")
 )
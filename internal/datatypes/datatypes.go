@@ -5,4 +5,6 @@ const (
 	CardDataType
 	TextDataType
 	BinaryDataType
+	SSHKeyDataType
+	IdentityDataType
 )
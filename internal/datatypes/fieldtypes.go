@@ -0,0 +1,9 @@
+package datatypes
+
+// Types of the custom fields of the records.
+const (
+	TextFieldType int32 = iota + 1
+	HiddenFieldType
+	BooleanFieldType
+	URLFieldType
+)
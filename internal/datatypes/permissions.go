@@ -0,0 +1,15 @@
+package datatypes
+
+// Permissions of the recipient of the shared item.
+const (
+	ReadOnlyPermission int32 = iota + 1
+	ReadWritePermission
+)
+
+// Roles of the member in the collection of the organization, each role includes the previous ones.
+// The viewer reads the records, the editor changes them, the manager grants the roles in the collection.
+const (
+	ViewerRole int32 = iota + 1
+	EditorRole
+	ManagerRole
+)
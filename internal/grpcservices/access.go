@@ -0,0 +1,22 @@
+package grpcservices
+
+import (
+	"context"
+
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/sirupsen/logrus"
+)
+
+// touchItem - saves that the record was read. The error is only logged,
+// the record was already read and the response is not failed because of the statistics.
+func touchItem(ctx context.Context, rep storage.Storage, l *logrus.Logger, model models.IDModel, service string, handler string) {
+	if err := rep.TouchItem(ctx, model); err != nil {
+		l.WithFields(logrus.Fields{
+			"service": service,
+			"handler": handler,
+			"err":     err,
+			"from":    "storage.touch_item",
+		}).Error("Storage error")
+	}
+}
@@ -0,0 +1,198 @@
+package grpcservices
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/chunktools"
+	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Maximum length of the filename and the MIME type of the attachment.
+const maxAttachmentNameLen int = 255
+
+// AttachmentService - service contains methods for the files attached to the records.
+type AttachmentService struct {
+	srvpb.UnimplementedAttachmentServiceServer
+	Rep        storage.Storage
+	TokenTools *tokentools.JWTTools
+	Logger     *logrus.Logger
+}
+
+// NewAttachmentService - constructor AttachmentService.
+func NewAttachmentService(r storage.Storage, tt *tokentools.JWTTools, l *logrus.Logger) *AttachmentService {
+	return &AttachmentService{Rep: r, TokenTools: tt, Logger: l}
+}
+
+// Attach - receives the file in chunks and attaches it to the record.
+// The upload is resumed in the same way as the upload of the binary record.
+func (a *AttachmentService) Attach(stream srvpb.AttachmentService_AttachServer) error {
+	return receiveUpload(stream, a.Rep, a.Logger, "attachment_service", "attach", attachmentFromHeader)
+}
+
+// ListAttachments - get the attachments of the record.
+func (a *AttachmentService) ListAttachments(ctx context.Context, in *srvpb.ListAttachmentsReq) (*srvpb.ListAttachmentsResp, error) {
+	resp := &srvpb.ListAttachmentsResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		a.Logger.WithFields(logrus.Fields{
+			"service": "attachment_service",
+			"handler": "list_attachments",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	res, err := a.Rep.SelectAttachments(ctx, models.IDModel{UUID: uuid, ID: in.Id, Type: in.Type})
+	if errors.Is(err, customerror.ErrUnknownDataType) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, customerror.ErrRecordNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(a.Logger, err, "attachment_service", "list_attachments", "storage.select_attachments")
+	}
+	resp.Attachments = make([]*srvpb.AttachmentModel, 0, len(res))
+	for _, attachment := range res {
+		resp.Attachments = append(resp.Attachments, attachmentToProto(attachment))
+	}
+	return resp, nil
+}
+
+// DownloadAttachment - sends the header of the attachment and then its data in chunks starting at the offset.
+// The header contains the filename in the title.
+func (a *AttachmentService) DownloadAttachment(in *srvpb.DownloadAttachmentReq, stream srvpb.AttachmentService_DownloadAttachmentServer) error {
+	ctx := stream.Context()
+	uuid, _ := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		a.Logger.WithFields(logrus.Fields{
+			"service": "attachment_service",
+			"handler": "download_attachment",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		return status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	res, err := a.Rep.SelectAttachment(ctx, models.AttachmentReqModel{UUID: uuid, ID: in.Id})
+	if errors.Is(err, customerror.ErrAttachmentNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return internalError(a.Logger, err, "attachment_service", "download_attachment", "storage.select_attachment")
+	}
+	if in.Offset < 0 || in.Offset > res.Size {
+		return status.Error(codes.OutOfRange, customerror.ErrInvalidOffset.Error())
+	}
+
+	header := &srvpb.DownloadBinaryResp_Header{
+		Id:       res.ID,
+		Title:    res.Filename,
+		Size:     res.Size,
+		Sha256:   res.SHA256,
+		MimeType: res.MimeType,
+	}
+	if err := stream.Send(&srvpb.DownloadBinaryResp{Msg: &srvpb.DownloadBinaryResp_Header_{Header: header}}); err != nil {
+		return err
+	}
+	touchItem(ctx, a.Rep, a.Logger, models.IDModel{UUID: uuid, ID: res.ItemID, Type: res.Type}, "attachment_service", "download_attachment")
+
+	read := func(offset int64) ([]byte, error) {
+		return a.Rep.ReadAttachmentChunk(ctx, models.BinaryReadModel{UUID: uuid, ID: in.Id, Offset: offset, Limit: MaxChunkSize})
+	}
+	return sendChunks(ctx, stream, a.Logger, "attachment_service", "download_attachment", res.Size, in.Offset, read)
+}
+
+// RemoveAttachment - removes the attachment from the record.
+func (a *AttachmentService) RemoveAttachment(ctx context.Context, in *srvpb.RemoveAttachmentReq) (*srvpb.RemoveAttachmentResp, error) {
+	resp := &srvpb.RemoveAttachmentResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		a.Logger.WithFields(logrus.Fields{
+			"service": "attachment_service",
+			"handler": "remove_attachment",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	err := a.Rep.DeleteAttachment(ctx, models.AttachmentReqModel{UUID: uuid, ID: in.Id})
+	if errors.Is(err, customerror.ErrAttachmentNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, customerror.ErrCollectionRole) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(a.Logger, err, "attachment_service", "remove_attachment", "storage.delete_attachment")
+	}
+	return resp, nil
+}
+
+// attachmentFromHeader - checks the header of the new attachment and converts it to the storage model.
+func attachmentFromHeader(header *srvpb.UploadBinaryReq_Header) (models.BinaryUploadModel, error) {
+	res := models.BinaryUploadModel{}
+	if header.Type == datatypes.BinaryDataType {
+		return res, customerror.ErrAttachmentType
+	}
+	if header.Type < datatypes.LoginPasswordDataType || header.Type > datatypes.IdentityDataType {
+		return res, customerror.ErrUnknownDataType
+	}
+	if header.Id <= 0 {
+		return res, customerror.ErrRecordNotFound
+	}
+	filename := strings.TrimSpace(header.Title)
+	if filename == "" || len(filename) > maxAttachmentNameLen || strings.ContainsAny(filename, `/\`) {
+		return res, customerror.ErrInvalidFilename
+	}
+	if len(header.MimeType) > maxAttachmentNameLen {
+		return res, customerror.ErrInvalidFilename
+	}
+	if header.Size < 0 || header.Size > MaxBinarySize {
+		return res, customerror.ErrInvalidUploadSize
+	}
+	sum, err := chunktools.NormalizeSum(header.Sha256)
+	if err != nil {
+		return res, err
+	}
+	return models.BinaryUploadModel{
+		ItemID:     header.Id,
+		TechData:   models.ReqTechDataModel{Title: filename, Type: header.Type},
+		Size:       header.Size,
+		SHA256:     sum,
+		Attachment: true,
+		MimeType:   header.MimeType,
+	}, nil
+}
+
+// attachmentToProto - converts the attachment to the message.
+func attachmentToProto(attachment models.AttachmentModel) *srvpb.AttachmentModel {
+	return &srvpb.AttachmentModel{
+		Id:        attachment.ID,
+		Type:      attachment.Type,
+		ItemId:    attachment.ItemID,
+		Filename:  attachment.Filename,
+		MimeType:  attachment.MimeType,
+		Size:      attachment.Size,
+		Sha256:    attachment.SHA256,
+		CreatedAt: timestamppb.New(attachment.CreatedAt),
+	}
+}
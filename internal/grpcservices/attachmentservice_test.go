@@ -0,0 +1,37 @@
+package grpcservices
+
+import (
+	"strings"
+	"testing"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttachmentFromHeader(t *testing.T) {
+	sum := strings.Repeat("ab", 32)
+	header := &srvpb.UploadBinaryReq_Header{Id: 3, Type: datatypes.TextDataType, Title: " notes.pdf ",
+		MimeType: "application/pdf", Size: 10, Sha256: sum}
+	res, err := attachmentFromHeader(header)
+	assert.NoError(t, err)
+	assert.True(t, res.Attachment)
+	assert.Equal(t, int32(3), res.ItemID)
+	assert.Equal(t, datatypes.TextDataType, res.TechData.Type)
+	assert.Equal(t, "notes.pdf", res.TechData.Title)
+	assert.Equal(t, "application/pdf", res.MimeType)
+
+	_, err = attachmentFromHeader(&srvpb.UploadBinaryReq_Header{Id: 3, Type: datatypes.BinaryDataType, Title: "a", Sha256: sum})
+	assert.ErrorIs(t, err, customerror.ErrAttachmentType)
+	_, err = attachmentFromHeader(&srvpb.UploadBinaryReq_Header{Id: 3, Type: 42, Title: "a", Sha256: sum})
+	assert.ErrorIs(t, err, customerror.ErrUnknownDataType)
+	_, err = attachmentFromHeader(&srvpb.UploadBinaryReq_Header{Type: datatypes.CardDataType, Title: "a", Sha256: sum})
+	assert.ErrorIs(t, err, customerror.ErrRecordNotFound)
+	_, err = attachmentFromHeader(&srvpb.UploadBinaryReq_Header{Id: 3, Type: datatypes.CardDataType, Title: "../a", Sha256: sum})
+	assert.ErrorIs(t, err, customerror.ErrInvalidFilename)
+	_, err = attachmentFromHeader(&srvpb.UploadBinaryReq_Header{Id: 3, Type: datatypes.CardDataType, Title: " ", Sha256: sum})
+	assert.ErrorIs(t, err, customerror.ErrInvalidFilename)
+	_, err = attachmentFromHeader(&srvpb.UploadBinaryReq_Header{Id: 3, Type: datatypes.CardDataType, Title: "a", Size: -1, Sha256: sum})
+	assert.ErrorIs(t, err, customerror.ErrInvalidUploadSize)
+}
@@ -108,6 +108,8 @@ func (a *AuthService) Enter(ctx context.Context, in *pb.AuthReq) (*pb.AuthResp,
 		resp.Error = customerror.ErrInternalServer.Error()
 		return resp, customerror.ErrInternalServer
 	}
+	// the user is moved to the login without the password, see SRPService
+	setUpSRP(ctx, a.Rep, a.Logger, uuid, user)
 
 	expAt := time.Now().Add(time.Hour * 1).Unix()
 	token, err := a.TokenTools.CreateToken(expAt, uuid)
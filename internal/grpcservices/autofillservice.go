@@ -0,0 +1,185 @@
+package grpcservices
+
+import (
+	"context"
+	"errors"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
+	"github.com/BillyBones007/pwdm_server/internal/tools/urimatch"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AutofillService - service contains methods for the URIs of the login/password records
+// and the search of the records for the page.
+type AutofillService struct {
+	srvpb.UnimplementedAutofillServiceServer
+	Rep        storage.Storage
+	TokenTools *tokentools.JWTTools
+	Logger     *logrus.Logger
+}
+
+// NewAutofillService - constructor AutofillService.
+func NewAutofillService(r storage.Storage, tt *tokentools.JWTTools, l *logrus.Logger) *AutofillService {
+	return &AutofillService{Rep: r, TokenTools: tt, Logger: l}
+}
+
+// SetLoginURIs - replace the URIs of the login/password record.
+func (a *AutofillService) SetLoginURIs(ctx context.Context, in *srvpb.SetLoginURIsReq) (*srvpb.LoginURIsResp, error) {
+	resp := &srvpb.LoginURIsResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		a.Logger.WithFields(logrus.Fields{
+			"service": "autofill_service",
+			"handler": "set_login_uris",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+	if len(in.Uris) > urimatch.MaxURIs {
+		resp.Error = customerror.ErrTooManyURIs.Error()
+		return resp, status.Error(codes.InvalidArgument, customerror.ErrTooManyURIs.Error())
+	}
+
+	modelURIs := models.LoginURIsModel{UUID: uuid, ID: in.Id, URIs: make([]models.LoginURIModel, 0, len(in.Uris))}
+	for _, u := range in.Uris {
+		uri, err := urimatch.Prepare(models.LoginURIModel{URI: u.Uri, Match: int32(u.Match)})
+		if err != nil {
+			resp.Error = err.Error()
+			return resp, status.Error(codes.InvalidArgument, err.Error())
+		}
+		modelURIs.URIs = append(modelURIs.URIs, uri)
+	}
+
+	err := a.Rep.SetLoginURIs(ctx, modelURIs)
+	if errors.Is(err, customerror.ErrRecordNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, customerror.ErrCollectionRole) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		a.Logger.WithFields(logrus.Fields{
+			"service": "autofill_service",
+			"handler": "set_login_uris",
+			"err":     err,
+			"from":    "storage.set_login_uris",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+	resp.Uris = loginURIsToProto(modelURIs.URIs)
+	return resp, nil
+}
+
+// GetLoginURIs - get the URIs of the login/password record.
+func (a *AutofillService) GetLoginURIs(ctx context.Context, in *srvpb.GetLoginURIsReq) (*srvpb.LoginURIsResp, error) {
+	resp := &srvpb.LoginURIsResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		a.Logger.WithFields(logrus.Fields{
+			"service": "autofill_service",
+			"handler": "get_login_uris",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	res, err := a.Rep.SelectLoginURIs(ctx, models.IDModel{UUID: uuid, ID: in.Id})
+	if errors.Is(err, customerror.ErrRecordNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		a.Logger.WithFields(logrus.Fields{
+			"service": "autofill_service",
+			"handler": "get_login_uris",
+			"err":     err,
+			"from":    "storage.select_login_uris",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+	resp.Uris = loginURIsToProto(res)
+	return resp, nil
+}
+
+// LookupByURL - get the login/password records whose URIs match the page URL.
+func (a *AutofillService) LookupByURL(ctx context.Context, in *srvpb.LookupByURLReq) (*srvpb.LookupByURLResp, error) {
+	resp := &srvpb.LookupByURLResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		a.Logger.WithFields(logrus.Fields{
+			"service": "autofill_service",
+			"handler": "lookup_by_url",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	domain, err := urimatch.PageDomain(in.Url)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := a.Rep.LookupLogins(ctx, models.LookupLoginsModel{UUID: uuid, BaseDomain: domain})
+	if err != nil {
+		a.Logger.WithFields(logrus.Fields{
+			"service": "autofill_service",
+			"handler": "lookup_by_url",
+			"err":     err,
+			"from":    "storage.lookup_logins",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+
+	logins := make([]*srvpb.LookupByURLResp_LoginModel, 0)
+	for _, login := range matchLogins(res, in.Url) {
+		logins = append(logins, &srvpb.LookupByURLResp_LoginModel{
+			Id:       login.ID,
+			Title:    login.Title,
+			Login:    login.Data.Login,
+			Password: login.Data.Password,
+			Tag:      login.Tag,
+			Comment:  login.Comment,
+			Uris:     loginURIsToProto(login.URIs),
+		})
+	}
+	resp.Logins = logins
+	return resp, nil
+}
+
+// matchLogins - returns the records that have at least one URI matching the page URL.
+func matchLogins(logins []models.LoginMatchModel, page string) []models.LoginMatchModel {
+	res := make([]models.LoginMatchModel, 0, len(logins))
+	for _, login := range logins {
+		for _, uri := range login.URIs {
+			if urimatch.Match(uri, page) {
+				res = append(res, login)
+				break
+			}
+		}
+	}
+	return res
+}
+
+// loginURIsToProto - converts the URIs of the storage to the response URIs.
+func loginURIsToProto(uris []models.LoginURIModel) []*srvpb.LoginURI {
+	res := make([]*srvpb.LoginURI, 0, len(uris))
+	for _, uri := range uris {
+		res = append(res, &srvpb.LoginURI{Uri: uri.URI, Match: srvpb.LoginURI_Match(uri.Match)})
+	}
+	return res
+}
@@ -0,0 +1,28 @@
+package grpcservices
+
+import (
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/urimatch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchLogins(t *testing.T) {
+	prepare := func(uri string, match int32) models.LoginURIModel {
+		res, err := urimatch.Prepare(models.LoginURIModel{URI: uri, Match: match})
+		assert.NoError(t, err)
+		return res
+	}
+	logins := []models.LoginMatchModel{
+		{ID: 1, URIs: []models.LoginURIModel{prepare("https://example.com", urimatch.BaseDomain)}},
+		{ID: 2, URIs: []models.LoginURIModel{prepare("https://www.example.com", urimatch.Host)}},
+		{ID: 3, URIs: []models.LoginURIModel{prepare("https://other.com", urimatch.BaseDomain),
+			prepare(`^https://mail\.example\.com/`, urimatch.Regex)}},
+	}
+
+	res := matchLogins(logins, "https://mail.example.com/inbox")
+	assert.Len(t, res, 2)
+	assert.Equal(t, int32(1), res[0].ID)
+	assert.Equal(t, int32(3), res[1].ID)
+}
@@ -0,0 +1,167 @@
+package grpcservices
+
+import (
+	"context"
+	"errors"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/sshkey"
+	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Maximum number of operations in one batch.
+const MaxBatchSize int = 1000
+
+// BatchService - service contains methods for performing many operations at once.
+type BatchService struct {
+	srvpb.UnimplementedBatchServiceServer
+	Rep        storage.Storage
+	TokenTools *tokentools.JWTTools
+	Logger     *logrus.Logger
+}
+
+// NewBatchService - constructor BatchService.
+func NewBatchService(r storage.Storage, tt *tokentools.JWTTools, l *logrus.Logger) *BatchService {
+	return &BatchService{Rep: r, TokenTools: tt, Logger: l}
+}
+
+// Batch - performs inserts, updates and deletes of any data types in a single transaction.
+// If one of the operations fails, all operations are rolled back, the response contains
+// the results of the performed operations and the error of the failed one.
+func (b *BatchService) Batch(ctx context.Context, in *srvpb.BatchReq) (*srvpb.BatchResp, error) {
+	resp := &srvpb.BatchResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		b.Logger.WithFields(logrus.Fields{
+			"service": "batch_service",
+			"handler": "batch",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	if len(in.Operations) > MaxBatchSize {
+		resp.Error = customerror.ErrBatchTooLarge.Error()
+		return resp, status.Error(codes.InvalidArgument, customerror.ErrBatchTooLarge.Error())
+	}
+
+	modelBatch := models.BatchReqModel{UUID: uuid, Operations: make([]models.BatchOperationModel, 0, len(in.Operations))}
+	for _, op := range in.Operations {
+		modelOp, err := batchOperationModel(op)
+		if err != nil {
+			// the card errors already are the statuses with the field violations
+			st, ok := status.FromError(err)
+			if !ok {
+				st = status.New(codes.InvalidArgument, err.Error())
+			}
+			resp.Error = st.Message()
+			return resp, st.Err()
+		}
+		modelBatch.Operations = append(modelBatch.Operations, modelOp)
+	}
+
+	res, err := b.Rep.Batch(ctx, modelBatch)
+	for _, result := range res.Results {
+		item := &srvpb.BatchResp_ResultModel{Id: result.ID, Title: result.Title}
+		if result.Error != nil {
+			item.Error = batchResultError(result.Error)
+		}
+		resp.Results = append(resp.Results, item)
+	}
+	resp.Committed = res.Committed
+
+	if errors.Is(err, customerror.ErrBatchRolledBack) {
+		resp.Error = customerror.ErrBatchRolledBack.Error()
+		return resp, nil
+	}
+	if err != nil {
+		b.Logger.WithFields(logrus.Fields{
+			"service": "batch_service",
+			"handler": "batch",
+			"err":     err,
+			"from":    "storage.batch",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+	return resp, nil
+}
+
+// batchOperationModel - converts the operation of the request to the storage model.
+func batchOperationModel(op *srvpb.BatchReq_Operation) (models.BatchOperationModel, error) {
+	modelOp := models.BatchOperationModel{
+		ID:       op.Id,
+		Type:     op.Type,
+		TechData: models.ReqTechDataModel{Title: op.Title, Tag: op.Tag, Comment: op.Comment},
+	}
+
+	switch op.Action {
+	case srvpb.BatchReq_Operation_INSERT:
+		modelOp.Action = models.BatchInsert
+	case srvpb.BatchReq_Operation_UPDATE:
+		modelOp.Action = models.BatchUpdate
+	case srvpb.BatchReq_Operation_DELETE:
+		modelOp.Action = models.BatchDelete
+		return modelOp, nil
+	default:
+		return modelOp, customerror.ErrUnknownAction
+	}
+
+	switch data := op.Data.(type) {
+	case *srvpb.BatchReq_Operation_LoginPassword:
+		modelOp.Type = datatypes.LoginPasswordDataType
+		modelOp.LogPwd = models.LogPwdModel{Login: data.LoginPassword.Login, Password: data.LoginPassword.Password}
+	case *srvpb.BatchReq_Operation_Card:
+		modelOp.Type = datatypes.CardDataType
+		card, st := checkCard(models.CardModel{Num: data.Card.Num, Date: data.Card.Date, CVC: data.Card.Cvc,
+			FirstName: data.Card.FirstName, LastName: data.Card.LastName})
+		if st != nil {
+			return modelOp, st.Err()
+		}
+		modelOp.Card = card
+	case *srvpb.BatchReq_Operation_Text:
+		modelOp.Type = datatypes.TextDataType
+		modelOp.Text = models.TextDataModel{Data: data.Text.Data}
+	case *srvpb.BatchReq_Operation_Binary:
+		modelOp.Type = datatypes.BinaryDataType
+		modelOp.Binary = models.BinaryDataModel{Data: data.Binary.Data}
+	case *srvpb.BatchReq_Operation_Identity:
+		modelOp.Type = datatypes.IdentityDataType
+		identity, err := identityFromProto(data.Identity)
+		if err != nil {
+			return modelOp, err
+		}
+		modelOp.Identity = identity
+	case *srvpb.BatchReq_Operation_SshKey:
+		modelOp.Type = datatypes.SSHKeyDataType
+		info, err := sshkey.Parse(data.SshKey.PrivateKey, data.SshKey.PublicKey, data.SshKey.Passphrase)
+		if err != nil {
+			return modelOp, err
+		}
+		modelOp.SSHKey = models.SSHKeyModel{PrivateKey: data.SshKey.PrivateKey, PublicKey: info.PublicKey,
+			Passphrase: data.SshKey.Passphrase, KeyType: info.KeyType, Fingerprint: info.Fingerprint}
+	default:
+		return modelOp, customerror.ErrMissingData
+	}
+	return modelOp, nil
+}
+
+// batchResultError - returns the error text of the operation that can be shown to the client.
+func batchResultError(err error) string {
+	switch {
+	case errors.Is(err, customerror.ErrRecordNotFound),
+		errors.Is(err, customerror.ErrUnknownDataType),
+		errors.Is(err, customerror.ErrUnknownAction),
+		errors.Is(err, customerror.ErrCollectionRole):
+		return err.Error()
+	}
+	return customerror.ErrInternalServer.Error()
+}
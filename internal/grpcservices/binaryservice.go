@@ -0,0 +1,320 @@
+package grpcservices
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/chunktools"
+	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Limits of the binary data transferred in chunks.
+const (
+	MaxChunkSize  int32 = 1 << 20 // fits into the default 4 MB gRPC message
+	MaxBinarySize int64 = 512 << 20
+)
+
+// BinaryService - service contains methods for transferring the binary data in chunks.
+type BinaryService struct {
+	srvpb.UnimplementedBinaryServiceServer
+	Rep        storage.Storage
+	TokenTools *tokentools.JWTTools
+	Logger     *logrus.Logger
+}
+
+// NewBinaryService - constructor BinaryService.
+func NewBinaryService(r storage.Storage, tt *tokentools.JWTTools, l *logrus.Logger) *BinaryService {
+	return &BinaryService{Rep: r, TokenTools: tt, Logger: l}
+}
+
+// uploadStream - server side of the upload stream of BinaryService and AttachmentService.
+type uploadStream interface {
+	Context() context.Context
+	Recv() (*srvpb.UploadBinaryReq, error)
+	SendAndClose(*srvpb.UploadBinaryResp) error
+}
+
+// downloadStream - server side of the download stream of BinaryService and AttachmentService.
+type downloadStream interface {
+	Send(*srvpb.DownloadBinaryResp) error
+}
+
+// UploadBinary - receives the binary data in chunks and saves it to the record.
+// Every chunk is saved as soon as it is received, so the interrupted upload is resumed
+// with its id from the offset returned by UploadStatus. When all the data is received,
+// its SHA-256 is checked and the data is written to the record.
+func (b *BinaryService) UploadBinary(stream srvpb.BinaryService_UploadBinaryServer) error {
+	return receiveUpload(stream, b.Rep, b.Logger, "binary_service", "upload_binary", uploadFromHeader)
+}
+
+// receiveUpload - receives the upload stream: the header of the new upload or of the upload to resume
+// and then the chunks. The new upload is created from the header with newUpload.
+func receiveUpload(stream uploadStream, rep storage.Storage, l *logrus.Logger, service string, handler string,
+	newUpload func(header *srvpb.UploadBinaryReq_Header) (models.BinaryUploadModel, error)) error {
+	ctx := stream.Context()
+	uuid, _ := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		l.WithFields(logrus.Fields{
+			"service": service,
+			"handler": handler,
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		return status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	in, err := stream.Recv()
+	if err == io.EOF || (err == nil && in.GetHeader() == nil) {
+		return status.Error(codes.InvalidArgument, customerror.ErrMissingHeader.Error())
+	}
+	if err != nil {
+		return err
+	}
+	upload, err := startUpload(ctx, rep, l, service, handler, uuid, in.GetHeader(), newUpload)
+	if err != nil {
+		return err
+	}
+	hash, err := chunktools.ResumeHash(upload.HashState)
+	if err != nil {
+		return internalError(l, err, service, handler, "chunktools.resume_hash")
+	}
+
+	resp := &srvpb.UploadBinaryResp{UploadId: upload.ID, Offset: upload.Received}
+	for upload.Received < upload.Size {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			// the upload is not finished, the client resumes it later
+			return stream.SendAndClose(resp)
+		}
+		if err != nil {
+			return err
+		}
+		chunk := in.GetChunk()
+		if chunk == nil {
+			return status.Error(codes.InvalidArgument, customerror.ErrMissingData.Error())
+		}
+		if len(chunk.Data) > int(MaxChunkSize) {
+			return status.Error(codes.InvalidArgument, customerror.ErrChunkTooLarge.Error())
+		}
+		if err := chunktools.CheckChunk(chunk.Data, chunk.Crc32); err != nil {
+			return status.Error(codes.DataLoss, err.Error())
+		}
+		if chunk.Offset != upload.Received {
+			return status.Error(codes.FailedPrecondition, customerror.ErrInvalidOffset.Error())
+		}
+
+		hash.Write(chunk.Data)
+		state, err := hash.State()
+		if err != nil {
+			return internalError(l, err, service, handler, "chunktools.state")
+		}
+		modelChunk := models.BinaryChunkModel{UUID: uuid, UploadID: upload.ID, Offset: chunk.Offset, Data: chunk.Data, HashState: state}
+		upload.Received, err = rep.AppendUploadChunk(ctx, modelChunk)
+		switch {
+		case errors.Is(err, customerror.ErrUploadNotFound):
+			return status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, customerror.ErrInvalidOffset):
+			return status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, customerror.ErrInvalidUploadSize):
+			return status.Error(codes.InvalidArgument, err.Error())
+		case err != nil:
+			return internalError(l, err, service, handler, "storage.append_upload_chunk")
+		}
+		resp.Offset = upload.Received
+	}
+
+	modelUpload := models.UploadReqModel{UUID: uuid, ID: upload.ID}
+	if hash.Sum() != upload.SHA256 {
+		// the received data is corrupted, the upload cannot be resumed
+		if err := rep.DeleteUpload(ctx, modelUpload); err != nil {
+			return internalError(l, err, service, handler, "storage.delete_upload")
+		}
+		return status.Error(codes.DataLoss, customerror.ErrChecksumMismatch.Error())
+	}
+	res, err := rep.FinishUpload(ctx, modelUpload)
+	if errors.Is(err, customerror.ErrRecordNotFound) || errors.Is(err, customerror.ErrUploadNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, customerror.ErrCollectionRole) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return internalError(l, err, service, handler, "storage.finish_upload")
+	}
+	resp.Done = true
+	resp.Id = res.ID
+	resp.Title = res.Title
+	return stream.SendAndClose(resp)
+}
+
+// UploadStatus - get the number of the received bytes of the upload.
+func (b *BinaryService) UploadStatus(ctx context.Context, in *srvpb.UploadStatusReq) (*srvpb.UploadBinaryResp, error) {
+	resp := &srvpb.UploadBinaryResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		b.Logger.WithFields(logrus.Fields{
+			"service": "binary_service",
+			"handler": "upload_status",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	res, err := b.Rep.SelectUpload(ctx, models.UploadReqModel{UUID: uuid, ID: in.UploadId})
+	if errors.Is(err, customerror.ErrUploadNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(b.Logger, err, "binary_service", "upload_status", "storage.select_upload")
+	}
+	resp.UploadId = res.ID
+	resp.Offset = res.Received
+	return resp, nil
+}
+
+// DownloadBinary - sends the header of the binary record and then its data in chunks
+// starting at the offset. The client checks the SHA-256 of the header after receiving all the data.
+func (b *BinaryService) DownloadBinary(in *srvpb.DownloadBinaryReq, stream srvpb.BinaryService_DownloadBinaryServer) error {
+	ctx := stream.Context()
+	uuid, _ := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		b.Logger.WithFields(logrus.Fields{
+			"service": "binary_service",
+			"handler": "download_binary",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		return status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	modelItem := models.IDModel{UUID: uuid, ID: in.Id, Type: datatypes.BinaryDataType}
+	res, err := b.Rep.SelectBinaryInfo(ctx, modelItem)
+	if errors.Is(err, customerror.ErrRecordNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return internalError(b.Logger, err, "binary_service", "download_binary", "storage.select_binary_info")
+	}
+	if in.Offset < 0 || in.Offset > res.Data.Size {
+		return status.Error(codes.OutOfRange, customerror.ErrInvalidOffset.Error())
+	}
+
+	header := &srvpb.DownloadBinaryResp_Header{
+		Id:      res.TechData.ID,
+		Title:   res.TechData.Title,
+		Tag:     res.TechData.Tag,
+		Comment: res.TechData.Comment,
+		Size:    res.Data.Size,
+		Sha256:  res.Data.SHA256,
+	}
+	if err := stream.Send(&srvpb.DownloadBinaryResp{Msg: &srvpb.DownloadBinaryResp_Header_{Header: header}}); err != nil {
+		return err
+	}
+	touchItem(ctx, b.Rep, b.Logger, modelItem, "binary_service", "download_binary")
+
+	read := func(offset int64) ([]byte, error) {
+		return b.Rep.ReadBinaryChunk(ctx, models.BinaryReadModel{UUID: uuid, ID: in.Id, Offset: offset, Limit: MaxChunkSize})
+	}
+	return sendChunks(ctx, stream, b.Logger, "binary_service", "download_binary", res.Data.Size, in.Offset, read)
+}
+
+// sendChunks - sends the data of the size in chunks starting at the offset, the chunks are read with read.
+func sendChunks(ctx context.Context, stream downloadStream, l *logrus.Logger, service string, handler string,
+	size int64, offset int64, read func(offset int64) ([]byte, error)) error {
+	for offset < size {
+		data, err := read(offset)
+		if errors.Is(err, customerror.ErrRecordNotFound) || errors.Is(err, customerror.ErrAttachmentNotFound) {
+			return status.Error(codes.NotFound, err.Error())
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return internalError(l, err, service, handler, "storage.read_chunk")
+		}
+		if len(data) == 0 {
+			// the data was replaced with the shorter one during the download
+			return status.Error(codes.Aborted, customerror.ErrInvalidOffset.Error())
+		}
+		chunk := &srvpb.BinaryChunk{Offset: offset, Data: data, Crc32: chunktools.CRC(data)}
+		if err := stream.Send(&srvpb.DownloadBinaryResp{Msg: &srvpb.DownloadBinaryResp_Chunk{Chunk: chunk}}); err != nil {
+			return err
+		}
+		offset += int64(len(data))
+	}
+	return nil
+}
+
+// startUpload - creates the new upload or returns the upload to resume.
+func startUpload(ctx context.Context, rep storage.Storage, l *logrus.Logger, service string, handler string, uuid string,
+	header *srvpb.UploadBinaryReq_Header, newUpload func(header *srvpb.UploadBinaryReq_Header) (models.BinaryUploadModel, error)) (models.BinaryUploadModel, error) {
+	if header.UploadId != "" {
+		upload, err := rep.SelectUpload(ctx, models.UploadReqModel{UUID: uuid, ID: header.UploadId})
+		if errors.Is(err, customerror.ErrUploadNotFound) {
+			return upload, status.Error(codes.NotFound, err.Error())
+		}
+		if err != nil {
+			return upload, internalError(l, err, service, handler, "storage.select_upload")
+		}
+		return upload, nil
+	}
+
+	upload, err := newUpload(header)
+	if err != nil {
+		return upload, status.Error(codes.InvalidArgument, err.Error())
+	}
+	upload.UUID = uuid
+	upload.ID, err = rep.CreateUpload(ctx, upload)
+	if errors.Is(err, customerror.ErrRecordNotFound) {
+		return upload, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, customerror.ErrQuotaExceeded) {
+		return upload, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if errors.Is(err, customerror.ErrCollectionRole) {
+		return upload, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return upload, internalError(l, err, service, handler, "storage.create_upload")
+	}
+	return upload, nil
+}
+
+// internalError - logs the storage error and returns the internal grpc error.
+func internalError(l *logrus.Logger, err error, service string, handler string, from string) error {
+	l.WithFields(logrus.Fields{
+		"service": service,
+		"handler": handler,
+		"err":     err,
+		"from":    from,
+	}).Error("Storage error")
+	return status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+}
+
+// uploadFromHeader - checks the header of the new upload and converts it to the storage model.
+func uploadFromHeader(header *srvpb.UploadBinaryReq_Header) (models.BinaryUploadModel, error) {
+	res := models.BinaryUploadModel{}
+	if header.Size < 0 || header.Size > MaxBinarySize {
+		return res, customerror.ErrInvalidUploadSize
+	}
+	sum, err := chunktools.NormalizeSum(header.Sha256)
+	if err != nil {
+		return res, err
+	}
+	return models.BinaryUploadModel{
+		ItemID:   header.Id,
+		TechData: models.ReqTechDataModel{Title: header.Title, Tag: header.Tag, Comment: header.Comment, Type: datatypes.BinaryDataType},
+		Size:     header.Size,
+		SHA256:   sum,
+	}, nil
+}
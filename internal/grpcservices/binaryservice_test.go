@@ -0,0 +1,28 @@
+package grpcservices
+
+import (
+	"strings"
+	"testing"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadFromHeader(t *testing.T) {
+	sum := strings.Repeat("AB", 32)
+	res, err := uploadFromHeader(&srvpb.UploadBinaryReq_Header{Id: 3, Title: "File", Size: 10, Sha256: sum})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), res.ItemID)
+	assert.Equal(t, "File", res.TechData.Title)
+	assert.Equal(t, datatypes.BinaryDataType, res.TechData.Type)
+	assert.Equal(t, strings.ToLower(sum), res.SHA256)
+
+	_, err = uploadFromHeader(&srvpb.UploadBinaryReq_Header{Size: -1, Sha256: sum})
+	assert.ErrorIs(t, err, customerror.ErrInvalidUploadSize)
+	_, err = uploadFromHeader(&srvpb.UploadBinaryReq_Header{Size: MaxBinarySize + 1, Sha256: sum})
+	assert.ErrorIs(t, err, customerror.ErrInvalidUploadSize)
+	_, err = uploadFromHeader(&srvpb.UploadBinaryReq_Header{Size: 10})
+	assert.ErrorIs(t, err, customerror.ErrInvalidChecksum)
+}
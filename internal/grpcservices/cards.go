@@ -0,0 +1,87 @@
+package grpcservices
+
+import (
+	"context"
+	"strings"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/cardtools"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Response header of GetInfo with the brands and masked numbers of the cards (serialized srvpb.CardsInfo).
+const CardsMD string = "cards-bin"
+
+// cardInfo - brand and masked number of the card.
+type cardInfo struct {
+	Brand  string
+	Masked string
+}
+
+// checkCard - checks the card data of the request and returns it with the normalized number.
+// If the data is invalid, returns the InvalidArgument status with the field violations.
+func checkCard(card models.CardModel) (models.CardModel, *status.Status) {
+	card, errs := cardtools.Check(card)
+	if len(errs) == 0 {
+		return card, nil
+	}
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(errs))
+	msgs := make([]string, 0, len(errs))
+	for _, e := range errs {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: e.Field, Description: e.Err.Error()})
+		msgs = append(msgs, e.Err.Error())
+	}
+	st := status.New(codes.InvalidArgument, customerror.ErrInvalidCardData.Error()+": "+strings.Join(msgs, "; "))
+	if withDetails, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); err == nil {
+		st = withDetails
+	}
+	return card, st
+}
+
+// selectCardsInfo - get the brands and masked numbers of the cards among the records.
+func selectCardsInfo(ctx context.Context, rep storage.Storage, uuid string, records []models.DataRecordModel) (map[int32]cardInfo, error) {
+	res := make(map[int32]cardInfo)
+	ids := make([]int32, 0)
+	for _, record := range records {
+		if record.Type == datatypes.CardDataType {
+			ids = append(ids, record.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return res, nil
+	}
+	nums, err := rep.SelectCardNumbers(ctx, models.ListRecordsModel{UUID: uuid, ListID: ids, Type: datatypes.CardDataType})
+	if err != nil {
+		return res, err
+	}
+	for id, num := range nums {
+		res[id] = cardInfo{Brand: cardtools.Brand(num), Masked: cardtools.Mask(num)}
+	}
+	return res, nil
+}
+
+// sendCardsInfo - sends the brands and masked numbers of the cards in the response header.
+func sendCardsInfo(ctx context.Context, cards map[int32]cardInfo, records []models.DataRecordModel) error {
+	info := &srvpb.CardsInfo{Cards: make([]*srvpb.CardsInfo_CardModel, 0, len(cards))}
+	for _, record := range records {
+		card, ok := cards[record.ID]
+		if !ok || record.Type != datatypes.CardDataType {
+			continue
+		}
+		info.Cards = append(info.Cards, &srvpb.CardsInfo_CardModel{Id: record.ID, Brand: card.Brand, MaskedNum: card.Masked})
+	}
+	data, err := proto.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return grpc.SetHeader(ctx, metadata.Pairs(CardsMD, string(data)))
+}
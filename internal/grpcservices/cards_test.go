@@ -0,0 +1,31 @@
+package grpcservices
+
+import (
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCheckCard(t *testing.T) {
+	card, st := checkCard(models.CardModel{Num: "4111 1111 1111 1111", Date: "09/27", CVC: "123"})
+	assert.Nil(t, st)
+	assert.Equal(t, "4111111111111111", card.Num)
+
+	_, st = checkCard(models.CardModel{Num: "4111 1111 1111 1112", Date: "13/27"})
+	if assert.NotNil(t, st) {
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		details := st.Details()
+		if assert.Len(t, details, 1) {
+			br, ok := details[0].(*errdetails.BadRequest)
+			assert.True(t, ok)
+			fields := make([]string, 0)
+			for _, v := range br.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+			assert.Equal(t, []string{"num", "date"}, fields)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package grpcservices
+
+import (
+	"context"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// Response header with the custom fields of the record (serialized srvpb.CustomFields).
+const CustomFieldsMD string = "custom-fields-bin"
+
+// customFieldsToProto - converts the custom fields of the storage to the response fields.
+func customFieldsToProto(fields []models.CustomFieldModel) []*srvpb.CustomField {
+	res := make([]*srvpb.CustomField, 0, len(fields))
+	for _, field := range fields {
+		res = append(res, &srvpb.CustomField{Name: field.Name, Type: srvpb.CustomField_Type(field.Type), Value: field.Value})
+	}
+	return res
+}
+
+// customFieldsFromProto - converts the custom fields of the request to the storage fields.
+func customFieldsFromProto(fields []*srvpb.CustomField) []models.CustomFieldModel {
+	res := make([]models.CustomFieldModel, 0, len(fields))
+	for _, field := range fields {
+		res = append(res, models.CustomFieldModel{Name: field.Name, Type: int32(field.Type), Value: field.Value})
+	}
+	return res
+}
+
+// sendCustomFields - sends the custom fields of the record in the response header.
+// Used by the methods whose response messages have no field for them.
+func sendCustomFields(ctx context.Context, fields []models.CustomFieldModel) error {
+	data, err := proto.Marshal(&srvpb.CustomFields{Fields: customFieldsToProto(fields)})
+	if err != nil {
+		return err
+	}
+	return grpc.SetHeader(ctx, metadata.Pairs(CustomFieldsMD, string(data)))
+}
@@ -2,6 +2,7 @@ package grpcservices
 
 import (
 	"context"
+	"errors"
 
 	"github.com/BillyBones007/pwdm_server/internal/customerror"
 	"github.com/BillyBones007/pwdm_server/internal/storage"
@@ -41,7 +42,12 @@ func (d *DeleteService) DelItem(ctx context.Context, in *pb.DeleteItemReq) (*pb.
 	}
 
 	modelDelItem := models.IDModel{ID: in.Id, UUID: uuid, Type: in.Type}
-	if err := d.Rep.DeleteRecord(ctx, modelDelItem); err != nil {
+	err := d.Rep.DeleteRecord(ctx, modelDelItem)
+	if errors.Is(err, customerror.ErrCollectionRole) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
 		d.Logger.WithFields(logrus.Fields{
 			"service": "delete_service",
 			"handler": "del_item",
@@ -0,0 +1,143 @@
+package grpcservices
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Horizon of the expiring records in days.
+const (
+	DefaultHorizonDays int32 = 30
+	MaxHorizonDays     int32 = 3650
+)
+
+// ExpiryService - service contains methods for the expiry dates of the records.
+type ExpiryService struct {
+	srvpb.UnimplementedExpiryServiceServer
+	Rep        storage.Storage
+	TokenTools *tokentools.JWTTools
+	Logger     *logrus.Logger
+}
+
+// NewExpiryService - constructor ExpiryService.
+func NewExpiryService(r storage.Storage, tt *tokentools.JWTTools, l *logrus.Logger) *ExpiryService {
+	return &ExpiryService{Rep: r, TokenTools: tt, Logger: l}
+}
+
+// SetExpiry - set or remove the expiry date of the record.
+func (e *ExpiryService) SetExpiry(ctx context.Context, in *srvpb.SetExpiryReq) (*srvpb.SetExpiryResp, error) {
+	resp := &srvpb.SetExpiryResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		e.Logger.WithFields(logrus.Fields{
+			"service": "expiry_service",
+			"handler": "set_expiry",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	expiresAt, err := parseDate(in.ExpiresAt)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = e.Rep.SetExpiry(ctx, models.ExpiryModel{UUID: uuid, ID: in.Id, Type: in.Type, ExpiresAt: expiresAt})
+	if errors.Is(err, customerror.ErrUnknownDataType) || errors.Is(err, customerror.ErrExpiryDerived) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, customerror.ErrRecordNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, customerror.ErrCollectionRole) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		e.Logger.WithFields(logrus.Fields{
+			"service": "expiry_service",
+			"handler": "set_expiry",
+			"err":     err,
+			"from":    "storage.set_expiry",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+	return resp, nil
+}
+
+// ExpiringItems - get the records that expire within the horizon, including the expired ones.
+func (e *ExpiryService) ExpiringItems(ctx context.Context, in *srvpb.ExpiringItemsReq) (*srvpb.ExpiringItemsResp, error) {
+	resp := &srvpb.ExpiringItemsResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		e.Logger.WithFields(logrus.Fields{
+			"service": "expiry_service",
+			"handler": "expiring_items",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	horizon := in.HorizonDays
+	if horizon == 0 {
+		horizon = DefaultHorizonDays
+	}
+	if horizon < 0 || horizon > MaxHorizonDays {
+		resp.Error = customerror.ErrInvalidHorizon.Error()
+		return resp, status.Error(codes.InvalidArgument, customerror.ErrInvalidHorizon.Error())
+	}
+
+	today := truncateDay(time.Now())
+	res, err := e.Rep.SelectExpiring(ctx, models.ExpiringReqModel{UUID: uuid, Before: today.AddDate(0, 0, int(horizon))})
+	if err != nil {
+		e.Logger.WithFields(logrus.Fields{
+			"service": "expiry_service",
+			"handler": "expiring_items",
+			"err":     err,
+			"from":    "storage.select_expiring",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+
+	items := make([]*srvpb.ExpiringItemsResp_ItemModel, 0, len(res))
+	for _, item := range res {
+		items = append(items, &srvpb.ExpiringItemsResp_ItemModel{
+			Id:        item.ID,
+			Type:      item.Type,
+			Title:     item.Title,
+			Tag:       item.Tag,
+			ExpiresAt: formatDate(item.ExpiresAt),
+			DaysLeft:  daysLeft(today, item.ExpiresAt),
+		})
+	}
+	resp.Items = items
+	return resp, nil
+}
+
+// truncateDay - returns the beginning of the day of the time in UTC.
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// daysLeft - returns the number of days from today to the expiry date, negative for the expired records.
+func daysLeft(today time.Time, expiresAt time.Time) int32 {
+	return int32(truncateDay(expiresAt).Sub(today).Hours() / 24)
+}
@@ -0,0 +1,15 @@
+package grpcservices
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDaysLeft(t *testing.T) {
+	today := truncateDay(time.Date(2024, time.March, 1, 15, 30, 0, 0, time.UTC))
+	assert.Equal(t, int32(0), daysLeft(today, time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, int32(31), daysLeft(today, time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, int32(-1), daysLeft(today, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)))
+}
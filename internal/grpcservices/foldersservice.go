@@ -0,0 +1,282 @@
+package grpcservices
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Maximum length of the folder name.
+const maxFolderNameLen int = 255
+
+// FoldersService - service contains methods for organizing records into folders.
+type FoldersService struct {
+	srvpb.UnimplementedFoldersServiceServer
+	Rep        storage.Storage
+	TokenTools *tokentools.JWTTools
+	Logger     *logrus.Logger
+}
+
+// NewFoldersService - constructor FoldersService.
+func NewFoldersService(r storage.Storage, tt *tokentools.JWTTools, l *logrus.Logger) *FoldersService {
+	return &FoldersService{Rep: r, TokenTools: tt, Logger: l}
+}
+
+// GetFolders - get the folder with its direct contents or with the whole tree of nested folders and records.
+func (f *FoldersService) GetFolders(ctx context.Context, in *srvpb.GetFoldersReq) (*srvpb.GetFoldersResp, error) {
+	resp := &srvpb.GetFoldersResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		f.Logger.WithFields(logrus.Fields{
+			"service": "folders_service",
+			"handler": "get_folders",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	folders, err := f.Rep.SelectFolders(ctx, uuid)
+	if err != nil {
+		f.Logger.WithFields(logrus.Fields{
+			"service": "folders_service",
+			"handler": "get_folders",
+			"err":     err,
+			"from":    "storage.select_folders",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+
+	var items []models.DataRecordModel
+	if in.Tree {
+		items, err = f.Rep.SelectAllInfoUser(ctx, uuid)
+	} else {
+		items, err = f.Rep.SelectFolderItems(ctx, models.FolderReqModel{UUID: uuid, ID: in.FolderId})
+	}
+	if errors.Is(err, customerror.ErrFolderNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		f.Logger.WithFields(logrus.Fields{
+			"service": "folders_service",
+			"handler": "get_folders",
+			"err":     err,
+			"from":    "storage.select_items",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+
+	folder, ok := buildFolderTree(folders, items, in.FolderId, in.Tree)
+	if !ok {
+		resp.Error = customerror.ErrFolderNotFound.Error()
+		return resp, status.Error(codes.NotFound, customerror.ErrFolderNotFound.Error())
+	}
+	resp.Folder = folder
+	return resp, nil
+}
+
+// CreateFolder - create the folder in the parent folder.
+func (f *FoldersService) CreateFolder(ctx context.Context, in *srvpb.CreateFolderReq) (*srvpb.FolderResp, error) {
+	resp := &srvpb.FolderResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		f.Logger.WithFields(logrus.Fields{
+			"service": "folders_service",
+			"handler": "create_folder",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+	if !validFolderName(in.Name) {
+		resp.Error = customerror.ErrInvalidFolderName.Error()
+		return resp, status.Error(codes.InvalidArgument, customerror.ErrInvalidFolderName.Error())
+	}
+
+	modelFolder := models.FolderModel{UUID: uuid, ParentID: in.ParentId, Name: in.Name}
+	id, err := f.Rep.CreateFolder(ctx, modelFolder)
+	if err != nil {
+		return resp, f.folderError(resp, err, "create_folder", "storage.create_folder")
+	}
+	resp.Id = id
+	return resp, nil
+}
+
+// RenameFolder - rename the folder.
+func (f *FoldersService) RenameFolder(ctx context.Context, in *srvpb.RenameFolderReq) (*srvpb.FolderResp, error) {
+	resp := &srvpb.FolderResp{Id: in.Id}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		f.Logger.WithFields(logrus.Fields{
+			"service": "folders_service",
+			"handler": "rename_folder",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+	if !validFolderName(in.Name) {
+		resp.Error = customerror.ErrInvalidFolderName.Error()
+		return resp, status.Error(codes.InvalidArgument, customerror.ErrInvalidFolderName.Error())
+	}
+
+	modelFolder := models.FolderModel{UUID: uuid, ID: in.Id, Name: in.Name}
+	if err := f.Rep.RenameFolder(ctx, modelFolder); err != nil {
+		return resp, f.folderError(resp, err, "rename_folder", "storage.rename_folder")
+	}
+	return resp, nil
+}
+
+// MoveFolder - move the folder with its contents to another parent folder.
+func (f *FoldersService) MoveFolder(ctx context.Context, in *srvpb.MoveFolderReq) (*srvpb.FolderResp, error) {
+	resp := &srvpb.FolderResp{Id: in.Id}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		f.Logger.WithFields(logrus.Fields{
+			"service": "folders_service",
+			"handler": "move_folder",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	modelFolder := models.FolderModel{UUID: uuid, ID: in.Id, ParentID: in.ParentId}
+	if err := f.Rep.MoveFolder(ctx, modelFolder); err != nil {
+		return resp, f.folderError(resp, err, "move_folder", "storage.move_folder")
+	}
+	return resp, nil
+}
+
+// DeleteFolder - delete the folder with the nested folders, their records are moved to the trash.
+func (f *FoldersService) DeleteFolder(ctx context.Context, in *srvpb.DeleteFolderReq) (*srvpb.FolderResp, error) {
+	resp := &srvpb.FolderResp{Id: in.Id}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		f.Logger.WithFields(logrus.Fields{
+			"service": "folders_service",
+			"handler": "delete_folder",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	affected, err := f.Rep.DeleteFolder(ctx, models.FolderReqModel{UUID: uuid, ID: in.Id})
+	if err != nil {
+		return resp, f.folderError(resp, err, "delete_folder", "storage.delete_folder")
+	}
+	resp.Affected = affected
+	return resp, nil
+}
+
+// MoveItems - move the records to the folder.
+func (f *FoldersService) MoveItems(ctx context.Context, in *srvpb.MoveItemsReq) (*srvpb.FolderResp, error) {
+	resp := &srvpb.FolderResp{Id: in.FolderId}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		f.Logger.WithFields(logrus.Fields{
+			"service": "folders_service",
+			"handler": "move_items",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	modelMove := models.MoveItemsModel{UUID: uuid, FolderID: in.FolderId, Items: make([]models.IDModel, 0, len(in.Items))}
+	for _, item := range in.Items {
+		modelMove.Items = append(modelMove.Items, models.IDModel{UUID: uuid, ID: item.Id, Type: item.Type})
+	}
+	affected, err := f.Rep.MoveItems(ctx, modelMove)
+	if err != nil {
+		return resp, f.folderError(resp, err, "move_items", "storage.move_items")
+	}
+	resp.Affected = affected
+	return resp, nil
+}
+
+// folderError - converts the storage error of the folder management to the grpc error.
+func (f *FoldersService) folderError(resp *srvpb.FolderResp, err error, handler string, from string) error {
+	switch {
+	case errors.Is(err, customerror.ErrFolderNotFound), errors.Is(err, customerror.ErrRecordNotFound):
+		resp.Error = err.Error()
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, customerror.ErrFolderIsExists):
+		resp.Error = err.Error()
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, customerror.ErrFolderCycle), errors.Is(err, customerror.ErrUnknownDataType):
+		resp.Error = err.Error()
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	f.Logger.WithFields(logrus.Fields{
+		"service": "folders_service",
+		"handler": handler,
+		"err":     err,
+		"from":    from,
+	}).Error("Storage error")
+	resp.Error = customerror.ErrInternalServer.Error()
+	return status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+}
+
+// validFolderName - checks that the folder name is not empty and is not too long.
+func validFolderName(name string) bool {
+	return strings.TrimSpace(name) != "" && utf8.RuneCountInString(name) <= maxFolderNameLen
+}
+
+// buildFolderTree - builds the folder with the nested folders and records.
+// If tree is false, the nested folders are returned without their contents.
+// Returns false if there is no folder with the id.
+func buildFolderTree(folders []models.FolderModel, items []models.DataRecordModel, id int32, tree bool) (*srvpb.FolderModel, bool) {
+	nodes := map[int32]*srvpb.FolderModel{0: {}}
+	for _, folder := range folders {
+		nodes[folder.ID] = &srvpb.FolderModel{Id: folder.ID, ParentId: folder.ParentID, Name: folder.Name}
+	}
+	root, ok := nodes[id]
+	if !ok {
+		return nil, false
+	}
+
+	for _, folder := range folders {
+		parent, ok := nodes[folder.ParentID]
+		if !ok || (!tree && folder.ParentID != id) {
+			continue
+		}
+		parent.Folders = append(parent.Folders, nodes[folder.ID])
+	}
+	for _, record := range items {
+		folder, ok := nodes[record.FolderID]
+		if !ok || (!tree && record.FolderID != id) {
+			continue
+		}
+		item := &srvpb.ListItemsResp_ItemModel{
+			Id:       record.ID,
+			Type:     record.Type,
+			Title:    record.Title,
+			Tag:      record.Tag,
+			Comment:  record.Comment,
+			FolderId: record.FolderID,
+			Fields:   customFieldsToProto(record.Fields),
+		}
+		if !record.CreatedAt.IsZero() {
+			item.CreatedAt = timestamppb.New(record.CreatedAt)
+			item.UpdatedAt = timestamppb.New(record.UpdatedAt)
+		}
+		folder.Items = append(folder.Items, item)
+	}
+	return root, true
+}
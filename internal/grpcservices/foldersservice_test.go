@@ -0,0 +1,36 @@
+package grpcservices
+
+import (
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildFolderTree(t *testing.T) {
+	folders := []models.FolderModel{
+		{ID: 1, Name: "Work"},
+		{ID: 2, ParentID: 1, Name: "Projects"},
+		{ID: 3, Name: "Home"},
+	}
+	items := []models.DataRecordModel{
+		{ID: 10, Type: 1, Title: "Root"},
+		{ID: 11, Type: 1, Title: "Work", FolderID: 1},
+		{ID: 12, Type: 3, Title: "Project", FolderID: 2},
+	}
+
+	root, ok := buildFolderTree(folders, items, 0, true)
+	assert.True(t, ok)
+	assert.Len(t, root.Folders, 2)
+	assert.Len(t, root.Items, 1)
+	assert.Equal(t, "Project", root.Folders[0].Folders[0].Items[0].Title)
+
+	work, ok := buildFolderTree(folders, []models.DataRecordModel{items[1]}, 1, false)
+	assert.True(t, ok)
+	assert.Len(t, work.Folders, 1)
+	assert.Empty(t, work.Folders[0].Items)
+	assert.Equal(t, "Work", work.Items[0].Title)
+
+	_, ok = buildFolderTree(folders, items, 100, true)
+	assert.False(t, ok)
+}
@@ -2,9 +2,10 @@ package grpcservices
 
 import (
 	"context"
-	"encoding/hex"
+	"errors"
 
 	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
 	"github.com/BillyBones007/pwdm_server/internal/storage"
 	"github.com/BillyBones007/pwdm_server/internal/storage/models"
 	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
@@ -77,7 +78,11 @@ func (g *GiveTakeService) InsCard(ctx context.Context, in *pb.InsertCardReq) (*p
 	}
 
 	modelTechData := models.ReqTechDataModel{Title: in.Title, Tag: in.Tag, Comment: in.Comment, Type: in.Type}
-	modelCard := models.CardModel{Num: in.Num, Date: in.Date, CVC: in.Cvc, FirstName: in.FirstName, LastName: in.LastName}
+	modelCard, st := checkCard(models.CardModel{Num: in.Num, Date: in.Date, CVC: in.Cvc, FirstName: in.FirstName, LastName: in.LastName})
+	if st != nil {
+		resp.Error = st.Message()
+		return resp, st.Err()
+	}
 	modelInsCard := models.ReqCardModel{UUID: uuid, Data: modelCard, TechData: modelTechData}
 
 	res, err := g.Rep.InsertCardData(ctx, modelInsCard)
@@ -147,11 +152,14 @@ func (g *GiveTakeService) InsBinary(ctx context.Context, in *pb.InsertBinaryReq)
 	}
 
 	modelTechData := models.ReqTechDataModel{Title: in.Title, Tag: in.Tag, Comment: in.Comment, Type: in.Type}
-	strData := hex.EncodeToString(in.Data)
-	modelBinary := models.BinaryDataModel{Data: strData}
+	modelBinary := models.BinaryDataModel{Data: in.Data}
 	modelInsBinary := models.ReqBinaryModel{UUID: uuid, Data: modelBinary, TechData: modelTechData}
 
 	res, err := g.Rep.InsertBinaryData(ctx, modelInsBinary)
+	if errors.Is(err, customerror.ErrQuotaExceeded) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.ResourceExhausted, err.Error())
+	}
 	if err != nil {
 		g.Logger.WithFields(logrus.Fields{
 			"service": "give_take_service",
@@ -201,6 +209,16 @@ func (g *GiveTakeService) GetLogPwd(ctx context.Context, in *pb.GetItemReq) (*pb
 	resp.Password = res.Data.Password
 	resp.Tag = res.TechData.Tag
 	resp.Comment = res.TechData.Comment
+	if err := sendCustomFields(ctx, res.TechData.Fields); err != nil {
+		g.Logger.WithFields(logrus.Fields{
+			"service": "give_take_service",
+			"handler": "get_log_pwd",
+			"err":     err,
+			"from":    "send_custom_fields",
+		}).Error("Header error")
+	}
+	modelGetItem.Type = datatypes.LoginPasswordDataType
+	touchItem(ctx, g.Rep, g.Logger, modelGetItem, "give_take_service", "get_log_pwd")
 	return resp, nil
 }
 
@@ -240,6 +258,16 @@ func (g *GiveTakeService) GetCard(ctx context.Context, in *pb.GetItemReq) (*pb.G
 	resp.LastName = res.Data.LastName
 	resp.Tag = res.TechData.Tag
 	resp.Comment = res.TechData.Comment
+	if err := sendCustomFields(ctx, res.TechData.Fields); err != nil {
+		g.Logger.WithFields(logrus.Fields{
+			"service": "give_take_service",
+			"handler": "get_card",
+			"err":     err,
+			"from":    "send_custom_fields",
+		}).Error("Header error")
+	}
+	modelGetItem.Type = datatypes.CardDataType
+	touchItem(ctx, g.Rep, g.Logger, modelGetItem, "give_take_service", "get_card")
 	return resp, nil
 }
 
@@ -275,6 +303,16 @@ func (g *GiveTakeService) GetText(ctx context.Context, in *pb.GetItemReq) (*pb.G
 	resp.Data = res.Data.Data
 	resp.Tag = res.TechData.Tag
 	resp.Comment = res.TechData.Comment
+	if err := sendCustomFields(ctx, res.TechData.Fields); err != nil {
+		g.Logger.WithFields(logrus.Fields{
+			"service": "give_take_service",
+			"handler": "get_text",
+			"err":     err,
+			"from":    "send_custom_fields",
+		}).Error("Header error")
+	}
+	modelGetItem.Type = datatypes.TextDataType
+	touchItem(ctx, g.Rep, g.Logger, modelGetItem, "give_take_service", "get_text")
 	return resp, nil
 }
 
@@ -304,20 +342,21 @@ func (g *GiveTakeService) GetBinary(ctx context.Context, in *pb.GetItemReq) (*pb
 		resp.Error = customerror.ErrInternalServer.Error()
 		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
 	}
-	byteData, err := hex.DecodeString(res.Data.Data)
-	if err != nil {
+
+	resp.Id = res.TechData.ID
+	resp.Title = res.TechData.Title
+	resp.Data = res.Data.Data
+	resp.Tag = res.TechData.Tag
+	resp.Comment = res.TechData.Comment
+	if err := sendCustomFields(ctx, res.TechData.Fields); err != nil {
 		g.Logger.WithFields(logrus.Fields{
 			"service": "give_take_service",
 			"handler": "get_binary",
 			"err":     err,
-			"from":    "hex.decode_string",
-		}).Error("Decode error")
-		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+			"from":    "send_custom_fields",
+		}).Error("Header error")
 	}
-	resp.Id = res.TechData.ID
-	resp.Title = res.TechData.Title
-	resp.Data = byteData
-	resp.Tag = res.TechData.Tag
-	resp.Comment = res.TechData.Comment
+	modelGetItem.Type = datatypes.BinaryDataType
+	touchItem(ctx, g.Rep, g.Logger, modelGetItem, "give_take_service", "get_binary")
 	return resp, nil
 }
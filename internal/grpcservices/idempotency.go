@@ -0,0 +1,246 @@
+package grpcservices
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"time"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	pb "github.com/BillyBones007/pwdm_service_api/api"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Metadata key of the idempotency key.
+const IdempotencyKeyMD string = "idempotency-key"
+
+// Maximum length of the idempotency key.
+const maxIdempotencyKeyLen int = 255
+
+// Methods that change user data. Only they support the idempotency key.
+var idempotentMethods = map[string]bool{
+	pb.GiveTakeService_InsLogPwd_FullMethodName:                 true,
+	pb.GiveTakeService_InsCard_FullMethodName:                   true,
+	pb.GiveTakeService_InsText_FullMethodName:                   true,
+	pb.GiveTakeService_InsBinary_FullMethodName:                 true,
+	pb.UpdateService_UpdateLogPwd_FullMethodName:                true,
+	pb.UpdateService_UpdateCard_FullMethodName:                  true,
+	pb.UpdateService_UpdateText_FullMethodName:                  true,
+	pb.UpdateService_UpdateBinary_FullMethodName:                true,
+	pb.DeleteService_DelItem_FullMethodName:                     true,
+	srvpb.BatchService_Batch_FullMethodName:                     true,
+	srvpb.ItemsService_RenameTag_FullMethodName:                 true,
+	srvpb.ItemsService_MergeTags_FullMethodName:                 true,
+	srvpb.ItemsService_DeleteTag_FullMethodName:                 true,
+	srvpb.FoldersService_CreateFolder_FullMethodName:            true,
+	srvpb.FoldersService_DeleteFolder_FullMethodName:            true,
+	srvpb.FoldersService_RenameFolder_FullMethodName:            true,
+	srvpb.FoldersService_MoveFolder_FullMethodName:              true,
+	srvpb.FoldersService_MoveItems_FullMethodName:               true,
+	srvpb.ItemsService_SetCustomFields_FullMethodName:           true,
+	srvpb.AutofillService_SetLoginURIs_FullMethodName:           true,
+	srvpb.TOTPService_SetTOTP_FullMethodName:                    true,
+	srvpb.SSHKeyService_InsSSHKey_FullMethodName:                true,
+	srvpb.SSHKeyService_UpdateSSHKey_FullMethodName:             true,
+	srvpb.IdentityService_InsIdentity_FullMethodName:            true,
+	srvpb.IdentityService_UpdateIdentity_FullMethodName:         true,
+	srvpb.ExpiryService_SetExpiry_FullMethodName:                true,
+	srvpb.ItemsService_SetFavorite_FullMethodName:               true,
+	srvpb.AttachmentService_RemoveAttachment_FullMethodName:     true,
+	srvpb.VaultService_SetupVault_FullMethodName:                true,
+	srvpb.VaultService_PutVaultItem_FullMethodName:              true,
+	srvpb.VaultService_DeleteVaultItem_FullMethodName:           true,
+	srvpb.ShareService_SetKeyPair_FullMethodName:                true,
+	srvpb.ShareService_ShareItem_FullMethodName:                 true,
+	srvpb.ShareService_RevokeShare_FullMethodName:               true,
+	srvpb.OrganizationService_CreateOrganization_FullMethodName: true,
+	srvpb.OrganizationService_RemoveMember_FullMethodName:       true,
+	srvpb.OrganizationService_InviteMember_FullMethodName:       true,
+	srvpb.OrganizationService_AcceptInvitation_FullMethodName:   true,
+	srvpb.OrganizationService_DeclineInvitation_FullMethodName:  true,
+	srvpb.OrganizationService_CreateCollection_FullMethodName:   true,
+	srvpb.OrganizationService_SetCollectionRole_FullMethodName:  true,
+	srvpb.OrganizationService_MoveToCollection_FullMethodName:   true,
+}
+
+// IdempotencyInterceptor - middleware for the write methods. If the request contains the idempotency key
+// that was already used by the current user, the stored response is returned instead of executing the request again.
+// Must be called after AuthInterceptor.
+func (i *InterceptorsService) IdempotencyInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !idempotentMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+	key := idempotencyKey(ctx)
+	if key == "" {
+		return handler(ctx, req)
+	}
+	if len(key) > maxIdempotencyKeyLen {
+		return nil, status.Error(codes.InvalidArgument, customerror.ErrIdempotencyKeyTooLong.Error())
+	}
+
+	hash, err := requestHash(req)
+	if err != nil {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "interceptors_service",
+			"handler": "idempotency_interceptor",
+			"err":     err,
+			"from":    "request_hash",
+		}).Error("Encode error")
+		return nil, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+
+	uuid, _ := ctx.Value(UUIDKey).(string)
+	modelKey := models.IdempotencyModel{UUID: uuid, Key: key, Method: info.FullMethod, RequestHash: hash,
+		Expired: time.Now().Add(-i.idempotencyTTL)}
+	stored, reserved, err := i.rep.ReserveIdempotencyKey(ctx, modelKey)
+	if err != nil {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "interceptors_service",
+			"handler": "idempotency_interceptor",
+			"err":     err,
+			"from":    "storage.reserve_idempotency_key",
+		}).Error("Storage error")
+		return nil, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+
+	if !reserved {
+		return i.replayResponse(stored, modelKey)
+	}
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		// the failed request can be retried with the same key
+		if errDel := i.rep.DeleteIdempotencyKey(context.Background(), modelKey); errDel != nil {
+			i.Logger.WithFields(logrus.Fields{
+				"service": "interceptors_service",
+				"handler": "idempotency_interceptor",
+				"err":     errDel,
+				"from":    "storage.delete_idempotency_key",
+			}).Error("Storage error")
+		}
+		return resp, err
+	}
+
+	modelKey.Response, err = marshalResponse(resp)
+	if err == nil {
+		err = i.rep.SaveIdempotencyResponse(context.Background(), modelKey)
+	}
+	if err != nil {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "interceptors_service",
+			"handler": "idempotency_interceptor",
+			"err":     err,
+			"from":    "storage.save_idempotency_response",
+		}).Error("Storage error")
+		// otherwise the retries are aborted as in progress until the key expires
+		if errDel := i.rep.DeleteIdempotencyKey(context.Background(), modelKey); errDel != nil {
+			i.Logger.WithFields(logrus.Fields{
+				"service": "interceptors_service",
+				"handler": "idempotency_interceptor",
+				"err":     errDel,
+				"from":    "storage.delete_idempotency_key",
+			}).Error("Storage error")
+		}
+	}
+	return resp, nil
+}
+
+// replayResponse - returns the stored response of the previous request with the same key.
+// The key used with another method or another request body is rejected.
+func (i *InterceptorsService) replayResponse(stored models.IdempotencyModel, current models.IdempotencyModel) (interface{}, error) {
+	if !sameRequest(stored, current) {
+		return nil, status.Error(codes.InvalidArgument, customerror.ErrIdempotencyKeyReused.Error())
+	}
+	if stored.Response == nil {
+		return nil, status.Error(codes.Aborted, customerror.ErrRequestInProgress.Error())
+	}
+
+	resp, err := unmarshalResponse(stored.Response)
+	if err != nil {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "interceptors_service",
+			"handler": "idempotency_interceptor",
+			"err":     err,
+			"from":    "unmarshal_response",
+		}).Error("Decode error")
+		return nil, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+	return resp, nil
+}
+
+// idempotencyKey - gets the idempotency key from the incoming metadata.
+func idempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(IdempotencyKeyMD)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// requestHash - returns the SHA-256 of the deterministically serialized request.
+func requestHash(req interface{}) ([]byte, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil, customerror.ErrNotProtoMessage
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(data)
+	return hash[:], nil
+}
+
+// sameRequest - checks that the stored key was used by the same request.
+// The keys stored before the request hash was added are matched by the method only.
+func sameRequest(stored models.IdempotencyModel, current models.IdempotencyModel) bool {
+	if stored.Method != current.Method {
+		return false
+	}
+	return stored.RequestHash == nil || bytes.Equal(stored.RequestHash, current.RequestHash)
+}
+
+// marshalResponse - serializes the response with its type name.
+func marshalResponse(resp interface{}) ([]byte, error) {
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return nil, customerror.ErrNotProtoMessage
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	name := []byte(msg.ProtoReflect().Descriptor().FullName())
+	// format: <type name length><type name><message>
+	return append(append([]byte{byte(len(name))}, name...), data...), nil
+}
+
+// unmarshalResponse - deserializes the response serialized by marshalResponse.
+func unmarshalResponse(data []byte) (proto.Message, error) {
+	if len(data) == 0 || len(data) < int(data[0])+1 {
+		return nil, customerror.ErrNotProtoMessage
+	}
+	name := protoreflect.FullName(data[1 : data[0]+1])
+	msgType, err := protoregistry.GlobalTypes.FindMessageByName(name)
+	if err != nil {
+		return nil, err
+	}
+	msg := msgType.New().Interface()
+	if err := proto.Unmarshal(data[data[0]+1:], msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
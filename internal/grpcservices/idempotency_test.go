@@ -0,0 +1,49 @@
+package grpcservices
+
+import (
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	pb "github.com/BillyBones007/pwdm_service_api/api"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMarshalResponse(t *testing.T) {
+	resp := &pb.InsertResp{Id: 10, Title: "Title"}
+	data, err := marshalResponse(resp)
+	assert.NoError(t, err)
+
+	got, err := unmarshalResponse(data)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(resp, got))
+
+	_, err = marshalResponse("not a message")
+	assert.Error(t, err)
+
+	_, err = unmarshalResponse([]byte{10, 'a'})
+	assert.Error(t, err)
+}
+
+func TestRequestHash(t *testing.T) {
+	first, err := requestHash(&pb.InsertResp{Id: 10, Title: "Title"})
+	assert.NoError(t, err)
+	same, err := requestHash(&pb.InsertResp{Id: 10, Title: "Title"})
+	assert.NoError(t, err)
+	other, err := requestHash(&pb.InsertResp{Id: 10, Title: "Other"})
+	assert.NoError(t, err)
+	assert.Equal(t, first, same)
+	assert.NotEqual(t, first, other)
+
+	_, err = requestHash("not a message")
+	assert.Error(t, err)
+}
+
+func TestSameRequest(t *testing.T) {
+	stored := models.IdempotencyModel{Method: "/pwdm.UpdateService/UpdateText", RequestHash: []byte{1, 2}}
+	assert.True(t, sameRequest(stored, models.IdempotencyModel{Method: stored.Method, RequestHash: []byte{1, 2}}))
+	assert.False(t, sameRequest(stored, models.IdempotencyModel{Method: stored.Method, RequestHash: []byte{3, 4}}))
+	assert.False(t, sameRequest(stored, models.IdempotencyModel{Method: "/pwdm.DeleteService/DelItem", RequestHash: []byte{1, 2}}))
+	// the key stored without the hash
+	assert.True(t, sameRequest(models.IdempotencyModel{Method: stored.Method}, models.IdempotencyModel{Method: stored.Method, RequestHash: []byte{3, 4}}))
+}
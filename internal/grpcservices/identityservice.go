@@ -0,0 +1,251 @@
+package grpcservices
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Format of the dates in the requests and responses.
+const dateLayout string = "2006-01-02"
+
+// IdentityService - service contains methods for the identities.
+type IdentityService struct {
+	srvpb.UnimplementedIdentityServiceServer
+	Rep        storage.Storage
+	TokenTools *tokentools.JWTTools
+	Logger     *logrus.Logger
+}
+
+// NewIdentityService - constructor IdentityService.
+func NewIdentityService(r storage.Storage, tt *tokentools.JWTTools, l *logrus.Logger) *IdentityService {
+	return &IdentityService{Rep: r, TokenTools: tt, Logger: l}
+}
+
+// InsIdentity - send the identity to the server.
+func (i *IdentityService) InsIdentity(ctx context.Context, in *srvpb.IdentityReq) (*srvpb.IdentityResp, error) {
+	resp := &srvpb.IdentityResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "identity_service",
+			"handler": "ins_identity",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	data, err := identityFromProto(in.Identity)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	techData := models.ReqTechDataModel{Title: in.Title, Tag: in.Tag, Comment: in.Comment, Type: datatypes.IdentityDataType}
+
+	res, err := i.Rep.InsertIdentity(ctx, models.ReqIdentityModel{UUID: uuid, Data: data, TechData: techData})
+	if err != nil {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "identity_service",
+			"handler": "ins_identity",
+			"err":     err,
+			"from":    "storage.insert_identity",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+
+	resp.Id = res.ID
+	resp.Title = res.Title
+	resp.Identity = identityToProto(data)
+	resp.Tag = in.Tag
+	resp.Comment = in.Comment
+	return resp, nil
+}
+
+// GetIdentity - get the identity from server.
+func (i *IdentityService) GetIdentity(ctx context.Context, in *srvpb.GetIdentityReq) (*srvpb.IdentityResp, error) {
+	resp := &srvpb.IdentityResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "identity_service",
+			"handler": "get_identity",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	res, err := i.Rep.SelectIdentity(ctx, models.IDModel{UUID: uuid, ID: in.Id})
+	if errors.Is(err, customerror.ErrRecordNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "identity_service",
+			"handler": "get_identity",
+			"err":     err,
+			"from":    "storage.select_identity",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+
+	resp.Id = res.TechData.ID
+	resp.Title = res.TechData.Title
+	resp.Identity = identityToProto(res.Data)
+	resp.Tag = res.TechData.Tag
+	resp.Comment = res.TechData.Comment
+	resp.Fields = customFieldsToProto(res.TechData.Fields)
+	touchItem(ctx, i.Rep, i.Logger, models.IDModel{UUID: uuid, ID: in.Id, Type: datatypes.IdentityDataType}, "identity_service", "get_identity")
+	return resp, nil
+}
+
+// UpdateIdentity - update the identity on the server.
+func (i *IdentityService) UpdateIdentity(ctx context.Context, in *srvpb.IdentityReq) (*srvpb.IdentityResp, error) {
+	resp := &srvpb.IdentityResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "identity_service",
+			"handler": "update_identity",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	data, err := identityFromProto(in.Identity)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	data.ID = in.Id
+	techData := models.ReqTechDataModel{Title: in.Title, Tag: in.Tag, Comment: in.Comment, Type: datatypes.IdentityDataType}
+
+	res, err := i.Rep.UpdateIdentity(ctx, models.ReqIdentityModel{UUID: uuid, Data: data, TechData: techData})
+	if errors.Is(err, customerror.ErrRecordNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, customerror.ErrCollectionRole) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "identity_service",
+			"handler": "update_identity",
+			"err":     err,
+			"from":    "storage.update_identity",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+
+	resp.Id = res.ID
+	resp.Title = res.Title
+	resp.Identity = identityToProto(data)
+	resp.Tag = in.Tag
+	resp.Comment = in.Comment
+	return resp, nil
+}
+
+// identityFromProto - checks the identity of the request and converts it to the storage model.
+func identityFromProto(in *srvpb.Identity) (models.IdentityModel, error) {
+	res := models.IdentityModel{}
+	if in == nil {
+		return res, customerror.ErrMissingData
+	}
+	email := strings.TrimSpace(in.Email)
+	if email != "" {
+		if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+			return res, customerror.ErrInvalidEmail
+		}
+	}
+	passportExpiry, err := parseDate(in.PassportExpiry)
+	if err != nil {
+		return res, err
+	}
+	licenseExpiry, err := parseDate(in.LicenseExpiry)
+	if err != nil {
+		return res, err
+	}
+	return models.IdentityModel{
+		FirstName:      in.FirstName,
+		MiddleName:     in.MiddleName,
+		LastName:       in.LastName,
+		Address1:       in.Address1,
+		Address2:       in.Address2,
+		City:           in.City,
+		State:          in.State,
+		PostalCode:     in.PostalCode,
+		Country:        in.Country,
+		Phone:          in.Phone,
+		Email:          email,
+		PassportNumber: in.PassportNumber,
+		PassportExpiry: passportExpiry,
+		LicenseNumber:  in.LicenseNumber,
+		LicenseExpiry:  licenseExpiry,
+		NationalID:     in.NationalId,
+		TaxID:          in.TaxId,
+	}, nil
+}
+
+// identityToProto - converts the identity of the storage to the response identity.
+func identityToProto(in models.IdentityModel) *srvpb.Identity {
+	return &srvpb.Identity{
+		FirstName:      in.FirstName,
+		MiddleName:     in.MiddleName,
+		LastName:       in.LastName,
+		Address1:       in.Address1,
+		Address2:       in.Address2,
+		City:           in.City,
+		State:          in.State,
+		PostalCode:     in.PostalCode,
+		Country:        in.Country,
+		Phone:          in.Phone,
+		Email:          in.Email,
+		PassportNumber: in.PassportNumber,
+		PassportExpiry: formatDate(in.PassportExpiry),
+		LicenseNumber:  in.LicenseNumber,
+		LicenseExpiry:  formatDate(in.LicenseExpiry),
+		NationalId:     in.NationalID,
+		TaxId:          in.TaxID,
+	}
+}
+
+// parseDate - parses the date in the YYYY-MM-DD format, the empty string is the zero date.
+func parseDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return time.Time{}, customerror.ErrInvalidDate
+	}
+	return t, nil
+}
+
+// formatDate - formats the date in the YYYY-MM-DD format, the zero date is the empty string.
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(dateLayout)
+}
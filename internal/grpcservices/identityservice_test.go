@@ -0,0 +1,30 @@
+package grpcservices
+
+import (
+	"testing"
+	"time"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentityFromProto(t *testing.T) {
+	in := &srvpb.Identity{FirstName: "Ivan", LastName: "Petrov", Email: " ivan@example.com ", PassportExpiry: "2030-05-01"}
+	res, err := identityFromProto(in)
+	assert.NoError(t, err)
+	assert.Equal(t, "ivan@example.com", res.Email)
+	assert.Equal(t, time.Date(2030, time.May, 1, 0, 0, 0, 0, time.UTC), res.PassportExpiry)
+	assert.True(t, res.LicenseExpiry.IsZero())
+
+	out := identityToProto(res)
+	assert.Equal(t, "2030-05-01", out.PassportExpiry)
+	assert.Equal(t, "", out.LicenseExpiry)
+
+	_, err = identityFromProto(&srvpb.Identity{LicenseExpiry: "01.05.2030"})
+	assert.ErrorIs(t, err, customerror.ErrInvalidDate)
+	_, err = identityFromProto(&srvpb.Identity{Email: "Ivan <ivan@example.com>"})
+	assert.ErrorIs(t, err, customerror.ErrInvalidEmail)
+	_, err = identityFromProto(nil)
+	assert.ErrorIs(t, err, customerror.ErrMissingData)
+}
@@ -3,8 +3,11 @@ package grpcservices
 import (
 	"context"
 	"fmt"
+	"time"
 
+	srvpb "github.com/BillyBones007/pwdm_server/api"
 	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
 	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
@@ -17,42 +20,78 @@ type Key string
 
 const UUIDKey Key = "uuid"
 
+// Methods called without the token.
+var publicMethods = map[string]bool{
+	"/pwdm.AuthService/Create":                  true,
+	"/pwdm.AuthService/Enter":                   true,
+	srvpb.SRPService_Register_FullMethodName:    true,
+	srvpb.SRPService_StartLogin_FullMethodName:  true,
+	srvpb.SRPService_FinishLogin_FullMethodName: true,
+}
+
 // InterceptorsService - interceptors struct.
 type InterceptorsService struct {
-	tokenTools *tokentools.JWTTools
-	Logger     *logrus.Logger
+	rep            storage.Storage
+	tokenTools     *tokentools.JWTTools
+	idempotencyTTL time.Duration // how long the responses for the idempotency keys are stored
+	Logger         *logrus.Logger
 }
 
 // NewInterceptorsService - constructor.
-func NewInterceptorsService(tt *tokentools.JWTTools, l *logrus.Logger) *InterceptorsService {
-	return &InterceptorsService{tokenTools: tt, Logger: l}
+func NewInterceptorsService(r storage.Storage, tt *tokentools.JWTTools, ttl time.Duration, l *logrus.Logger) *InterceptorsService {
+	return &InterceptorsService{rep: r, tokenTools: tt, idempotencyTTL: ttl, Logger: l}
 }
 
 // AuthInterceptor - middleware for checking the token when contacting grpc.
 func (i *InterceptorsService) AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	fmt.Printf("INFO: Called method: %v\n", info.FullMethod)
-	if info.FullMethod == "/pwdm.AuthService/Create" || info.FullMethod == "/pwdm.AuthService/Enter" {
+	if publicMethods[info.FullMethod] {
 		return handler(ctx, req)
 	}
 
+	uuid, err := i.checkToken(ctx, "auth_interceptor")
+	if err != nil {
+		return nil, err
+	}
+	newctx := context.WithValue(ctx, UUIDKey, uuid)
+	return handler(newctx, req)
+}
+
+// AuthStreamInterceptor - middleware for checking the token when contacting grpc streams.
+func (i *InterceptorsService) AuthStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	i.Logger.WithFields(logrus.Fields{
+		"service": "interceptors_service",
+		"handler": "auth_stream_interceptor",
+		"method":  info.FullMethod,
+	}).Trace("Called method")
+	uuid, err := i.checkToken(ss.Context(), "auth_stream_interceptor")
+	if err != nil {
+		return err
+	}
+	newctx := context.WithValue(ss.Context(), UUIDKey, uuid)
+	return handler(srv, &authServerStream{ServerStream: ss, ctx: newctx})
+}
+
+// checkToken - gets the token from the metadata and returns the uuid of the user.
+func (i *InterceptorsService) checkToken(ctx context.Context, handler string) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		i.Logger.WithFields(logrus.Fields{
 			"service": "interceptors_service",
-			"handler": "auth_interceptor",
+			"handler": handler,
 			"err":     customerror.ErrMissingMD.Error(),
 		}).Trace("Metadata error")
-		return nil, status.Error(codes.Unauthenticated, customerror.ErrMissingMD.Error())
+		return "", status.Error(codes.Unauthenticated, customerror.ErrMissingMD.Error())
 	}
 
 	values := md.Get("token")
 	if len(values) == 0 {
 		i.Logger.WithFields(logrus.Fields{
 			"service": "interceptors_service",
-			"handler": "auth_interceptor",
+			"handler": handler,
 			"err":     customerror.ErrMissingToken.Error(),
 		}).Trace("Token error")
-		return nil, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+		return "", status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
 	}
 
 	token := values[0]
@@ -60,12 +99,22 @@ func (i *InterceptorsService) AuthInterceptor(ctx context.Context, req interface
 	if err != nil {
 		i.Logger.WithFields(logrus.Fields{
 			"service": "interceptors_service",
-			"handler": "auth_interceptor",
+			"handler": handler,
 			"err":     err,
 			"from":    "token_tools.parse_uuid",
 		}).Error("TokenTools error")
-		return nil, status.Error(codes.Unauthenticated, err.Error())
+		return "", status.Error(codes.Unauthenticated, err.Error())
 	}
-	newctx := context.WithValue(context.Background(), UUIDKey, uuid)
-	return handler(newctx, req)
+	return uuid, nil
+}
+
+// authServerStream - server stream with the context containing the uuid of the user.
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context - returns the context of the stream.
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
 }
@@ -0,0 +1,391 @@
+package grpcservices
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/fieldtools"
+	"github.com/BillyBones007/pwdm_server/internal/tools/pagecursor"
+	"github.com/BillyBones007/pwdm_server/internal/tools/tagtools"
+	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Page size limits for the list of records.
+const (
+	DefaultItemsLimit int32 = 50
+	MaxItemsLimit     int32 = 500
+)
+
+// ItemsService - service contains methods for searching and organizing user records.
+type ItemsService struct {
+	srvpb.UnimplementedItemsServiceServer
+	Rep        storage.Storage
+	TokenTools *tokentools.JWTTools
+	Logger     *logrus.Logger
+}
+
+// NewItemsService - constructor ItemsService.
+func NewItemsService(r storage.Storage, tt *tokentools.JWTTools, l *logrus.Logger) *ItemsService {
+	return &ItemsService{Rep: r, TokenTools: tt, Logger: l}
+}
+
+// ListItems - get a filtered and sorted page of records of the current user.
+func (i *ItemsService) ListItems(ctx context.Context, in *srvpb.ListItemsReq) (*srvpb.ListItemsResp, error) {
+	resp := &srvpb.ListItemsResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "items_service",
+			"handler": "list_items",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	cursor, err := pagecursor.Decode(in.Cursor)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit := in.Limit
+	if limit <= 0 {
+		limit = DefaultItemsLimit
+	}
+	if limit > MaxItemsLimit {
+		limit = MaxItemsLimit
+	}
+	if in.Tag != "" {
+		in.Tags = append(in.Tags, in.Tag)
+	}
+
+	modelList := models.ListItemsReqModel{
+		UUID:           uuid,
+		Types:          in.Types,
+		Tags:           in.Tags,
+		Title:          in.Title,
+		CreatedFrom:    timeFromProto(in.CreatedFrom),
+		CreatedTo:      timeFromProto(in.CreatedTo),
+		UpdatedFrom:    timeFromProto(in.UpdatedFrom),
+		UpdatedTo:      timeFromProto(in.UpdatedTo),
+		SortBy:         int32(in.SortBy),
+		Desc:           in.Desc,
+		Cursor:         cursor,
+		Limit:          limit,
+		FavoritesFirst: in.FavoritesFirst,
+	}
+	res, err := i.Rep.ListItems(ctx, modelList)
+	if errors.Is(err, customerror.ErrUnknownSortOrder) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "items_service",
+			"handler": "list_items",
+			"err":     err,
+			"from":    "storage.list_items",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+
+	cards, err := selectCardsInfo(ctx, i.Rep, uuid, res.Items)
+	if err != nil {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "items_service",
+			"handler": "list_items",
+			"err":     err,
+			"from":    "storage.select_card_numbers",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+
+	resp.Items = itemsToProto(res.Items, cards)
+
+	if res.Next != nil {
+		resp.NextCursor, err = pagecursor.Encode(*res.Next)
+		if err != nil {
+			i.Logger.WithFields(logrus.Fields{
+				"service": "items_service",
+				"handler": "list_items",
+				"err":     err,
+				"from":    "pagecursor.encode",
+			}).Error("Encode error")
+			resp.Error = customerror.ErrInternalServer.Error()
+			return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+		}
+	}
+	return resp, nil
+}
+
+// ListTags - get all tags of the current user with the number of records.
+func (i *ItemsService) ListTags(ctx context.Context, in *srvpb.ListTagsReq) (*srvpb.ListTagsResp, error) {
+	resp := &srvpb.ListTagsResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "items_service",
+			"handler": "list_tags",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	res, err := i.Rep.ListTags(ctx, uuid)
+	if err != nil {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "items_service",
+			"handler": "list_tags",
+			"err":     err,
+			"from":    "storage.list_tags",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+
+	listTags := make([]*srvpb.ListTagsResp_TagModel, 0, len(res))
+	for _, tag := range res {
+		listTags = append(listTags, &srvpb.ListTagsResp_TagModel{Name: tag.Name, Count: tag.Count})
+	}
+	resp.Tags = listTags
+	return resp, nil
+}
+
+// RenameTag - rename the tag on all records of the current user.
+func (i *ItemsService) RenameTag(ctx context.Context, in *srvpb.RenameTagReq) (*srvpb.TagsResp, error) {
+	resp := &srvpb.TagsResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "items_service",
+			"handler": "rename_tag",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+	if !tagtools.Valid(in.Name) || !tagtools.Valid(in.NewName) {
+		resp.Error = customerror.ErrInvalidTag.Error()
+		return resp, status.Error(codes.InvalidArgument, customerror.ErrInvalidTag.Error())
+	}
+
+	modelRename := models.RenameTagModel{UUID: uuid, Name: in.Name, NewName: in.NewName}
+	affected, err := i.Rep.RenameTag(ctx, modelRename)
+	if err != nil {
+		return resp, i.tagError(resp, err, "rename_tag", "storage.rename_tag")
+	}
+	resp.Affected = affected
+	return resp, nil
+}
+
+// MergeTags - replace the tags with the target tag on all records of the current user.
+func (i *ItemsService) MergeTags(ctx context.Context, in *srvpb.MergeTagsReq) (*srvpb.TagsResp, error) {
+	resp := &srvpb.TagsResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "items_service",
+			"handler": "merge_tags",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+	valid := len(in.Names) > 0 && tagtools.Valid(in.Target)
+	for _, name := range in.Names {
+		valid = valid && tagtools.Valid(name)
+	}
+	if !valid {
+		resp.Error = customerror.ErrInvalidTag.Error()
+		return resp, status.Error(codes.InvalidArgument, customerror.ErrInvalidTag.Error())
+	}
+
+	modelMerge := models.MergeTagsModel{UUID: uuid, Names: in.Names, Target: in.Target}
+	affected, err := i.Rep.MergeTags(ctx, modelMerge)
+	if err != nil {
+		return resp, i.tagError(resp, err, "merge_tags", "storage.merge_tags")
+	}
+	resp.Affected = affected
+	return resp, nil
+}
+
+// DeleteTag - remove the tag from all records of the current user.
+func (i *ItemsService) DeleteTag(ctx context.Context, in *srvpb.DeleteTagReq) (*srvpb.TagsResp, error) {
+	resp := &srvpb.TagsResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "items_service",
+			"handler": "delete_tag",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+	if !tagtools.Valid(in.Name) {
+		resp.Error = customerror.ErrInvalidTag.Error()
+		return resp, status.Error(codes.InvalidArgument, customerror.ErrInvalidTag.Error())
+	}
+
+	affected, err := i.Rep.DeleteTag(ctx, models.TagReqModel{UUID: uuid, Name: in.Name})
+	if err != nil {
+		return resp, i.tagError(resp, err, "delete_tag", "storage.delete_tag")
+	}
+	resp.Affected = affected
+	return resp, nil
+}
+
+// SetCustomFields - replace the custom fields of the record.
+func (i *ItemsService) SetCustomFields(ctx context.Context, in *srvpb.SetCustomFieldsReq) (*srvpb.CustomFieldsResp, error) {
+	resp := &srvpb.CustomFieldsResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "items_service",
+			"handler": "set_custom_fields",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	fields := customFieldsFromProto(in.Fields)
+	if err := fieldtools.Validate(fields); err != nil {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	modelFields := models.CustomFieldsModel{UUID: uuid, ID: in.Id, Type: in.Type, Fields: fields}
+	err := i.Rep.SetCustomFields(ctx, modelFields)
+	switch {
+	case errors.Is(err, customerror.ErrRecordNotFound):
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, customerror.ErrUnknownDataType):
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, customerror.ErrCollectionRole):
+		resp.Error = err.Error()
+		return resp, status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
+		i.Logger.WithFields(logrus.Fields{
+			"service": "items_service",
+			"handler": "set_custom_fields",
+			"err":     err,
+			"from":    "storage.set_custom_fields",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+	resp.Fields = customFieldsToProto(fields)
+	return resp, nil
+}
+
+// SetFavorite - mark the record as favorite or remove the mark.
+func (i *ItemsService) SetFavorite(ctx context.Context, in *srvpb.SetFavoriteReq) (*srvpb.SetFavoriteResp, error) {
+	resp := &srvpb.SetFavoriteResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "items_service",
+			"handler": "set_favorite",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	err := i.Rep.SetFavorite(ctx, models.FavoriteModel{UUID: uuid, ID: in.Id, Type: in.Type, Favorite: in.Favorite})
+	switch {
+	case errors.Is(err, customerror.ErrRecordNotFound):
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, customerror.ErrUnknownDataType):
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, customerror.ErrCollectionRole):
+		resp.Error = err.Error()
+		return resp, status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
+		i.Logger.WithFields(logrus.Fields{
+			"service": "items_service",
+			"handler": "set_favorite",
+			"err":     err,
+			"from":    "storage.set_favorite",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+	return resp, nil
+}
+
+// tagError - converts the storage error of the tag management to the grpc error.
+func (i *ItemsService) tagError(resp *srvpb.TagsResp, err error, handler string, from string) error {
+	switch {
+	case errors.Is(err, customerror.ErrTagNotFound):
+		resp.Error = err.Error()
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, customerror.ErrTagIsExists):
+		resp.Error = err.Error()
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	i.Logger.WithFields(logrus.Fields{
+		"service": "items_service",
+		"handler": handler,
+		"err":     err,
+		"from":    from,
+	}).Error("Storage error")
+	resp.Error = customerror.ErrInternalServer.Error()
+	return status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+}
+
+// timeFromProto - converts the timestamp to time, nil timestamp is the zero time.
+func timeFromProto(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+// itemsToProto - converts the records to the response records, the cards get the brand and the masked number.
+func itemsToProto(records []models.DataRecordModel, cards map[int32]cardInfo) []*srvpb.ListItemsResp_ItemModel {
+	res := make([]*srvpb.ListItemsResp_ItemModel, 0, len(records))
+	for _, record := range records {
+		item := &srvpb.ListItemsResp_ItemModel{
+			Id:          record.ID,
+			Type:        record.Type,
+			Title:       record.Title,
+			Tag:         record.Tag,
+			Comment:     record.Comment,
+			CreatedAt:   timestamppb.New(record.CreatedAt),
+			UpdatedAt:   timestamppb.New(record.UpdatedAt),
+			FolderId:    record.FolderID,
+			Fields:      customFieldsToProto(record.Fields),
+			Favorite:    record.Favorite,
+			AccessCount: record.AccessCount,
+		}
+		if !record.LastAccessedAt.IsZero() {
+			item.LastAccessedAt = timestamppb.New(record.LastAccessedAt)
+		}
+		if card, ok := cards[record.ID]; ok && record.Type == datatypes.CardDataType {
+			item.CardBrand = card.Brand
+			item.MaskedNum = card.Masked
+		}
+		res = append(res, item)
+	}
+	return res
+}
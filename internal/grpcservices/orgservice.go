@@ -0,0 +1,433 @@
+package grpcservices
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Maximum length of the name of the organization and of the collection.
+const maxOrgNameLen int = 255
+
+// orgIDPattern - format of the id of the organization.
+var orgIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// OrganizationService - service contains methods for the organizations and their shared collections of records.
+type OrganizationService struct {
+	srvpb.UnimplementedOrganizationServiceServer
+	Rep        storage.Storage
+	TokenTools *tokentools.JWTTools
+	Logger     *logrus.Logger
+}
+
+// NewOrganizationService - constructor OrganizationService.
+func NewOrganizationService(r storage.Storage, tt *tokentools.JWTTools, l *logrus.Logger) *OrganizationService {
+	return &OrganizationService{Rep: r, TokenTools: tt, Logger: l}
+}
+
+// CreateOrganization - creates the organization, the current user becomes its administrator.
+func (o *OrganizationService) CreateOrganization(ctx context.Context, in *srvpb.CreateOrganizationReq) (*srvpb.CreateOrganizationResp, error) {
+	resp := &srvpb.CreateOrganizationResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		o.tokenError("create_organization")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+	if !validOrgName(in.Name) {
+		resp.Error = customerror.ErrInvalidOrgName.Error()
+		return resp, status.Error(codes.InvalidArgument, customerror.ErrInvalidOrgName.Error())
+	}
+
+	id, err := o.Rep.CreateOrganization(ctx, models.OrganizationModel{UUID: uuid, Name: in.Name})
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(o.Logger, err, "org_service", "create_organization", "storage.create_organization")
+	}
+	resp.Id = id
+	return resp, nil
+}
+
+// ListOrganizations - get the organizations of the current user.
+func (o *OrganizationService) ListOrganizations(ctx context.Context, in *srvpb.ListOrganizationsReq) (*srvpb.ListOrganizationsResp, error) {
+	resp := &srvpb.ListOrganizationsResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		o.tokenError("list_organizations")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	res, err := o.Rep.SelectOrganizations(ctx, uuid)
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(o.Logger, err, "org_service", "list_organizations", "storage.select_organizations")
+	}
+	resp.Organizations = make([]*srvpb.Organization, 0, len(res))
+	for _, org := range res {
+		resp.Organizations = append(resp.Organizations, &srvpb.Organization{
+			Id:        org.ID,
+			Name:      org.Name,
+			Admin:     org.Admin,
+			CreatedAt: timestamppb.New(org.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// ListMembers - get the members of the organization of the current user.
+func (o *OrganizationService) ListMembers(ctx context.Context, in *srvpb.ListMembersReq) (*srvpb.ListMembersResp, error) {
+	resp := &srvpb.ListMembersResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		o.tokenError("list_members")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+	if !validOrgID(in.OrgId) {
+		resp.Error = customerror.ErrOrgNotFound.Error()
+		return resp, status.Error(codes.NotFound, customerror.ErrOrgNotFound.Error())
+	}
+
+	res, err := o.Rep.SelectOrgMembers(ctx, models.OrgMemberModel{OrgID: in.OrgId, UUID: uuid})
+	if err != nil {
+		var errResp error
+		resp.Error, errResp = o.orgError(err, "list_members", "storage.select_org_members")
+		return resp, errResp
+	}
+	resp.Members = make([]*srvpb.OrgMember, 0, len(res))
+	for _, member := range res {
+		resp.Members = append(resp.Members, &srvpb.OrgMember{
+			Login:    member.Login,
+			Admin:    member.Admin,
+			JoinedAt: timestamppb.New(member.JoinedAt),
+		})
+	}
+	return resp, nil
+}
+
+// RemoveMember - removes the member from the organization. The member leaves the organization with the own login.
+func (o *OrganizationService) RemoveMember(ctx context.Context, in *srvpb.RemoveMemberReq) (*srvpb.OrgResp, error) {
+	resp := &srvpb.OrgResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		o.tokenError("remove_member")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+	if !validOrgID(in.OrgId) {
+		resp.Error = customerror.ErrOrgNotFound.Error()
+		return resp, status.Error(codes.NotFound, customerror.ErrOrgNotFound.Error())
+	}
+
+	err := o.Rep.RemoveOrgMember(ctx, models.OrgMemberModel{OrgID: in.OrgId, UUID: uuid, Login: in.Login})
+	if err != nil {
+		var errResp error
+		resp.Error, errResp = o.orgError(err, "remove_member", "storage.remove_org_member")
+		return resp, errResp
+	}
+	return resp, nil
+}
+
+// InviteMember - invites the user to the organization.
+func (o *OrganizationService) InviteMember(ctx context.Context, in *srvpb.InviteMemberReq) (*srvpb.OrgResp, error) {
+	resp := &srvpb.OrgResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		o.tokenError("invite_member")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+	if !validOrgID(in.OrgId) {
+		resp.Error = customerror.ErrOrgNotFound.Error()
+		return resp, status.Error(codes.NotFound, customerror.ErrOrgNotFound.Error())
+	}
+
+	err := o.Rep.InviteOrgMember(ctx, models.InvitationModel{OrgID: in.OrgId, UUID: uuid, Login: in.Login})
+	if err != nil {
+		var errResp error
+		resp.Error, errResp = o.orgError(err, "invite_member", "storage.invite_org_member")
+		return resp, errResp
+	}
+	return resp, nil
+}
+
+// ListInvitations - get the invitations of the current user.
+func (o *OrganizationService) ListInvitations(ctx context.Context, in *srvpb.ListInvitationsReq) (*srvpb.ListInvitationsResp, error) {
+	resp := &srvpb.ListInvitationsResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		o.tokenError("list_invitations")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	res, err := o.Rep.SelectInvitations(ctx, uuid)
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(o.Logger, err, "org_service", "list_invitations", "storage.select_invitations")
+	}
+	resp.Invitations = make([]*srvpb.Invitation, 0, len(res))
+	for _, invitation := range res {
+		resp.Invitations = append(resp.Invitations, &srvpb.Invitation{
+			OrgId:     invitation.OrgID,
+			OrgName:   invitation.OrgName,
+			InvitedBy: invitation.InvitedByLogin,
+			CreatedAt: timestamppb.New(invitation.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// AcceptInvitation - the current user joins the organization by the invitation.
+func (o *OrganizationService) AcceptInvitation(ctx context.Context, in *srvpb.InvitationReq) (*srvpb.OrgResp, error) {
+	resp := &srvpb.OrgResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		o.tokenError("accept_invitation")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+	if !validOrgID(in.OrgId) {
+		resp.Error = customerror.ErrInvitationNotFound.Error()
+		return resp, status.Error(codes.NotFound, customerror.ErrInvitationNotFound.Error())
+	}
+
+	err := o.Rep.AcceptInvitation(ctx, models.InvitationModel{OrgID: in.OrgId, UUID: uuid})
+	if err != nil {
+		var errResp error
+		resp.Error, errResp = o.orgError(err, "accept_invitation", "storage.accept_invitation")
+		return resp, errResp
+	}
+	return resp, nil
+}
+
+// DeclineInvitation - deletes the invitation of the current user.
+func (o *OrganizationService) DeclineInvitation(ctx context.Context, in *srvpb.InvitationReq) (*srvpb.OrgResp, error) {
+	resp := &srvpb.OrgResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		o.tokenError("decline_invitation")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+	if !validOrgID(in.OrgId) {
+		resp.Error = customerror.ErrInvitationNotFound.Error()
+		return resp, status.Error(codes.NotFound, customerror.ErrInvitationNotFound.Error())
+	}
+
+	err := o.Rep.DeclineInvitation(ctx, models.InvitationModel{OrgID: in.OrgId, UUID: uuid})
+	if err != nil {
+		var errResp error
+		resp.Error, errResp = o.orgError(err, "decline_invitation", "storage.decline_invitation")
+		return resp, errResp
+	}
+	return resp, nil
+}
+
+// CreateCollection - creates the collection in the organization, the current user becomes its manager.
+func (o *OrganizationService) CreateCollection(ctx context.Context, in *srvpb.CreateCollectionReq) (*srvpb.CreateCollectionResp, error) {
+	resp := &srvpb.CreateCollectionResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		o.tokenError("create_collection")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+	if !validOrgName(in.Name) {
+		resp.Error = customerror.ErrInvalidOrgName.Error()
+		return resp, status.Error(codes.InvalidArgument, customerror.ErrInvalidOrgName.Error())
+	}
+	if !validOrgID(in.OrgId) {
+		resp.Error = customerror.ErrOrgNotFound.Error()
+		return resp, status.Error(codes.NotFound, customerror.ErrOrgNotFound.Error())
+	}
+
+	id, err := o.Rep.CreateCollection(ctx, models.CollectionModel{OrgID: in.OrgId, UUID: uuid, Name: in.Name})
+	if err != nil {
+		var errResp error
+		resp.Error, errResp = o.orgError(err, "create_collection", "storage.create_collection")
+		return resp, errResp
+	}
+	resp.Id = id
+	return resp, nil
+}
+
+// ListCollections - get the collections of the current user.
+func (o *OrganizationService) ListCollections(ctx context.Context, in *srvpb.ListCollectionsReq) (*srvpb.ListCollectionsResp, error) {
+	resp := &srvpb.ListCollectionsResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		o.tokenError("list_collections")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	res, err := o.Rep.SelectCollections(ctx, uuid)
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(o.Logger, err, "org_service", "list_collections", "storage.select_collections")
+	}
+	resp.Collections = make([]*srvpb.Collection, 0, len(res))
+	for _, collection := range res {
+		resp.Collections = append(resp.Collections, &srvpb.Collection{
+			Id:        collection.ID,
+			OrgId:     collection.OrgID,
+			Name:      collection.Name,
+			Role:      collection.Role,
+			CreatedAt: timestamppb.New(collection.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// SetCollectionRole - grants the role in the collection to the member of the organization, the role 0 removes it.
+func (o *OrganizationService) SetCollectionRole(ctx context.Context, in *srvpb.SetCollectionRoleReq) (*srvpb.OrgResp, error) {
+	resp := &srvpb.OrgResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		o.tokenError("set_collection_role")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+	if in.Role != 0 && !validRole(in.Role) {
+		resp.Error = customerror.ErrUnknownRole.Error()
+		return resp, status.Error(codes.InvalidArgument, customerror.ErrUnknownRole.Error())
+	}
+
+	model := models.CollectionRoleModel{CollectionID: in.CollectionId, UUID: uuid, Login: in.Login, Role: in.Role}
+	if err := o.Rep.SetCollectionRole(ctx, model); err != nil {
+		var errResp error
+		resp.Error, errResp = o.orgError(err, "set_collection_role", "storage.set_collection_role")
+		return resp, errResp
+	}
+	return resp, nil
+}
+
+// ListCollectionMembers - get the members of the collection with their roles.
+func (o *OrganizationService) ListCollectionMembers(ctx context.Context, in *srvpb.ListCollectionMembersReq) (*srvpb.ListCollectionMembersResp, error) {
+	resp := &srvpb.ListCollectionMembersResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		o.tokenError("list_collection_members")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	res, err := o.Rep.SelectCollectionMembers(ctx, models.CollectionReqModel{ID: in.CollectionId, UUID: uuid})
+	if err != nil {
+		var errResp error
+		resp.Error, errResp = o.orgError(err, "list_collection_members", "storage.select_collection_members")
+		return resp, errResp
+	}
+	resp.Members = make([]*srvpb.CollectionMember, 0, len(res))
+	for _, member := range res {
+		resp.Members = append(resp.Members, &srvpb.CollectionMember{Login: member.Login, Role: member.Role})
+	}
+	return resp, nil
+}
+
+// ListCollectionItems - get the records of the collection where the current user has a role.
+func (o *OrganizationService) ListCollectionItems(ctx context.Context, in *srvpb.ListCollectionItemsReq) (*srvpb.ListItemsResp, error) {
+	resp := &srvpb.ListItemsResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		o.tokenError("list_collection_items")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	res, err := o.Rep.SelectCollectionItems(ctx, models.CollectionReqModel{ID: in.CollectionId, UUID: uuid})
+	if err != nil {
+		var errResp error
+		resp.Error, errResp = o.orgError(err, "list_collection_items", "storage.select_collection_items")
+		return resp, errResp
+	}
+	resp.Items = itemsToProto(res, nil)
+	return resp, nil
+}
+
+// MoveToCollection - moves the record of the current user to the collection, the record is owned by the organization after it.
+func (o *OrganizationService) MoveToCollection(ctx context.Context, in *srvpb.MoveToCollectionReq) (*srvpb.OrgResp, error) {
+	resp := &srvpb.OrgResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		o.tokenError("move_to_collection")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	model := models.MoveToCollectionModel{UUID: uuid, ID: in.Id, Type: in.Type, CollectionID: in.CollectionId}
+	if err := o.Rep.MoveToCollection(ctx, model); err != nil {
+		var errResp error
+		resp.Error, errResp = o.orgError(err, "move_to_collection", "storage.move_to_collection")
+		return resp, errResp
+	}
+	return resp, nil
+}
+
+// tokenError - logs the request without the token.
+func (o *OrganizationService) tokenError(handler string) {
+	o.Logger.WithFields(logrus.Fields{
+		"service": "org_service",
+		"handler": handler,
+		"err":     customerror.ErrMissingToken.Error(),
+	}).Trace("Token error")
+}
+
+// orgError - returns the error message of the response and the status error.
+// The unknown errors are logged and returned as internal.
+func (o *OrganizationService) orgError(err error, handler string, from string) (string, error) {
+	code := orgErrorCode(err)
+	if code == codes.Internal {
+		return customerror.ErrInternalServer.Error(), internalError(o.Logger, err, "org_service", handler, from)
+	}
+	return err.Error(), status.Error(code, err.Error())
+}
+
+// orgErrorCode - returns the status code of the error of the organization management. The unknown errors are internal.
+func orgErrorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, customerror.ErrOrgNotFound), errors.Is(err, customerror.ErrUserNotFound),
+		errors.Is(err, customerror.ErrInvitationNotFound), errors.Is(err, customerror.ErrCollectionNotFound),
+		errors.Is(err, customerror.ErrRecordNotFound), errors.Is(err, customerror.ErrNotOrgMember):
+		return codes.NotFound
+	case errors.Is(err, customerror.ErrNotOrgAdmin), errors.Is(err, customerror.ErrCollectionRole):
+		return codes.PermissionDenied
+	case errors.Is(err, customerror.ErrAlreadyOrgMember), errors.Is(err, customerror.ErrCollectionIsExists):
+		return codes.AlreadyExists
+	case errors.Is(err, customerror.ErrLastOrgAdmin):
+		return codes.FailedPrecondition
+	case errors.Is(err, customerror.ErrUnknownDataType):
+		return codes.InvalidArgument
+	}
+	return codes.Internal
+}
+
+// validOrgName - checks that the name of the organization or of the collection is not empty and is not too long.
+func validOrgName(name string) bool {
+	return strings.TrimSpace(name) != "" && utf8.RuneCountInString(name) <= maxOrgNameLen
+}
+
+// validOrgID - checks the format of the id of the organization, the id in the other format cannot exist.
+func validOrgID(id string) bool {
+	return orgIDPattern.MatchString(id)
+}
+
+// validRole - checks that the role in the collection is known.
+func validRole(role int32) bool {
+	return role >= datatypes.ViewerRole && role <= datatypes.ManagerRole
+}
@@ -0,0 +1,53 @@
+package grpcservices
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestOrgErrorCode(t *testing.T) {
+	assert.Equal(t, codes.NotFound, orgErrorCode(customerror.ErrOrgNotFound))
+	assert.Equal(t, codes.NotFound, orgErrorCode(customerror.ErrUserNotFound))
+	assert.Equal(t, codes.NotFound, orgErrorCode(customerror.ErrInvitationNotFound))
+	assert.Equal(t, codes.NotFound, orgErrorCode(customerror.ErrCollectionNotFound))
+	assert.Equal(t, codes.NotFound, orgErrorCode(customerror.ErrNotOrgMember))
+	assert.Equal(t, codes.NotFound, orgErrorCode(customerror.ErrRecordNotFound))
+	assert.Equal(t, codes.PermissionDenied, orgErrorCode(customerror.ErrNotOrgAdmin))
+	assert.Equal(t, codes.PermissionDenied, orgErrorCode(fmt.Errorf("move: %w", customerror.ErrCollectionRole)))
+	assert.Equal(t, codes.AlreadyExists, orgErrorCode(customerror.ErrAlreadyOrgMember))
+	assert.Equal(t, codes.AlreadyExists, orgErrorCode(customerror.ErrCollectionIsExists))
+	assert.Equal(t, codes.FailedPrecondition, orgErrorCode(customerror.ErrLastOrgAdmin))
+	assert.Equal(t, codes.InvalidArgument, orgErrorCode(customerror.ErrUnknownDataType))
+	assert.Equal(t, codes.Internal, orgErrorCode(errors.New("connection refused")))
+}
+
+func TestValidOrgName(t *testing.T) {
+	assert.True(t, validOrgName("Backend team"))
+	assert.True(t, validOrgName(strings.Repeat("я", maxOrgNameLen)))
+	assert.False(t, validOrgName(""))
+	assert.False(t, validOrgName("   "))
+	assert.False(t, validOrgName(strings.Repeat("a", maxOrgNameLen+1)))
+}
+
+func TestValidOrgID(t *testing.T) {
+	assert.True(t, validOrgID("6fdd89f3-e740-464a-96d5-c94da40a3a12"))
+	assert.True(t, validOrgID("6FDD89F3-E740-464A-96D5-C94DA40A3A12"))
+	assert.False(t, validOrgID(""))
+	assert.False(t, validOrgID("6fdd89f3e740464a96d5c94da40a3a12"))
+	assert.False(t, validOrgID("6fdd89f3-e740-464a-96d5-c94da40a3a1g"))
+}
+
+func TestValidRole(t *testing.T) {
+	assert.True(t, validRole(datatypes.ViewerRole))
+	assert.True(t, validRole(datatypes.EditorRole))
+	assert.True(t, validRole(datatypes.ManagerRole))
+	assert.False(t, validRole(0))
+	assert.False(t, validRole(datatypes.ManagerRole+1))
+}
@@ -0,0 +1,252 @@
+package grpcservices
+
+import (
+	"context"
+	"errors"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/sharetools"
+	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ShareService - service contains methods for the end-to-end encrypted sharing of the vault items.
+// The records encrypted by the server are shared through the collections of the organizations.
+type ShareService struct {
+	srvpb.UnimplementedShareServiceServer
+	Rep        storage.Storage
+	TokenTools *tokentools.JWTTools
+	Logger     *logrus.Logger
+}
+
+// NewShareService - constructor ShareService.
+func NewShareService(r storage.Storage, tt *tokentools.JWTTools, l *logrus.Logger) *ShareService {
+	return &ShareService{Rep: r, TokenTools: tt, Logger: l}
+}
+
+// SetKeyPair - publishes the public key of the current user and saves its encrypted private key.
+func (s *ShareService) SetKeyPair(ctx context.Context, in *srvpb.SetKeyPairReq) (*srvpb.KeyPairResp, error) {
+	resp := &srvpb.KeyPairResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "share_service",
+			"handler": "set_key_pair",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	model := keyPairFromProto(uuid, in.KeyPair)
+	if err := sharetools.ValidateKeyPair(model.Algorithm, model.PublicKey, model.EncryptedPrivateKey); err != nil {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	model.Fingerprint = sharetools.Fingerprint(model.PublicKey)
+
+	if err := s.Rep.UpsertKeyPair(ctx, model); err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(s.Logger, err, "share_service", "set_key_pair", "storage.upsert_key_pair")
+	}
+	resp.KeyPair = keyPairToProto(model)
+	return resp, nil
+}
+
+// GetKeyPair - get the key pair of the current user.
+func (s *ShareService) GetKeyPair(ctx context.Context, in *srvpb.GetKeyPairReq) (*srvpb.KeyPairResp, error) {
+	resp := &srvpb.KeyPairResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "share_service",
+			"handler": "get_key_pair",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	res, err := s.Rep.SelectKeyPair(ctx, uuid)
+	if errors.Is(err, customerror.ErrNoPublicKey) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(s.Logger, err, "share_service", "get_key_pair", "storage.select_key_pair")
+	}
+	resp.KeyPair = keyPairToProto(res)
+	return resp, nil
+}
+
+// GetPublicKey - get the public key of the user to wrap the item key for.
+func (s *ShareService) GetPublicKey(ctx context.Context, in *srvpb.GetPublicKeyReq) (*srvpb.KeyPairResp, error) {
+	resp := &srvpb.KeyPairResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "share_service",
+			"handler": "get_public_key",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	res, err := s.Rep.SelectPublicKey(ctx, in.Login)
+	if errors.Is(err, customerror.ErrNoPublicKey) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(s.Logger, err, "share_service", "get_public_key", "storage.select_public_key")
+	}
+	resp.KeyPair = keyPairToProto(res)
+	return resp, nil
+}
+
+// ShareItem - shares the vault item of the current user with the recipient or changes the share.
+func (s *ShareService) ShareItem(ctx context.Context, in *srvpb.ShareItemReq) (*srvpb.ShareItemResp, error) {
+	resp := &srvpb.ShareItemResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "share_service",
+			"handler": "share_item",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	if err := sharetools.ValidateShare(in.WrappedKey, in.Permission); err != nil {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	model := models.ShareModel{
+		Owner:          uuid,
+		ItemID:         in.ItemId,
+		RecipientLogin: in.RecipientLogin,
+		WrappedKey:     in.WrappedKey,
+		Fingerprint:    in.KeyFingerprint,
+		Permission:     in.Permission,
+	}
+	err := s.Rep.InsertShare(ctx, model)
+	if err != nil {
+		code := shareErrorCode(err)
+		if code == codes.Internal {
+			resp.Error = customerror.ErrInternalServer.Error()
+			return resp, internalError(s.Logger, err, "share_service", "share_item", "storage.insert_share")
+		}
+		resp.Error = err.Error()
+		return resp, status.Error(code, err.Error())
+	}
+	return resp, nil
+}
+
+// ListSharedWithMe - get the vault items shared with the current user with their wrapped keys.
+func (s *ShareService) ListSharedWithMe(ctx context.Context, in *srvpb.ListSharedWithMeReq) (*srvpb.ListSharedWithMeResp, error) {
+	resp := &srvpb.ListSharedWithMeResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "share_service",
+			"handler": "list_shared_with_me",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	res, err := s.Rep.SelectSharedWithMe(ctx, uuid)
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(s.Logger, err, "share_service", "list_shared_with_me", "storage.select_shared_with_me")
+	}
+	resp.Items = make([]*srvpb.SharedItem, 0, len(res))
+	for _, shared := range res {
+		resp.Items = append(resp.Items, sharedItemToProto(shared))
+	}
+	return resp, nil
+}
+
+// RevokeShare - revokes the access of the recipient to the vault item of the current user.
+func (s *ShareService) RevokeShare(ctx context.Context, in *srvpb.RevokeShareReq) (*srvpb.RevokeShareResp, error) {
+	resp := &srvpb.RevokeShareResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "share_service",
+			"handler": "revoke_share",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	err := s.Rep.DeleteShare(ctx, models.ShareModel{Owner: uuid, ItemID: in.ItemId, RecipientLogin: in.RecipientLogin})
+	if errors.Is(err, customerror.ErrShareNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(s.Logger, err, "share_service", "revoke_share", "storage.delete_share")
+	}
+	return resp, nil
+}
+
+// keyPairFromProto - converts the key pair to the storage model.
+func keyPairFromProto(uuid string, keyPair *srvpb.KeyPair) models.KeyPairModel {
+	res := models.KeyPairModel{UUID: uuid}
+	if keyPair == nil {
+		return res
+	}
+	res.Algorithm = keyPair.Algorithm
+	res.PublicKey = keyPair.PublicKey
+	res.EncryptedPrivateKey = keyPair.EncryptedPrivateKey
+	return res
+}
+
+// keyPairToProto - converts the key pair to the message.
+func keyPairToProto(keyPair models.KeyPairModel) *srvpb.KeyPair {
+	return &srvpb.KeyPair{
+		Algorithm:           keyPair.Algorithm,
+		PublicKey:           keyPair.PublicKey,
+		EncryptedPrivateKey: keyPair.EncryptedPrivateKey,
+		Fingerprint:         keyPair.Fingerprint,
+	}
+}
+
+// sharedItemToProto - converts the shared item to the message.
+func sharedItemToProto(shared models.SharedItemModel) *srvpb.SharedItem {
+	return &srvpb.SharedItem{
+		Item:           vaultItemToProto(shared.Item),
+		OwnerLogin:     shared.OwnerLogin,
+		WrappedKey:     shared.WrappedKey,
+		KeyFingerprint: shared.Fingerprint,
+		Permission:     shared.Permission,
+		SharedAt:       timestamppb.New(shared.SharedAt),
+	}
+}
+
+// shareErrorCode - returns the status code of the error of sharing the item. The unknown errors are internal.
+func shareErrorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, customerror.ErrRecordNotFound), errors.Is(err, customerror.ErrNoPublicKey):
+		return codes.NotFound
+	case errors.Is(err, customerror.ErrShareWithSelf):
+		return codes.InvalidArgument
+	case errors.Is(err, customerror.ErrKeyFingerprint):
+		return codes.FailedPrecondition
+	}
+	return codes.Internal
+}
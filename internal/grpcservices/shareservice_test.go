@@ -0,0 +1,47 @@
+package grpcservices
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestKeyPairFromProto(t *testing.T) {
+	res := keyPairFromProto("user", &srvpb.KeyPair{Algorithm: "x25519", PublicKey: []byte("public"),
+		EncryptedPrivateKey: []byte("private"), Fingerprint: "set by the client"})
+	// the fingerprint is computed by the server
+	assert.Equal(t, models.KeyPairModel{UUID: "user", Algorithm: "x25519", PublicKey: []byte("public"), EncryptedPrivateKey: []byte("private")}, res)
+	assert.Equal(t, models.KeyPairModel{UUID: "user"}, keyPairFromProto("user", nil))
+}
+
+func TestSharedItemToProto(t *testing.T) {
+	now := time.Now()
+	res := sharedItemToProto(models.SharedItemModel{
+		Item:        models.VaultItemModel{ID: 3, Blob: []byte("ciphertext"), Revision: 5},
+		OwnerLogin:  "alice",
+		WrappedKey:  []byte("wrapped"),
+		Fingerprint: "abc",
+		Permission:  datatypes.ReadWritePermission,
+		SharedAt:    now,
+	})
+	assert.Equal(t, int32(3), res.Item.Id)
+	assert.Equal(t, "alice", res.OwnerLogin)
+	assert.Equal(t, []byte("wrapped"), res.WrappedKey)
+	assert.Equal(t, datatypes.ReadWritePermission, res.Permission)
+	assert.True(t, res.SharedAt.AsTime().Equal(now))
+}
+
+func TestShareErrorCode(t *testing.T) {
+	assert.Equal(t, codes.NotFound, shareErrorCode(customerror.ErrRecordNotFound))
+	assert.Equal(t, codes.NotFound, shareErrorCode(customerror.ErrNoPublicKey))
+	assert.Equal(t, codes.InvalidArgument, shareErrorCode(customerror.ErrShareWithSelf))
+	assert.Equal(t, codes.FailedPrecondition, shareErrorCode(customerror.ErrKeyFingerprint))
+	assert.Equal(t, codes.Internal, shareErrorCode(errors.New("connection refused")))
+}
@@ -2,9 +2,12 @@ package grpcservices
 
 import (
 	"context"
+	"errors"
 
 	"github.com/BillyBones007/pwdm_server/internal/customerror"
 	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/metadatatools"
 	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
 	pb "github.com/BillyBones007/pwdm_service_api/api"
 	"github.com/sirupsen/logrus"
@@ -25,7 +28,18 @@ func NewShowInfoService(r storage.Storage, tt *tokentools.JWTTools, l *logrus.Lo
 	return &ShowInfoService{Rep: r, TokenTools: tt, Logger: l}
 }
 
+// Number of the recently used records in GetInfo.
+const (
+	DefaultRecentLimit int32 = 10
+	MaxRecentLimit     int32 = 100
+)
+
 // GetInfo - get information for current user.
+// If the metadata contains the folder id, only the records of this folder are returned.
+// If the metadata contains the "recent" key, only the recently read records are returned,
+// the last read goes first. Its value is the number of the records, 10 by default.
+// The brands and masked numbers of the cards and the public keys and fingerprints of the SSH keys
+// are sent in the response headers.
 func (s *ShowInfoService) GetInfo(ctx context.Context, in *pb.Empty) (*pb.ShowItemsResp, error) {
 	resp := &pb.ShowItemsResp{}
 	uuid := ctx.Value(UUIDKey).(string)
@@ -38,8 +52,35 @@ func (s *ShowInfoService) GetInfo(ctx context.Context, in *pb.Empty) (*pb.ShowIt
 		return nil, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
 	}
 
+	folderID, inFolder, err := metadatatools.GetFolderIDFromMetadata(ctx)
+	if err != nil {
+		resp.Error = customerror.ErrInvalidFolderID.Error()
+		return resp, status.Error(codes.InvalidArgument, customerror.ErrInvalidFolderID.Error())
+	}
+
+	recent, onlyRecent, err := metadatatools.GetRecentFromMetadata(ctx)
+	if err != nil || recent < 0 || recent > MaxRecentLimit {
+		resp.Error = customerror.ErrInvalidRecentLimit.Error()
+		return resp, status.Error(codes.InvalidArgument, customerror.ErrInvalidRecentLimit.Error())
+	}
+	if recent == 0 {
+		recent = DefaultRecentLimit
+	}
+
 	// result list from database
-	listResult, err := s.Rep.SelectAllInfoUser(ctx, uuid)
+	var listResult []models.DataRecordModel
+	switch {
+	case onlyRecent:
+		listResult, err = s.Rep.SelectRecentItems(ctx, models.RecentItemsModel{UUID: uuid, Limit: recent})
+	case inFolder:
+		listResult, err = s.Rep.SelectFolderItems(ctx, models.FolderReqModel{UUID: uuid, ID: folderID})
+	default:
+		listResult, err = s.Rep.SelectAllInfoUser(ctx, uuid)
+	}
+	if errors.Is(err, customerror.ErrFolderNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
 	if err != nil {
 		s.Logger.WithFields(logrus.Fields{
 			"service": "show_info_service",
@@ -65,5 +106,31 @@ func (s *ShowInfoService) GetInfo(ctx context.Context, in *pb.Empty) (*pb.ShowIt
 	}
 
 	resp.Items = listItems
+
+	cards, err := selectCardsInfo(ctx, s.Rep, uuid, listResult)
+	if err == nil {
+		err = sendCardsInfo(ctx, cards, listResult)
+	}
+	if err != nil {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "show_info_service",
+			"handler": "get_info",
+			"err":     err,
+			"from":    "send_cards_info",
+		}).Error("Header error")
+	}
+
+	keys, err := s.Rep.SelectSSHKeysInfo(ctx, uuid)
+	if err == nil {
+		err = sendSSHKeysInfo(ctx, keys)
+	}
+	if err != nil {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "show_info_service",
+			"handler": "get_info",
+			"err":     err,
+			"from":    "send_ssh_keys_info",
+		}).Error("Header error")
+	}
 	return resp, nil
 }
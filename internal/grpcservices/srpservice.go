@@ -0,0 +1,231 @@
+package grpcservices
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/srptools"
+	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// How long the started SRP login waits for the proof of the client.
+const srpSessionTTL = time.Minute
+
+// SRPService - service contains methods for the login without sending the password to the server.
+type SRPService struct {
+	srvpb.UnimplementedSRPServiceServer
+	Rep        storage.Storage
+	TokenTools *tokentools.JWTTools
+	Logger     *logrus.Logger
+}
+
+// NewSRPService - constructor SRPService.
+func NewSRPService(r storage.Storage, tt *tokentools.JWTTools, l *logrus.Logger) *SRPService {
+	return &SRPService{Rep: r, TokenTools: tt, Logger: l}
+}
+
+// Register - creates the user with the salt and the verifier of the password.
+func (s *SRPService) Register(ctx context.Context, in *srvpb.SRPRegisterReq) (*srvpb.SRPRegisterResp, error) {
+	resp := &srvpb.SRPRegisterResp{}
+	if strings.TrimSpace(in.Login) == "" {
+		resp.Error = customerror.ErrCreateUser.Error()
+		return resp, status.Error(codes.InvalidArgument, customerror.ErrCreateUser.Error())
+	}
+	if err := srptools.ValidVerifier(in.Salt, in.Verifier); err != nil {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	uuid, err := s.Rep.CreateSRPUser(ctx, models.SRPUserModel{Login: in.Login, Salt: in.Salt, Verifier: in.Verifier})
+	if errors.Is(err, customerror.ErrUserIsExists) {
+		resp.Error = customerror.ErrCreateUser.Error()
+		return resp, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		resp.Error = customerror.ErrCreateUser.Error()
+		return resp, internalError(s.Logger, err, "srp_service", "register", "storage.create_srp_user")
+	}
+
+	token, err := s.createToken(uuid, "register")
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, err
+	}
+	resp.Token = token
+	return resp, nil
+}
+
+// StartLogin - saves the public value of the client and returns the salt of the user and the public value of the server.
+// The login without SRP (unknown or not set up yet) gets the fake salt and the random public value,
+// its login fails on FinishLogin, so the response does not reveal which logins exist.
+func (s *SRPService) StartLogin(ctx context.Context, in *srvpb.SRPStartReq) (*srvpb.SRPStartResp, error) {
+	resp := &srvpb.SRPStartResp{}
+	if err := srptools.ValidPublic(in.ClientPublic); err != nil {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	known := true
+	user, err := s.Rep.SelectSRPUser(ctx, in.Login)
+	if errors.Is(err, customerror.ErrLoginOrPassIncorrect) || errors.Is(err, customerror.ErrSRPNotSetUp) {
+		known = false
+		user, err = s.fakeUser(ctx, in.Login)
+	}
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(s.Logger, err, "srp_service", "start_login", "storage.select_srp_user")
+	}
+
+	secret, err := srptools.NewSecret()
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(s.Logger, err, "srp_service", "start_login", "srp_tools.new_secret")
+	}
+	id, err := newSessionID()
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(s.Logger, err, "srp_service", "start_login", "new_session_id")
+	}
+	// the session of the fake user is not saved, FinishLogin does not find it
+	if known {
+		session := models.SRPSessionModel{ID: id, User: user, ClientPublic: in.ClientPublic, Secret: secret, Expires: time.Now().Add(srpSessionTTL)}
+		if err := s.Rep.InsertSRPSession(ctx, session); err != nil {
+			resp.Error = customerror.ErrInternalServer.Error()
+			return resp, internalError(s.Logger, err, "srp_service", "start_login", "storage.insert_srp_session")
+		}
+	}
+
+	resp.SessionId = id
+	resp.Salt = user.Salt
+	resp.ServerPublic = srptools.ServerPublic(user.Verifier, secret)
+	return resp, nil
+}
+
+// FinishLogin - checks the proof of the client and returns the proof of the server and the token.
+// After the first SRP login the password hash of the user is removed.
+func (s *SRPService) FinishLogin(ctx context.Context, in *srvpb.SRPFinishReq) (*srvpb.SRPFinishResp, error) {
+	resp := &srvpb.SRPFinishResp{}
+	session, err := s.Rep.TakeSRPSession(ctx, in.SessionId)
+	if errors.Is(err, customerror.ErrSRPSessionNotFound) {
+		// the missing session is not told from the wrong password: the sessions of the unknown logins are not saved
+		resp.Error = customerror.ErrLogIn.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrLoginOrPassIncorrect.Error())
+	}
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(s.Logger, err, "srp_service", "finish_login", "storage.take_srp_session")
+	}
+
+	user := session.User
+	proof, err := srptools.ServerProof(user.Login, user.Salt, user.Verifier, session.ClientPublic, session.Secret, in.ClientProof)
+	if err != nil {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "srp_service",
+			"handler": "finish_login",
+			"err":     err,
+			"from":    "srp_tools.server_proof",
+		}).Trace("Login error")
+		resp.Error = customerror.ErrLogIn.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrLoginOrPassIncorrect.Error())
+	}
+
+	if err := s.Rep.DropPassword(ctx, user.UUID); err != nil {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "srp_service",
+			"handler": "finish_login",
+			"err":     err,
+			"from":    "storage.drop_password",
+		}).Error("Storage error")
+	}
+
+	token, err := s.createToken(user.UUID, "finish_login")
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, err
+	}
+	resp.ServerProof = proof
+	resp.Token = token
+	return resp, nil
+}
+
+// fakeUser - returns the user for the login without SRP: the salt is the same for each start of the login,
+// the verifier is random.
+func (s *SRPService) fakeUser(ctx context.Context, login string) (models.SRPUserModel, error) {
+	user := models.SRPUserModel{Login: login}
+	salt, err := s.Rep.FakeSRPSalt(ctx, login)
+	if err != nil {
+		return user, err
+	}
+	verifier, err := srptools.NewSecret()
+	if err != nil {
+		return user, err
+	}
+	user.Salt = salt
+	user.Verifier = verifier
+	return user, nil
+}
+
+// createToken - returns the token of the user for an hour.
+func (s *SRPService) createToken(uuid string, handler string) (string, error) {
+	expAt := time.Now().Add(time.Hour * 1).Unix()
+	token, err := s.TokenTools.CreateToken(expAt, uuid)
+	if err != nil {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "srp_service",
+			"handler": handler,
+			"err":     err,
+			"from":    "token_tools.create_token",
+		}).Error("TokenTools error")
+		return "", status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+	return token, nil
+}
+
+// newSessionID - returns the random id of the SRP login.
+func newSessionID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
+
+// setUpSRP - sets up the SRP login of the user who logged in with the password.
+// The login is not failed if the setup fails, the user is set up on the next login.
+func setUpSRP(ctx context.Context, rep storage.Storage, l *logrus.Logger, uuid string, user models.UserModel) {
+	_, err := rep.SelectSRPUser(ctx, user.Login)
+	if !errors.Is(err, customerror.ErrSRPNotSetUp) {
+		if err != nil {
+			l.WithFields(logrus.Fields{
+				"service": "auth_service",
+				"handler": "enter",
+				"err":     err,
+				"from":    "storage.select_srp_user",
+			}).Error("Storage error")
+		}
+		return
+	}
+	salt, verifier, err := srptools.NewVerifier(user.Login, user.Password)
+	if err == nil {
+		err = rep.SetSRPVerifier(ctx, models.SRPUserModel{UUID: uuid, Salt: salt, Verifier: verifier})
+	}
+	if err != nil {
+		l.WithFields(logrus.Fields{
+			"service": "auth_service",
+			"handler": "enter",
+			"err":     err,
+			"from":    "storage.set_srp_verifier",
+		}).Error("Storage error")
+	}
+}
@@ -0,0 +1,22 @@
+package grpcservices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSessionID(t *testing.T) {
+	id, err := newSessionID()
+	assert.NoError(t, err)
+	assert.Len(t, id, 32)
+	other, err := newSessionID()
+	assert.NoError(t, err)
+	assert.NotEqual(t, id, other)
+}
+
+func TestPublicMethods(t *testing.T) {
+	assert.True(t, publicMethods["/pwdm.AuthService/Enter"])
+	assert.True(t, publicMethods["/pwdm.SRPService/StartLogin"])
+	assert.False(t, publicMethods["/pwdm.VaultService/PutVaultItem"])
+}
@@ -0,0 +1,205 @@
+package grpcservices
+
+import (
+	"context"
+	"errors"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/sshkey"
+	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Response header of GetInfo with the public information about the SSH keys (serialized srvpb.SSHKeysInfo).
+const SSHKeysMD string = "ssh-keys-bin"
+
+// SSHKeyService - service contains methods for the SSH keys.
+type SSHKeyService struct {
+	srvpb.UnimplementedSSHKeyServiceServer
+	Rep        storage.Storage
+	TokenTools *tokentools.JWTTools
+	Logger     *logrus.Logger
+}
+
+// NewSSHKeyService - constructor SSHKeyService.
+func NewSSHKeyService(r storage.Storage, tt *tokentools.JWTTools, l *logrus.Logger) *SSHKeyService {
+	return &SSHKeyService{Rep: r, TokenTools: tt, Logger: l}
+}
+
+// InsSSHKey - send the SSH key to the server.
+func (s *SSHKeyService) InsSSHKey(ctx context.Context, in *srvpb.SSHKeyReq) (*srvpb.SSHKeyResp, error) {
+	resp := &srvpb.SSHKeyResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "ssh_key_service",
+			"handler": "ins_ssh_key",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	model, err := sshKeyFromProto(uuid, in)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := s.Rep.InsertSSHKey(ctx, model)
+	if err != nil {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "ssh_key_service",
+			"handler": "ins_ssh_key",
+			"err":     err,
+			"from":    "storage.insert_ssh_key",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+
+	fillSSHKeyResp(resp, res.ID, model.Data, model.TechData)
+	return resp, nil
+}
+
+// GetSSHKey - get the SSH key from server.
+func (s *SSHKeyService) GetSSHKey(ctx context.Context, in *srvpb.GetSSHKeyReq) (*srvpb.SSHKeyResp, error) {
+	resp := &srvpb.SSHKeyResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "ssh_key_service",
+			"handler": "get_ssh_key",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	res, err := s.Rep.SelectSSHKey(ctx, models.IDModel{UUID: uuid, ID: in.Id})
+	if errors.Is(err, customerror.ErrRecordNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "ssh_key_service",
+			"handler": "get_ssh_key",
+			"err":     err,
+			"from":    "storage.select_ssh_key",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+
+	techData := models.ReqTechDataModel{Title: res.TechData.Title, Tag: res.TechData.Tag, Comment: res.TechData.Comment}
+	fillSSHKeyResp(resp, res.TechData.ID, res.Data, techData)
+	resp.Fields = customFieldsToProto(res.TechData.Fields)
+	touchItem(ctx, s.Rep, s.Logger, models.IDModel{UUID: uuid, ID: in.Id, Type: datatypes.SSHKeyDataType}, "ssh_key_service", "get_ssh_key")
+	return resp, nil
+}
+
+// UpdateSSHKey - update the SSH key on the server.
+func (s *SSHKeyService) UpdateSSHKey(ctx context.Context, in *srvpb.SSHKeyReq) (*srvpb.SSHKeyResp, error) {
+	resp := &srvpb.SSHKeyResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "ssh_key_service",
+			"handler": "update_ssh_key",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	model, err := sshKeyFromProto(uuid, in)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := s.Rep.UpdateSSHKey(ctx, model)
+	if errors.Is(err, customerror.ErrRecordNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, customerror.ErrCollectionRole) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "ssh_key_service",
+			"handler": "update_ssh_key",
+			"err":     err,
+			"from":    "storage.update_ssh_key",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+
+	fillSSHKeyResp(resp, res.ID, model.Data, model.TechData)
+	return resp, nil
+}
+
+// sshKeyFromProto - checks the SSH key of the request and converts it to the storage model
+// with the derived public key, key type and fingerprint.
+func sshKeyFromProto(uuid string, in *srvpb.SSHKeyReq) (models.ReqSSHKeyModel, error) {
+	info, err := sshkey.Parse(in.PrivateKey, in.PublicKey, in.Passphrase)
+	if err != nil {
+		return models.ReqSSHKeyModel{}, err
+	}
+	return models.ReqSSHKeyModel{
+		UUID: uuid,
+		Data: models.SSHKeyModel{
+			ID:          in.Id,
+			PrivateKey:  in.PrivateKey,
+			PublicKey:   info.PublicKey,
+			Passphrase:  in.Passphrase,
+			KeyType:     info.KeyType,
+			Fingerprint: info.Fingerprint,
+		},
+		TechData: models.ReqTechDataModel{Title: in.Title, Tag: in.Tag, Comment: in.Comment, Type: datatypes.SSHKeyDataType},
+	}, nil
+}
+
+// fillSSHKeyResp - fills the response with the SSH key.
+func fillSSHKeyResp(resp *srvpb.SSHKeyResp, id int32, key models.SSHKeyModel, techData models.ReqTechDataModel) {
+	resp.Id = id
+	resp.Title = techData.Title
+	resp.PrivateKey = key.PrivateKey
+	resp.PublicKey = key.PublicKey
+	resp.Passphrase = key.Passphrase
+	resp.KeyType = key.KeyType
+	resp.Fingerprint = key.Fingerprint
+	resp.Tag = techData.Tag
+	resp.Comment = techData.Comment
+}
+
+// sendSSHKeysInfo - sends the public information about the SSH keys in the response header.
+func sendSSHKeysInfo(ctx context.Context, keys []models.SSHKeyInfoModel) error {
+	info := &srvpb.SSHKeysInfo{Keys: make([]*srvpb.SSHKeysInfo_KeyModel, 0, len(keys))}
+	for _, key := range keys {
+		info.Keys = append(info.Keys, &srvpb.SSHKeysInfo_KeyModel{
+			Id:          key.ID,
+			KeyType:     key.KeyType,
+			PublicKey:   key.PublicKey,
+			Fingerprint: key.Fingerprint,
+		})
+	}
+	data, err := proto.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return grpc.SetHeader(ctx, metadata.Pairs(SSHKeysMD, string(data)))
+}
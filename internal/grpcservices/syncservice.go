@@ -0,0 +1,88 @@
+package grpcservices
+
+import (
+	"context"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Page size limits for the sync service.
+const (
+	DefaultSyncLimit int32 = 100
+	MaxSyncLimit     int32 = 1000
+)
+
+// SyncService - service contains methods for the delta synchronization of user data.
+type SyncService struct {
+	srvpb.UnimplementedSyncServiceServer
+	Rep        storage.Storage
+	TokenTools *tokentools.JWTTools
+	Logger     *logrus.Logger
+}
+
+// NewSyncService - constructor SyncService.
+func NewSyncService(r storage.Storage, tt *tokentools.JWTTools, l *logrus.Logger) *SyncService {
+	return &SyncService{Rep: r, TokenTools: tt, Logger: l}
+}
+
+// Sync - get the changes of the current user since the cursor, including deleted records.
+func (s *SyncService) Sync(ctx context.Context, in *srvpb.SyncReq) (*srvpb.SyncResp, error) {
+	resp := &srvpb.SyncResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "sync_service",
+			"handler": "sync",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	limit := in.Limit
+	if limit <= 0 {
+		limit = DefaultSyncLimit
+	}
+	if limit > MaxSyncLimit {
+		limit = MaxSyncLimit
+	}
+
+	modelSync := models.SyncReqModel{UUID: uuid, Cursor: in.Cursor, Limit: limit}
+	res, err := s.Rep.SelectChanges(ctx, modelSync)
+	if err != nil {
+		s.Logger.WithFields(logrus.Fields{
+			"service": "sync_service",
+			"handler": "sync",
+			"err":     err,
+			"from":    "storage.select_changes",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+
+	listChanges := make([]*srvpb.SyncResp_ChangeModel, 0, len(res.Changes))
+	for _, change := range res.Changes {
+		item := &srvpb.SyncResp_ChangeModel{
+			Seq:     change.Seq,
+			Id:      change.ID,
+			Type:    change.Type,
+			Deleted: change.Deleted,
+			Title:   change.Title,
+			Tag:     change.Tag,
+			Comment: change.Comment,
+		}
+		listChanges = append(listChanges, item)
+	}
+
+	resp.Changes = listChanges
+	resp.Cursor = res.Cursor
+	resp.HasMore = res.HasMore
+	return resp, nil
+}
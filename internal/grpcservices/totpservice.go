@@ -0,0 +1,137 @@
+package grpcservices
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
+	"github.com/BillyBones007/pwdm_server/internal/tools/totp"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TOTPService - service contains methods for the TOTP secrets of the login/password records.
+type TOTPService struct {
+	srvpb.UnimplementedTOTPServiceServer
+	Rep        storage.Storage
+	TokenTools *tokentools.JWTTools
+	Logger     *logrus.Logger
+}
+
+// NewTOTPService - constructor TOTPService.
+func NewTOTPService(r storage.Storage, tt *tokentools.JWTTools, l *logrus.Logger) *TOTPService {
+	return &TOTPService{Rep: r, TokenTools: tt, Logger: l}
+}
+
+// SetTOTP - set or remove the TOTP secret of the login/password record.
+// Returns the current code of the new secret.
+func (t *TOTPService) SetTOTP(ctx context.Context, in *srvpb.SetTOTPReq) (*srvpb.TOTPResp, error) {
+	resp := &srvpb.TOTPResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		t.Logger.WithFields(logrus.Fields{
+			"service": "totp_service",
+			"handler": "set_totp",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	uri := strings.TrimSpace(in.Uri)
+	var key totp.Key
+	if uri != "" {
+		var err error
+		if key, err = totp.Parse(uri); err != nil {
+			resp.Error = err.Error()
+			return resp, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	err := t.Rep.SetTOTP(ctx, models.TOTPModel{UUID: uuid, ID: in.Id, URI: uri})
+	if errors.Is(err, customerror.ErrRecordNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, customerror.ErrCollectionRole) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		t.Logger.WithFields(logrus.Fields{
+			"service": "totp_service",
+			"handler": "set_totp",
+			"err":     err,
+			"from":    "storage.set_totp",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+
+	if uri != "" {
+		fillTOTPResp(resp, key, time.Now())
+	}
+	return resp, nil
+}
+
+// GetTOTPCode - get the current TOTP code of the login/password record and the time it remains valid.
+func (t *TOTPService) GetTOTPCode(ctx context.Context, in *srvpb.GetTOTPCodeReq) (*srvpb.TOTPResp, error) {
+	resp := &srvpb.TOTPResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		t.Logger.WithFields(logrus.Fields{
+			"service": "totp_service",
+			"handler": "get_totp_code",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	uri, err := t.Rep.SelectTOTP(ctx, models.IDModel{UUID: uuid, ID: in.Id})
+	if errors.Is(err, customerror.ErrRecordNotFound) || errors.Is(err, customerror.ErrTOTPNotSet) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		t.Logger.WithFields(logrus.Fields{
+			"service": "totp_service",
+			"handler": "get_totp_code",
+			"err":     err,
+			"from":    "storage.select_totp",
+		}).Error("Storage error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+
+	key, err := totp.Parse(uri)
+	if err != nil {
+		t.Logger.WithFields(logrus.Fields{
+			"service": "totp_service",
+			"handler": "get_totp_code",
+			"err":     err,
+			"from":    "totp.parse",
+		}).Error("Decode error")
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+	fillTOTPResp(resp, key, time.Now())
+	return resp, nil
+}
+
+// fillTOTPResp - fills the response with the code of the key for the time.
+func fillTOTPResp(resp *srvpb.TOTPResp, key totp.Key, now time.Time) {
+	code, remaining := totp.Code(key, now)
+	resp.Code = code
+	resp.Remaining = int32(remaining / time.Second)
+	resp.Period = int32(key.Period)
+	resp.Issuer = key.Issuer
+	resp.Account = key.Account
+}
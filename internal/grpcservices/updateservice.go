@@ -2,7 +2,7 @@ package grpcservices
 
 import (
 	"context"
-	"encoding/hex"
+	"errors"
 
 	"github.com/BillyBones007/pwdm_server/internal/customerror"
 	"github.com/BillyBones007/pwdm_server/internal/storage"
@@ -46,6 +46,14 @@ func (u *UpdateService) UpdateLogPwd(ctx context.Context, in *pb.UpdateLoginPass
 	modelUpdLogPwd := models.ReqLogPwdModel{UUID: uuid, Data: modelLogPwd, TechData: modelTechData}
 
 	res, err := u.Rep.UpdateLogPwdPair(ctx, modelUpdLogPwd)
+	if errors.Is(err, customerror.ErrRecordNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, customerror.ErrCollectionRole) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.PermissionDenied, err.Error())
+	}
 	if err != nil {
 		u.Logger.WithFields(logrus.Fields{
 			"service": "update_service",
@@ -78,10 +86,22 @@ func (u *UpdateService) UpdateCard(ctx context.Context, in *pb.UpdateCardReq) (*
 	}
 
 	modelTechData := models.ReqTechDataModel{Title: in.Title, Tag: in.Tag, Comment: in.Comment, Type: in.Type}
-	modelCard := models.CardModel{ID: in.Id, Num: in.Num, Date: in.Date, CVC: in.Cvc, FirstName: in.FirstName, LastName: in.LastName}
+	modelCard, st := checkCard(models.CardModel{ID: in.Id, Num: in.Num, Date: in.Date, CVC: in.Cvc, FirstName: in.FirstName, LastName: in.LastName})
+	if st != nil {
+		resp.Error = st.Message()
+		return resp, st.Err()
+	}
 	modelUpdCard := models.ReqCardModel{UUID: uuid, Data: modelCard, TechData: modelTechData}
 
 	res, err := u.Rep.UpdateCardData(ctx, modelUpdCard)
+	if errors.Is(err, customerror.ErrRecordNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, customerror.ErrCollectionRole) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.PermissionDenied, err.Error())
+	}
 	if err != nil {
 		u.Logger.WithFields(logrus.Fields{
 			"service": "update_service",
@@ -117,6 +137,14 @@ func (u *UpdateService) UpdateText(ctx context.Context, in *pb.UpdateTextReq) (*
 	modelUpdText := models.ReqTextModel{UUID: uuid, Data: modelText, TechData: modelTechData}
 
 	res, err := u.Rep.UpdateTextData(ctx, modelUpdText)
+	if errors.Is(err, customerror.ErrRecordNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, customerror.ErrCollectionRole) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.PermissionDenied, err.Error())
+	}
 	if err != nil {
 		u.Logger.WithFields(logrus.Fields{
 			"service": "update_service",
@@ -148,11 +176,22 @@ func (u *UpdateService) UpdateBinary(ctx context.Context, in *pb.UpdateBinaryReq
 	}
 
 	modelTechData := models.ReqTechDataModel{Title: in.Title, Tag: in.Tag, Comment: in.Comment, Type: in.Type}
-	strData := hex.EncodeToString(in.Data)
-	modelBinary := models.BinaryDataModel{ID: in.Id, Data: strData}
+	modelBinary := models.BinaryDataModel{ID: in.Id, Data: in.Data}
 	modelUpdBinary := models.ReqBinaryModel{UUID: uuid, Data: modelBinary, TechData: modelTechData}
 
 	res, err := u.Rep.UpdateBinaryData(ctx, modelUpdBinary)
+	if errors.Is(err, customerror.ErrQuotaExceeded) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if errors.Is(err, customerror.ErrRecordNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, customerror.ErrCollectionRole) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.PermissionDenied, err.Error())
+	}
 	if err != nil {
 		u.Logger.WithFields(logrus.Fields{
 			"service": "update_service",
@@ -0,0 +1,67 @@
+package grpcservices
+
+import (
+	"context"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UsageService - service contains methods for the storage usage.
+type UsageService struct {
+	srvpb.UnimplementedUsageServiceServer
+	Rep        storage.Storage
+	TokenTools *tokentools.JWTTools
+	Logger     *logrus.Logger
+}
+
+// NewUsageService - constructor UsageService.
+func NewUsageService(r storage.Storage, tt *tokentools.JWTTools, l *logrus.Logger) *UsageService {
+	return &UsageService{Rep: r, TokenTools: tt, Logger: l}
+}
+
+// GetUsage - get the logical and the stored size of the data of the current user.
+func (u *UsageService) GetUsage(ctx context.Context, in *srvpb.UsageReq) (*srvpb.UsageResp, error) {
+	resp := &srvpb.UsageResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		u.Logger.WithFields(logrus.Fields{
+			"service": "usage_service",
+			"handler": "get_usage",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	res, err := u.Rep.SelectUsage(ctx, uuid)
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(u.Logger, err, "usage_service", "get_usage", "storage.select_usage")
+	}
+	return usageToProto(res), nil
+}
+
+// usageToProto - converts the storage usage to the message and counts the total.
+func usageToProto(usage models.UsageModel) *srvpb.UsageResp {
+	size := func(s models.SizeModel) *srvpb.SizeModel {
+		return &srvpb.SizeModel{Logical: s.Logical, Stored: s.Stored}
+	}
+	total := models.SizeModel{
+		Logical: usage.Text.Logical + usage.Binary.Logical + usage.Attachments.Logical,
+		Stored:  usage.Text.Stored + usage.Binary.Stored + usage.Attachments.Stored,
+	}
+	return &srvpb.UsageResp{
+		Text:        size(usage.Text),
+		Binary:      size(usage.Binary),
+		Attachments: size(usage.Attachments),
+		Total:       size(total),
+		Quota:       usage.Quota,
+	}
+}
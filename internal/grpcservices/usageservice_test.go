@@ -0,0 +1,21 @@
+package grpcservices
+
+import (
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsageToProto(t *testing.T) {
+	res := usageToProto(models.UsageModel{
+		Text:        models.SizeModel{Logical: 100, Stored: 40},
+		Binary:      models.SizeModel{Logical: 1000, Stored: 1000},
+		Attachments: models.SizeModel{Logical: 10, Stored: 11},
+		Quota:       5000,
+	})
+	assert.Equal(t, int64(40), res.Text.Stored)
+	assert.Equal(t, int64(1110), res.Total.Logical)
+	assert.Equal(t, int64(1051), res.Total.Stored)
+	assert.Equal(t, int64(5000), res.Quota)
+}
@@ -0,0 +1,75 @@
+package grpcservices
+
+import (
+	"context"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	pb "github.com/BillyBones007/pwdm_service_api/api"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Methods that send the plain secrets to the server. They are rejected for the users in the vault mode.
+var plainSecretMethods = map[string]bool{
+	pb.GiveTakeService_InsLogPwd_FullMethodName:         true,
+	pb.GiveTakeService_InsCard_FullMethodName:           true,
+	pb.GiveTakeService_InsText_FullMethodName:           true,
+	pb.GiveTakeService_InsBinary_FullMethodName:         true,
+	pb.UpdateService_UpdateLogPwd_FullMethodName:        true,
+	pb.UpdateService_UpdateCard_FullMethodName:          true,
+	pb.UpdateService_UpdateText_FullMethodName:          true,
+	pb.UpdateService_UpdateBinary_FullMethodName:        true,
+	srvpb.BatchService_Batch_FullMethodName:             true,
+	srvpb.ItemsService_SetCustomFields_FullMethodName:   true,
+	srvpb.TOTPService_SetTOTP_FullMethodName:            true,
+	srvpb.SSHKeyService_InsSSHKey_FullMethodName:        true,
+	srvpb.SSHKeyService_UpdateSSHKey_FullMethodName:     true,
+	srvpb.IdentityService_InsIdentity_FullMethodName:    true,
+	srvpb.IdentityService_UpdateIdentity_FullMethodName: true,
+	srvpb.BinaryService_UploadBinary_FullMethodName:     true,
+	srvpb.AttachmentService_Attach_FullMethodName:       true,
+}
+
+// VaultInterceptor - middleware that keeps the plain secrets of the users in the vault mode off the server.
+// The existing records of the user stay readable, so the client moves them to the vault.
+// Must be called after AuthInterceptor.
+func (i *InterceptorsService) VaultInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := i.checkVaultMode(ctx, info.FullMethod, "vault_interceptor"); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// VaultStreamInterceptor - middleware that rejects the uploads of the plain data of the users in the vault mode.
+// Must be called after AuthStreamInterceptor.
+func (i *InterceptorsService) VaultStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := i.checkVaultMode(ss.Context(), info.FullMethod, "vault_stream_interceptor"); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// checkVaultMode - returns the error if the method sends the plain secrets and the user is in the vault mode.
+func (i *InterceptorsService) checkVaultMode(ctx context.Context, method string, handler string) error {
+	if !plainSecretMethods[method] {
+		return nil
+	}
+	uuid, _ := ctx.Value(UUIDKey).(string)
+	vault, err := i.rep.IsVaultAccount(ctx, uuid)
+	if err != nil {
+		i.Logger.WithFields(logrus.Fields{
+			"service": "interceptors_service",
+			"handler": handler,
+			"err":     err,
+			"from":    "storage.is_vault_account",
+		}).Error("Storage error")
+		return status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+	}
+	if vault {
+		return status.Error(codes.FailedPrecondition, customerror.ErrVaultAccount.Error())
+	}
+	return nil
+}
@@ -0,0 +1,279 @@
+package grpcservices
+
+import (
+	"context"
+	"errors"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/tokentools"
+	"github.com/BillyBones007/pwdm_server/internal/tools/vaulttools"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Page size limits of the vault items. The items are larger than the changes of the sync service.
+const (
+	DefaultVaultLimit int32 = 50
+	MaxVaultLimit     int32 = 100
+)
+
+// VaultService - service contains methods for the zero-knowledge vault.
+// The server stores the items encrypted by the client and never sees the vault key.
+type VaultService struct {
+	srvpb.UnimplementedVaultServiceServer
+	Rep        storage.Storage
+	TokenTools *tokentools.JWTTools
+	Logger     *logrus.Logger
+}
+
+// NewVaultService - constructor VaultService.
+func NewVaultService(r storage.Storage, tt *tokentools.JWTTools, l *logrus.Logger) *VaultService {
+	return &VaultService{Rep: r, TokenTools: tt, Logger: l}
+}
+
+// SetupVault - switches the current user to the vault mode with the key derivation parameters.
+func (v *VaultService) SetupVault(ctx context.Context, in *srvpb.SetupVaultReq) (*srvpb.SetupVaultResp, error) {
+	resp := &srvpb.SetupVaultResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		v.Logger.WithFields(logrus.Fields{
+			"service": "vault_service",
+			"handler": "setup_vault",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	params := vaultParamsFromProto(uuid, in.Params)
+	if err := vaulttools.ValidateParams(params); err != nil {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err := v.Rep.InsertVaultParams(ctx, params)
+	if errors.Is(err, customerror.ErrVaultExists) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(v.Logger, err, "vault_service", "setup_vault", "storage.insert_vault_params")
+	}
+	return resp, nil
+}
+
+// GetVaultParams - get the key derivation parameters of the current user.
+func (v *VaultService) GetVaultParams(ctx context.Context, in *srvpb.GetVaultParamsReq) (*srvpb.GetVaultParamsResp, error) {
+	resp := &srvpb.GetVaultParamsResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		v.Logger.WithFields(logrus.Fields{
+			"service": "vault_service",
+			"handler": "get_vault_params",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	res, err := v.Rep.SelectVaultParams(ctx, uuid)
+	if errors.Is(err, customerror.ErrVaultNotSetUp) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(v.Logger, err, "vault_service", "get_vault_params", "storage.select_vault_params")
+	}
+	resp.Params = &srvpb.VaultParams{
+		Kdf:         res.KDF,
+		Salt:        res.Salt,
+		Iterations:  res.Iterations,
+		Memory:      res.Memory,
+		Parallelism: res.Parallelism,
+	}
+	return resp, nil
+}
+
+// PutVaultItem - saves the new item or replaces the item not changed since the revision known to the client.
+// The item shared with the user is replaced only with the read-write permission.
+func (v *VaultService) PutVaultItem(ctx context.Context, in *srvpb.PutVaultItemReq) (*srvpb.PutVaultItemResp, error) {
+	resp := &srvpb.PutVaultItemResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		v.Logger.WithFields(logrus.Fields{
+			"service": "vault_service",
+			"handler": "put_vault_item",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	item := models.VaultItemModel{UUID: uuid, ID: in.Id, Blob: in.Blob, Metadata: in.Metadata, Revision: in.Revision}
+	if err := vaulttools.ValidateItem(item); err != nil {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := v.Rep.PutVaultItem(ctx, item)
+	if err != nil {
+		code := vaultErrorCode(err)
+		if code == codes.Internal {
+			resp.Error = customerror.ErrInternalServer.Error()
+			return resp, internalError(v.Logger, err, "vault_service", "put_vault_item", "storage.put_vault_item")
+		}
+		resp.Error = err.Error()
+		return resp, status.Error(code, err.Error())
+	}
+	resp.Id = res.ID
+	resp.Revision = res.Revision
+	return resp, nil
+}
+
+// GetVaultItem - get the item of the current user or shared with the user.
+func (v *VaultService) GetVaultItem(ctx context.Context, in *srvpb.GetVaultItemReq) (*srvpb.GetVaultItemResp, error) {
+	resp := &srvpb.GetVaultItemResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		v.Logger.WithFields(logrus.Fields{
+			"service": "vault_service",
+			"handler": "get_vault_item",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	res, err := v.Rep.SelectVaultItem(ctx, models.IDModel{UUID: uuid, ID: in.Id})
+	if errors.Is(err, customerror.ErrRecordNotFound) {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(v.Logger, err, "vault_service", "get_vault_item", "storage.select_vault_item")
+	}
+	resp.Item = vaultItemToProto(res)
+	return resp, nil
+}
+
+// DeleteVaultItem - deletes the item if it was not changed since the revision known to the client.
+func (v *VaultService) DeleteVaultItem(ctx context.Context, in *srvpb.DeleteVaultItemReq) (*srvpb.DeleteVaultItemResp, error) {
+	resp := &srvpb.DeleteVaultItemResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		v.Logger.WithFields(logrus.Fields{
+			"service": "vault_service",
+			"handler": "delete_vault_item",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	revision, err := v.Rep.DeleteVaultItem(ctx, models.VaultItemModel{UUID: uuid, ID: in.Id, Revision: in.Revision})
+	if err != nil {
+		code := vaultErrorCode(err)
+		if code == codes.Internal {
+			resp.Error = customerror.ErrInternalServer.Error()
+			return resp, internalError(v.Logger, err, "vault_service", "delete_vault_item", "storage.delete_vault_item")
+		}
+		resp.Error = err.Error()
+		return resp, status.Error(code, err.Error())
+	}
+	resp.Revision = revision
+	return resp, nil
+}
+
+// ListVaultItems - get the items changed since the revision known to the client, including the deleted items.
+// With the metadata to match only the not deleted items with this metadata are returned.
+func (v *VaultService) ListVaultItems(ctx context.Context, in *srvpb.ListVaultItemsReq) (*srvpb.ListVaultItemsResp, error) {
+	resp := &srvpb.ListVaultItemsResp{}
+	uuid := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		v.Logger.WithFields(logrus.Fields{
+			"service": "vault_service",
+			"handler": "list_vault_items",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		resp.Error = customerror.ErrMissingToken.Error()
+		return resp, status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	if err := vaulttools.ValidateMetadata(in.Match); err != nil {
+		resp.Error = err.Error()
+		return resp, status.Error(codes.InvalidArgument, err.Error())
+	}
+	limit := in.Limit
+	if limit <= 0 {
+		limit = DefaultVaultLimit
+	}
+	if limit > MaxVaultLimit {
+		limit = MaxVaultLimit
+	}
+
+	modelList := models.VaultListReqModel{UUID: uuid, Since: in.Since, Limit: limit, Match: in.Match}
+	res, err := v.Rep.SelectVaultItems(ctx, modelList)
+	if err != nil {
+		resp.Error = customerror.ErrInternalServer.Error()
+		return resp, internalError(v.Logger, err, "vault_service", "list_vault_items", "storage.select_vault_items")
+	}
+
+	resp.Items = make([]*srvpb.VaultItem, 0, len(res.Items))
+	for _, item := range res.Items {
+		resp.Items = append(resp.Items, vaultItemToProto(item))
+	}
+	resp.Revision = res.Revision
+	resp.HasMore = res.HasMore
+	return resp, nil
+}
+
+// vaultParamsFromProto - converts the key derivation parameters to the storage model.
+func vaultParamsFromProto(uuid string, params *srvpb.VaultParams) models.VaultParamsModel {
+	res := models.VaultParamsModel{UUID: uuid}
+	if params == nil {
+		return res
+	}
+	res.KDF = params.Kdf
+	res.Salt = params.Salt
+	res.Iterations = params.Iterations
+	res.Memory = params.Memory
+	res.Parallelism = params.Parallelism
+	return res
+}
+
+// vaultItemToProto - converts the vault item to the message.
+func vaultItemToProto(item models.VaultItemModel) *srvpb.VaultItem {
+	return &srvpb.VaultItem{
+		Id:        item.ID,
+		Blob:      item.Blob,
+		Metadata:  item.Metadata,
+		Revision:  item.Revision,
+		Deleted:   item.Deleted,
+		CreatedAt: timestamppb.New(item.CreatedAt),
+		UpdatedAt: timestamppb.New(item.UpdatedAt),
+	}
+}
+
+// vaultErrorCode - returns the status code of the error of changing the vault item.
+// The unknown errors are internal.
+func vaultErrorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, customerror.ErrRecordNotFound):
+		return codes.NotFound
+	case errors.Is(err, customerror.ErrVaultConflict):
+		return codes.Aborted
+	case errors.Is(err, customerror.ErrVaultNotSetUp):
+		return codes.FailedPrecondition
+	case errors.Is(err, customerror.ErrReadOnlyShare):
+		return codes.PermissionDenied
+	}
+	return codes.Internal
+}
@@ -0,0 +1,42 @@
+package grpcservices
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func TestVaultParamsFromProto(t *testing.T) {
+	res := vaultParamsFromProto("user", &srvpb.VaultParams{Kdf: "argon2id", Salt: []byte("salt"), Iterations: 3, Memory: 65536, Parallelism: 4})
+	assert.Equal(t, models.VaultParamsModel{UUID: "user", KDF: "argon2id", Salt: []byte("salt"), Iterations: 3, Memory: 65536, Parallelism: 4}, res)
+
+	// the missing parameters are checked as empty
+	assert.Equal(t, models.VaultParamsModel{UUID: "user"}, vaultParamsFromProto("user", nil))
+}
+
+func TestVaultItemToProto(t *testing.T) {
+	now := time.Now()
+	res := vaultItemToProto(models.VaultItemModel{ID: 7, Blob: []byte("ciphertext"), Metadata: map[string]string{"kind": "login"},
+		Revision: 12, CreatedAt: now, UpdatedAt: now})
+	assert.Equal(t, int32(7), res.Id)
+	assert.Equal(t, []byte("ciphertext"), res.Blob)
+	assert.Equal(t, "login", res.Metadata["kind"])
+	assert.Equal(t, int64(12), res.Revision)
+	assert.False(t, res.Deleted)
+	assert.True(t, res.UpdatedAt.AsTime().Equal(now))
+}
+
+func TestVaultErrorCode(t *testing.T) {
+	assert.Equal(t, codes.NotFound, vaultErrorCode(customerror.ErrRecordNotFound))
+	assert.Equal(t, codes.Aborted, vaultErrorCode(fmt.Errorf("put: %w", customerror.ErrVaultConflict)))
+	assert.Equal(t, codes.FailedPrecondition, vaultErrorCode(customerror.ErrVaultNotSetUp))
+	assert.Equal(t, codes.PermissionDenied, vaultErrorCode(customerror.ErrReadOnlyShare))
+	assert.Equal(t, codes.Internal, vaultErrorCode(errors.New("connection refused")))
+}
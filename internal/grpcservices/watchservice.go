@@ -0,0 +1,100 @@
+package grpcservices
+
+import (
+	srvpb "github.com/BillyBones007/pwdm_server/api"
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/watcher"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WatchService - service contains methods for receiving changes of user data in real time.
+type WatchService struct {
+	srvpb.UnimplementedWatchServiceServer
+	Rep    storage.Storage
+	Hub    *watcher.Hub
+	Logger *logrus.Logger
+}
+
+// NewWatchService - constructor WatchService.
+func NewWatchService(r storage.Storage, h *watcher.Hub, l *logrus.Logger) *WatchService {
+	return &WatchService{Rep: r, Hub: h, Logger: l}
+}
+
+// Watch - sends the changes of the current user since the cursor and then
+// sends new changes as they happen, until the client closes the stream.
+func (w *WatchService) Watch(in *srvpb.WatchReq, stream srvpb.WatchService_WatchServer) error {
+	ctx := stream.Context()
+	uuid, _ := ctx.Value(UUIDKey).(string)
+	if uuid == "" {
+		w.Logger.WithFields(logrus.Fields{
+			"service": "watch_service",
+			"handler": "watch",
+			"err":     customerror.ErrMissingToken.Error(),
+		}).Trace("Token error")
+		return status.Error(codes.Unauthenticated, customerror.ErrMissingToken.Error())
+	}
+
+	// subscribe before reading the changes so as not to miss the notifications between them
+	signal, unsubscribe := w.Hub.Subscribe(uuid)
+	defer unsubscribe()
+
+	cursor := in.Cursor
+	for {
+		for {
+			res, err := w.Rep.SelectChanges(ctx, models.SyncReqModel{UUID: uuid, Cursor: cursor, Limit: MaxSyncLimit})
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil
+				}
+				w.Logger.WithFields(logrus.Fields{
+					"service": "watch_service",
+					"handler": "watch",
+					"err":     err,
+					"from":    "storage.select_changes",
+				}).Error("Storage error")
+				return status.Error(codes.Internal, customerror.ErrInternalServer.Error())
+			}
+			for _, change := range res.Changes {
+				if err := stream.Send(watchEvent(change)); err != nil {
+					return err
+				}
+			}
+			cursor = res.Cursor
+			if !res.HasMore {
+				break
+			}
+		}
+
+		select {
+		case <-signal:
+		case <-ctx.Done():
+			return nil
+		case <-w.Hub.Done():
+			return status.Error(codes.Unavailable, customerror.ErrServerStopped.Error())
+		}
+	}
+}
+
+// watchEvent - converts the change model to the watch event.
+func watchEvent(change models.ChangeModel) *srvpb.WatchEvent {
+	event := &srvpb.WatchEvent{
+		Event:   srvpb.WatchEvent_UPDATED,
+		Seq:     change.Seq,
+		Id:      change.ID,
+		Type:    change.Type,
+		Title:   change.Title,
+		Tag:     change.Tag,
+		Comment: change.Comment,
+	}
+	switch {
+	case change.Deleted:
+		event.Event = srvpb.WatchEvent_DELETED
+	case change.Created:
+		event.Event = srvpb.WatchEvent_CREATED
+	}
+	return event
+}
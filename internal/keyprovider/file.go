@@ -0,0 +1,180 @@
+package keyprovider
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+)
+
+// FileProvider - provider of the master keys from the file, the format of the file is described in ParseKeys.
+// The file is read again by Reload, when the file is changed before the data key is wrapped
+// and when the data key is wrapped with the unknown version, so the version added by RotateKeyFile
+// is used without restarting the server and the new data keys are not wrapped with the retired version.
+type FileProvider struct {
+	Path string
+
+	mu     sync.RWMutex
+	static *StaticProvider
+	info   fs.FileInfo // the file read last
+}
+
+// NewFileProvider - constructor FileProvider. The missing file is created with the new random key of version 1.
+func NewFileProvider(path string) (*FileProvider, error) {
+	p := &FileProvider{Path: path}
+	if err := p.Reload(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Reload - reads the master keys from the file again.
+func (p *FileProvider) Reload() error {
+	info, err := os.Stat(p.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		if _, err = createKeyFile(p.Path); err == nil {
+			info, err = os.Stat(p.Path)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	// the file changed after the check is read again by the next check
+	data, err := os.ReadFile(p.Path)
+	if err != nil {
+		return err
+	}
+	static, err := NewEnvProvider(string(data))
+	if err != nil {
+		return err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.static = static
+	p.info = info
+	return nil
+}
+
+// (p *FileProvider) reloadChanged - reads the master keys again if the file was replaced or changed since the last read.
+func (p *FileProvider) reloadChanged() error {
+	info, err := os.Stat(p.Path)
+	if err != nil {
+		return err
+	}
+	p.mu.RLock()
+	last := p.info
+	p.mu.RUnlock()
+	if os.SameFile(last, info) && last.ModTime().Equal(info.ModTime()) && last.Size() == info.Size() {
+		return nil
+	}
+	return p.Reload()
+}
+
+// provider - returns the keys read from the file last.
+func (p *FileProvider) provider() *StaticProvider {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.static
+}
+
+// CurrentVersion - returns the highest version of the master keys.
+func (p *FileProvider) CurrentVersion() int32 {
+	return p.provider().CurrentVersion()
+}
+
+// Wrap - encrypts the data key with the current master key. The file changed by the other server
+// is read first, so the data key is wrapped with the version rotated there.
+func (p *FileProvider) Wrap(ctx context.Context, key []byte, aad []byte) (int32, []byte, error) {
+	if err := p.reloadChanged(); err != nil {
+		return 0, nil, err
+	}
+	return p.provider().Wrap(ctx, key, aad)
+}
+
+// Unwrap - decrypts the data key wrapped with the version of the master key.
+// The unknown version may be added to the file by the other server, so the file is read again.
+func (p *FileProvider) Unwrap(ctx context.Context, version int32, wrapped []byte, aad []byte) ([]byte, error) {
+	key, err := p.provider().Unwrap(ctx, version, wrapped, aad)
+	if !errors.Is(err, customerror.ErrUnknownKeyVersion) {
+		return key, err
+	}
+	if err := p.Reload(); err != nil {
+		return nil, err
+	}
+	return p.provider().Unwrap(ctx, version, wrapped, aad)
+}
+
+// RotateKeyFile - adds the new random key with the next version to the key file. Returns the new version.
+func RotateKeyFile(path string) (int32, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	text, version, err := AddKey(string(data))
+	if err != nil {
+		return 0, err
+	}
+	return version, writeKeyFile(path, []byte(text))
+}
+
+// RetireKeyFile - removes the version of the master key from the key file.
+func RetireKeyFile(path string, version int32) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	text, err := RemoveKey(string(data), version)
+	if err != nil {
+		return err
+	}
+	return writeKeyFile(path, []byte(text))
+}
+
+// createKeyFile - creates the key file with the new random key of version 1, the file is readable by the owner only.
+func createKeyFile(path string) ([]byte, error) {
+	line, err := newKeyLine(1)
+	if err != nil {
+		return nil, err
+	}
+	data := []byte(line + "\n")
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return data, f.Close()
+}
+
+// writeKeyFile - replaces the key file with the temporary file, so the key file is never partial.
+func writeKeyFile(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	// does nothing if the file is renamed
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,219 @@
+// Keyprovider package keeps the master keys that wrap the data keys of the users.
+// The master keys have versions: the new data key is wrapped with the current version
+// and is unwrapped with the version it was wrapped with.
+package keyprovider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/tools/sealtools"
+)
+
+// KeyProvider - provider of the master keys.
+type KeyProvider interface {
+	// CurrentVersion - returns the version of the master key that wraps the new data keys.
+	CurrentVersion() int32
+	// Wrap - encrypts the data key with the current master key, the additional data is authenticated.
+	// Returns the version of the master key and the wrapped data key.
+	Wrap(ctx context.Context, key []byte, aad []byte) (int32, []byte, error)
+	// Unwrap - decrypts the data key wrapped with the version of the master key.
+	Unwrap(ctx context.Context, version int32, wrapped []byte, aad []byte) ([]byte, error)
+}
+
+// Reloader - provider that reads the master keys again, so the new version is used without restarting the server.
+type Reloader interface {
+	Reload() error
+}
+
+// StaticProvider - provider of the master keys kept in memory, the highest version is current.
+type StaticProvider struct {
+	keys    map[int32][]byte
+	current int32
+}
+
+// NewStaticProvider - constructor StaticProvider.
+func NewStaticProvider(keys map[int32][]byte) (*StaticProvider, error) {
+	if len(keys) == 0 {
+		return nil, customerror.ErrNoMasterKey
+	}
+	p := &StaticProvider{keys: make(map[int32][]byte, len(keys))}
+	for version, key := range keys {
+		if len(key) != sealtools.KeySize {
+			return nil, customerror.ErrInvalidKeySize
+		}
+		p.keys[version] = key
+		if version > p.current {
+			p.current = version
+		}
+	}
+	return p, nil
+}
+
+// NewEnvProvider - returns the provider of the master keys from the value of the environment variable.
+// The format of the value is described in ParseKeys.
+func NewEnvProvider(value string) (*StaticProvider, error) {
+	keys, err := ParseKeys(value)
+	if err != nil {
+		return nil, err
+	}
+	return NewStaticProvider(keys)
+}
+
+// CurrentVersion - returns the highest version of the master keys.
+func (p *StaticProvider) CurrentVersion() int32 {
+	return p.current
+}
+
+// Wrap - encrypts the data key with the current master key.
+func (p *StaticProvider) Wrap(ctx context.Context, key []byte, aad []byte) (int32, []byte, error) {
+	wrapped, err := sealtools.Seal(p.keys[p.current], key, aad)
+	if err != nil {
+		return 0, nil, err
+	}
+	return p.current, wrapped, nil
+}
+
+// Unwrap - decrypts the data key wrapped with the version of the master key.
+func (p *StaticProvider) Unwrap(ctx context.Context, version int32, wrapped []byte, aad []byte) ([]byte, error) {
+	master, ok := p.keys[version]
+	if !ok {
+		return nil, customerror.ErrUnknownKeyVersion
+	}
+	return sealtools.Open(master, wrapped, aad)
+}
+
+// ParseKeys - parses the master keys "version:base64 key" separated by the new lines or the commas.
+// The empty lines and the lines starting with # are skipped.
+func ParseKeys(text string) (map[int32][]byte, error) {
+	entries, err := parseEntries(text)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[int32][]byte, len(entries))
+	for _, e := range entries {
+		version, err := parseVersion(e.name)
+		if err != nil {
+			return nil, err
+		}
+		key, err := base64.StdEncoding.DecodeString(e.value)
+		if err != nil {
+			return nil, customerror.ErrInvalidMasterKey
+		}
+		keys[version] = key
+	}
+	return keys, nil
+}
+
+// AddKey - adds the new random key with the next version to the master keys in the format of ParseKeys.
+// Returns the master keys and the new version.
+func AddKey(text string) (string, int32, error) {
+	keys, err := ParseKeys(text)
+	if err != nil {
+		return "", 0, err
+	}
+	var version int32
+	for v := range keys {
+		if v > version {
+			version = v
+		}
+	}
+	version++
+	line, err := newKeyLine(version)
+	if err != nil {
+		return "", 0, err
+	}
+	// the key file keeps a key per line, the environment variable keeps the keys separated by the commas
+	multiline := strings.Contains(text, "\n")
+	text = strings.TrimRight(text, " \n")
+	switch {
+	case text == "":
+		return line, version, nil
+	case multiline:
+		return text + "\n" + line + "\n", version, nil
+	}
+	return text + "," + line, version, nil
+}
+
+// RemoveKey - removes the version from the master keys in the format of ParseKeys.
+// The current version cannot be removed.
+func RemoveKey(text string, version int32) (string, error) {
+	p, err := NewEnvProvider(text)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := p.keys[version]; !ok {
+		return "", customerror.ErrUnknownKeyVersion
+	}
+	if version == p.current {
+		return "", customerror.ErrRetireCurrentKey
+	}
+	lines := strings.Split(text, "\n")
+	res := make([]string, 0, len(lines))
+	for _, line := range lines {
+		parts := strings.Split(line, ",")
+		kept := make([]string, 0, len(parts))
+		for _, part := range parts {
+			entries, err := parseEntries(part)
+			if err != nil {
+				return "", err
+			}
+			if len(entries) == 1 {
+				if v, err := parseVersion(entries[0].name); err == nil && v == version {
+					continue
+				}
+			}
+			kept = append(kept, part)
+		}
+		// the line of the removed key is dropped, the other lines are kept as is
+		if len(kept) > 0 || strings.TrimSpace(line) == "" {
+			res = append(res, strings.Join(kept, ","))
+		}
+	}
+	return strings.Join(res, "\n"), nil
+}
+
+// newKeyLine - returns the new random key of the version in the format of ParseKeys.
+func newKeyLine(version int32) (string, error) {
+	key, err := sealtools.NewKey()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d:%s", version, base64.StdEncoding.EncodeToString(key)), nil
+}
+
+// entry - "name:value" entry of the key list.
+type entry struct {
+	name  string
+	value string
+}
+
+// parseEntries - parses the entries "name:value" separated by the new lines or the commas.
+func parseEntries(text string) ([]entry, error) {
+	res := make([]entry, 0)
+	for _, line := range strings.FieldsFunc(text, func(r rune) bool { return r == '\n' || r == ',' }) {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		i := strings.IndexByte(line, ':')
+		if i <= 0 || i == len(line)-1 {
+			return nil, customerror.ErrInvalidMasterKey
+		}
+		res = append(res, entry{name: strings.TrimSpace(line[:i]), value: strings.TrimSpace(line[i+1:])})
+	}
+	return res, nil
+}
+
+// parseVersion - parses the positive version of the master key.
+func parseVersion(s string) (int32, error) {
+	version, err := strconv.ParseInt(s, 10, 32)
+	if err != nil || version <= 0 {
+		return 0, customerror.ErrInvalidMasterKey
+	}
+	return int32(version), nil
+}
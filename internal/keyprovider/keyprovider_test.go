@@ -0,0 +1,180 @@
+package keyprovider
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/tools/sealtools"
+	"github.com/stretchr/testify/assert"
+)
+
+// testProvider - checks wrapping the data key with the provider.
+func testProvider(t *testing.T, p KeyProvider) {
+	ctx := context.Background()
+	key, err := sealtools.NewKey()
+	assert.NoError(t, err)
+	aad := []byte("user")
+
+	version, wrapped, err := p.Wrap(ctx, key, aad)
+	assert.NoError(t, err)
+	assert.Equal(t, p.CurrentVersion(), version)
+	assert.NotContains(t, string(wrapped), string(key))
+
+	unwrapped, err := p.Unwrap(ctx, version, wrapped, aad)
+	assert.NoError(t, err)
+	assert.Equal(t, key, unwrapped)
+
+	_, err = p.Unwrap(ctx, version, wrapped, []byte("other user"))
+	assert.ErrorIs(t, err, customerror.ErrDecrypt)
+	_, err = p.Unwrap(ctx, version+100, wrapped, aad)
+	assert.Error(t, err)
+}
+
+// newKeyText - returns the key of the key list in base64.
+func newKeyText(t *testing.T) string {
+	key, err := sealtools.NewKey()
+	assert.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func TestParseKeys(t *testing.T) {
+	k1, k2 := newKeyText(t), newKeyText(t)
+	keys, err := ParseKeys("# master keys\n1:" + k1 + "\n\n 2 : " + k2 + " \n")
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+	assert.Equal(t, k2, base64.StdEncoding.EncodeToString(keys[2]))
+
+	keys, err = ParseKeys("1:" + k1 + ",2:" + k2)
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+
+	for _, text := range []string{"1", "1:", ":" + k1, "x:" + k1, "0:" + k1, "1:not base64!"} {
+		_, err := ParseKeys(text)
+		assert.ErrorIs(t, err, customerror.ErrInvalidMasterKey, text)
+	}
+}
+
+func TestEnvProvider(t *testing.T) {
+	k1 := newKeyText(t)
+	p, err := NewEnvProvider("1:" + k1 + ",3:" + newKeyText(t))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), p.CurrentVersion())
+	testProvider(t, p)
+
+	// the key wrapped with the old version is still unwrapped
+	old, err := NewEnvProvider("1:" + k1)
+	assert.NoError(t, err)
+	key, err := sealtools.NewKey()
+	assert.NoError(t, err)
+	version, wrapped, err := old.Wrap(context.Background(), key, nil)
+	assert.NoError(t, err)
+	unwrapped, err := p.Unwrap(context.Background(), version, wrapped, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, key, unwrapped)
+
+	_, err = NewEnvProvider("")
+	assert.ErrorIs(t, err, customerror.ErrNoMasterKey)
+	_, err = NewEnvProvider("1:" + base64.StdEncoding.EncodeToString([]byte("short")))
+	assert.ErrorIs(t, err, customerror.ErrInvalidKeySize)
+}
+
+func TestFileProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "master.key")
+
+	// the missing file is created
+	p, err := NewFileProvider(path)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), p.CurrentVersion())
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	testProvider(t, p)
+
+	// the existing file is read
+	again, err := NewFileProvider(path)
+	assert.NoError(t, err)
+	assert.Equal(t, p.provider().keys, again.provider().keys)
+
+	assert.NoError(t, os.WriteFile(path, []byte("garbage"), 0o600))
+	_, err = NewFileProvider(path)
+	assert.ErrorIs(t, err, customerror.ErrInvalidMasterKey)
+}
+
+func TestAddRemoveKey(t *testing.T) {
+	text, version, err := AddKey("")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), version)
+	text, version, err = AddKey(text)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), version)
+	keys, err := ParseKeys(text)
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+
+	_, err = RemoveKey(text, 2)
+	assert.ErrorIs(t, err, customerror.ErrRetireCurrentKey)
+	_, err = RemoveKey(text, 3)
+	assert.ErrorIs(t, err, customerror.ErrUnknownKeyVersion)
+	text, err = RemoveKey(text, 1)
+	assert.NoError(t, err)
+	keys, err = ParseKeys(text)
+	assert.NoError(t, err)
+	assert.Len(t, keys, 1)
+	assert.Contains(t, keys, int32(2))
+
+	// the lines and the comments of the key file are kept
+	file := "# master keys\n1:" + newKeyText(t) + "\n"
+	file, version, err = AddKey(file)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), version)
+	assert.Equal(t, 3, strings.Count(file, "\n"))
+	file, err = RemoveKey(file, 1)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(file, "# master keys\n2:"))
+	assert.Equal(t, 2, strings.Count(file, "\n"))
+}
+
+func TestRotateKeyFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "master.key")
+	p, err := NewFileProvider(path)
+	assert.NoError(t, err)
+	ctx := context.Background()
+	key, err := sealtools.NewKey()
+	assert.NoError(t, err)
+	_, old, err := p.Wrap(ctx, key, nil)
+	assert.NoError(t, err)
+
+	// the other server rotates the key, the new version is read when it is met
+	version, err := RotateKeyFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), version)
+	other, err := NewFileProvider(path)
+	assert.NoError(t, err)
+	_, wrapped, err := other.Wrap(ctx, key, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), p.CurrentVersion())
+	unwrapped, err := p.Unwrap(ctx, 2, wrapped, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, key, unwrapped)
+	assert.Equal(t, int32(2), p.CurrentVersion())
+
+	assert.NoError(t, RetireKeyFile(path, 1))
+	assert.NoError(t, p.Reload())
+	_, err = p.Unwrap(ctx, 1, old, nil)
+	assert.ErrorIs(t, err, customerror.ErrUnknownKeyVersion)
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// the version rotated by the other server wraps the next data key without the reload
+	version, err = RotateKeyFile(path)
+	assert.NoError(t, err)
+	used, _, err := p.Wrap(ctx, key, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, version, used)
+}
@@ -0,0 +1,147 @@
+package keyprovider
+
+import (
+	"context"
+	"encoding/base64"
+	"sync"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/tools/sealtools"
+)
+
+// KMSClient - client of the key management service. The master keys never leave the service.
+type KMSClient interface {
+	// Encrypt - encrypts the data with the key of the service.
+	Encrypt(ctx context.Context, keyID string, data []byte, aad []byte) ([]byte, error)
+	// Decrypt - decrypts the data encrypted with the key of the service.
+	Decrypt(ctx context.Context, keyID string, ciphertext []byte, aad []byte) ([]byte, error)
+}
+
+// KMSProvider - provider that wraps the data keys with the keys of the key management service.
+// The versions of the master key are mapped to the key ids, the highest version is current.
+type KMSProvider struct {
+	Client  KMSClient
+	keyIDs  map[int32]string
+	current int32
+}
+
+// NewKMSProvider - constructor KMSProvider.
+func NewKMSProvider(client KMSClient, keyIDs map[int32]string) (*KMSProvider, error) {
+	if len(keyIDs) == 0 {
+		return nil, customerror.ErrNoMasterKey
+	}
+	p := &KMSProvider{Client: client, keyIDs: make(map[int32]string, len(keyIDs))}
+	for version, id := range keyIDs {
+		p.keyIDs[version] = id
+		if version > p.current {
+			p.current = version
+		}
+	}
+	return p, nil
+}
+
+// CurrentVersion - returns the highest version of the master key.
+func (p *KMSProvider) CurrentVersion() int32 {
+	return p.current
+}
+
+// Wrap - encrypts the data key with the key of the current version.
+func (p *KMSProvider) Wrap(ctx context.Context, key []byte, aad []byte) (int32, []byte, error) {
+	wrapped, err := p.Client.Encrypt(ctx, p.keyIDs[p.current], key, aad)
+	if err != nil {
+		return 0, nil, err
+	}
+	return p.current, wrapped, nil
+}
+
+// Unwrap - decrypts the data key with the key of the version.
+func (p *KMSProvider) Unwrap(ctx context.Context, version int32, wrapped []byte, aad []byte) ([]byte, error) {
+	id, ok := p.keyIDs[version]
+	if !ok {
+		return nil, customerror.ErrUnknownKeyVersion
+	}
+	return p.Client.Decrypt(ctx, id, wrapped, aad)
+}
+
+// ParseKeyIDs - parses the key ids "version:key id" separated by the new lines or the commas.
+func ParseKeyIDs(text string) (map[int32]string, error) {
+	entries, err := parseEntries(text)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[int32]string, len(entries))
+	for _, e := range entries {
+		version, err := parseVersion(e.name)
+		if err != nil {
+			return nil, err
+		}
+		ids[version] = e.value
+	}
+	return ids, nil
+}
+
+// LocalKMS - stand-in of the key management service that keeps the keys in memory.
+// It is used in the development and the tests until the client of the real service is plugged in.
+type LocalKMS struct {
+	mu   sync.RWMutex
+	keys map[string][]byte
+}
+
+// NewLocalKMS - returns the local KMS with the keys "key id:base64 key" separated by the new lines or the commas.
+func NewLocalKMS(text string) (*LocalKMS, error) {
+	entries, err := parseEntries(text)
+	if err != nil {
+		return nil, err
+	}
+	k := &LocalKMS{keys: make(map[string][]byte, len(entries))}
+	for _, e := range entries {
+		key, err := base64.StdEncoding.DecodeString(e.value)
+		if err != nil {
+			return nil, customerror.ErrInvalidMasterKey
+		}
+		if err := k.ImportKey(e.name, key); err != nil {
+			return nil, err
+		}
+	}
+	return k, nil
+}
+
+// ImportKey - adds the key with the id.
+func (k *LocalKMS) ImportKey(keyID string, key []byte) error {
+	if len(key) != sealtools.KeySize {
+		return customerror.ErrInvalidKeySize
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.keys[keyID] = key
+	return nil
+}
+
+// key - returns the key with the id.
+func (k *LocalKMS) key(keyID string) ([]byte, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	key, ok := k.keys[keyID]
+	if !ok {
+		return nil, customerror.ErrKMSKeyNotFound
+	}
+	return key, nil
+}
+
+// Encrypt - encrypts the data with the key.
+func (k *LocalKMS) Encrypt(ctx context.Context, keyID string, data []byte, aad []byte) ([]byte, error) {
+	key, err := k.key(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return sealtools.Seal(key, data, aad)
+}
+
+// Decrypt - decrypts the data encrypted with the key.
+func (k *LocalKMS) Decrypt(ctx context.Context, keyID string, ciphertext []byte, aad []byte) ([]byte, error) {
+	key, err := k.key(keyID)
+	if err != nil {
+		return nil, err
+	}
+	return sealtools.Open(key, ciphertext, aad)
+}
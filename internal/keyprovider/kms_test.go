@@ -0,0 +1,33 @@
+package keyprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKMSProvider(t *testing.T) {
+	kms, err := NewLocalKMS("alias/pwdm-1:" + newKeyText(t) + "\nalias/pwdm-2:" + newKeyText(t))
+	assert.NoError(t, err)
+	ids, err := ParseKeyIDs("1:alias/pwdm-1,2:alias/pwdm-2")
+	assert.NoError(t, err)
+	p, err := NewKMSProvider(kms, ids)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), p.CurrentVersion())
+	testProvider(t, p)
+
+	// the version that refers to the missing key of the service
+	p, err = NewKMSProvider(kms, map[int32]string{1: "alias/missing"})
+	assert.NoError(t, err)
+	_, _, err = p.Wrap(context.Background(), make([]byte, 32), nil)
+	assert.ErrorIs(t, err, customerror.ErrKMSKeyNotFound)
+
+	_, err = NewKMSProvider(kms, nil)
+	assert.ErrorIs(t, err, customerror.ErrNoMasterKey)
+	_, err = NewLocalKMS("alias/pwdm-1:c2hvcnQ=")
+	assert.ErrorIs(t, err, customerror.ErrInvalidKeySize)
+	_, err = ParseKeyIDs("alias/pwdm-1")
+	assert.ErrorIs(t, err, customerror.ErrInvalidMasterKey)
+}
@@ -0,0 +1,105 @@
+// Reminder package periodically finds the records whose expiry date is near
+// and sends the reminders about them through the notifier.
+package reminder
+
+import (
+	"context"
+	"time"
+
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/sirupsen/logrus"
+)
+
+// Maximum number of the reminders sent in one pass.
+const batchSize int32 = 100
+
+// Notifier - interface for sending the reminders to the users.
+type Notifier interface {
+	Notify(ctx context.Context, item models.ExpiringItemModel) error
+}
+
+// LogNotifier - notifier that writes the reminders to the log.
+type LogNotifier struct {
+	Logger *logrus.Logger
+}
+
+// NewLogNotifier - constructor LogNotifier.
+func NewLogNotifier(l *logrus.Logger) *LogNotifier {
+	return &LogNotifier{Logger: l}
+}
+
+// Notify - writes the reminder to the log.
+func (n *LogNotifier) Notify(ctx context.Context, item models.ExpiringItemModel) error {
+	n.Logger.WithFields(logrus.Fields{
+		"uuid":       item.UUID,
+		"id":         item.ID,
+		"type":       item.Type,
+		"title":      item.Title,
+		"expires_at": item.ExpiresAt.Format("2006-01-02"),
+	}).Info("Record expires soon")
+	return nil
+}
+
+// Scheduler - sends one reminder for each expiry date of the record to each user who can read it
+// when the date comes within the horizon.
+type Scheduler struct {
+	rep      storage.Storage
+	notifier Notifier
+	horizon  time.Duration
+	interval time.Duration
+	Logger   *logrus.Logger
+}
+
+// NewScheduler - constructor Scheduler.
+func NewScheduler(r storage.Storage, n Notifier, horizon time.Duration, interval time.Duration, l *logrus.Logger) *Scheduler {
+	return &Scheduler{rep: r, notifier: n, horizon: horizon, interval: interval, Logger: l}
+}
+
+// Run - sends the reminders every interval until the context is canceled.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.Tick(ctx, time.Now()); err != nil && ctx.Err() == nil {
+			s.Logger.WithField("err", err).Error("Failed to send expiry reminders")
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Tick - sends the reminders about the records expiring within the horizon from now.
+// The reminder is marked as sent only after the notifier accepted it, so a failed reminder
+// is sent again on the next tick.
+func (s *Scheduler) Tick(ctx context.Context, now time.Time) error {
+	for {
+		items, err := s.rep.SelectDueReminders(ctx, now.Add(s.horizon), batchSize)
+		if err != nil {
+			return err
+		}
+		sent := 0
+		for _, item := range items {
+			if err := s.notifier.Notify(ctx, item); err != nil {
+				s.Logger.WithFields(logrus.Fields{
+					"uuid": item.UUID,
+					"id":   item.ID,
+					"type": item.Type,
+					"err":  err,
+				}).Error("Failed to notify")
+				continue
+			}
+			if err := s.rep.MarkReminded(ctx, item); err != nil {
+				return err
+			}
+			sent++
+		}
+		// the failed reminders stay due, they are retried on the next tick
+		if len(items) < int(batchSize) || sent == 0 {
+			return nil
+		}
+	}
+}
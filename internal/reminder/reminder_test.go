@@ -0,0 +1,69 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeStorage - storage with the records expiring at the given dates.
+type fakeStorage struct {
+	storage.Storage
+	items    []models.ExpiringItemModel
+	reminded map[int32]bool
+}
+
+func (f *fakeStorage) SelectDueReminders(ctx context.Context, before time.Time, limit int32) ([]models.ExpiringItemModel, error) {
+	res := make([]models.ExpiringItemModel, 0)
+	for _, item := range f.items {
+		if !f.reminded[item.ID] && !item.ExpiresAt.After(before) && len(res) < int(limit) {
+			res = append(res, item)
+		}
+	}
+	return res, nil
+}
+
+func (f *fakeStorage) MarkReminded(ctx context.Context, model models.ExpiringItemModel) error {
+	f.reminded[model.ID] = true
+	return nil
+}
+
+// fakeNotifier - notifier that remembers the reminders and fails for the given record.
+type fakeNotifier struct {
+	sent   []int32
+	failID int32
+}
+
+func (f *fakeNotifier) Notify(ctx context.Context, item models.ExpiringItemModel) error {
+	if item.ID == f.failID {
+		return errors.New("notify error")
+	}
+	f.sent = append(f.sent, item.ID)
+	return nil
+}
+
+func TestScheduler(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	rep := &fakeStorage{reminded: make(map[int32]bool), items: []models.ExpiringItemModel{
+		{ID: 1, ExpiresAt: now.AddDate(0, 0, -1)},
+		{ID: 2, ExpiresAt: now.AddDate(0, 0, 10)},
+		{ID: 3, ExpiresAt: now.AddDate(0, 0, 20)},
+		{ID: 4, ExpiresAt: now.AddDate(0, 2, 0)},
+	}}
+	notifier := &fakeNotifier{failID: 3}
+	s := NewScheduler(rep, notifier, 30*24*time.Hour, time.Hour, logrus.New())
+
+	assert.NoError(t, s.Tick(context.Background(), now))
+	assert.Equal(t, []int32{1, 2}, notifier.sent)
+
+	// the sent reminders are not repeated, the failed one is retried
+	notifier.failID = 0
+	assert.NoError(t, s.Tick(context.Background(), now))
+	assert.Equal(t, []int32{1, 2, 3}, notifier.sent)
+}
@@ -1,6 +1,8 @@
 // Models package describes models objects for working with storage.
 package models
 
+import "time"
+
 // UserModel - model for authentication/registration users.
 type UserModel struct {
 	Login    string
@@ -11,15 +13,24 @@ type UserModel struct {
 type ListRecordsModel struct {
 	ListID []int32 // list records id
 	UUID   string  // uuid current user
+	Type   int32   // data type of the records
 }
 
 // DataRecordModel - model for show information by one record.
 type DataRecordModel struct {
-	Title   string // record title
-	Tag     string // tag for record
-	Comment string // comment for record
-	Type    int32  // data type (for example: 1 - login/password, 2 - card, 3 - text, 4 - binary)
-	ID      int32  // record id in database
+	Title     string    // record title
+	Tag       string    // tag for record
+	Comment   string    // comment for record
+	Type      int32     // data type (for example: 1 - login/password, 2 - card, 3 - text, 4 - binary)
+	ID        int32     // record id in database
+	CreatedAt time.Time // record creation time
+	UpdatedAt time.Time // record last update time
+	FolderID  int32     // folder id, 0 - root folder
+	Fields    []CustomFieldModel
+	Favorite  bool
+	// access statistics, filled only by the list of records
+	LastAccessedAt time.Time // zero if the record has never been read
+	AccessCount    int32
 }
 
 // ReqLogPwdModel - model login/password pair for request.
@@ -90,6 +101,7 @@ type RespTechDataModel struct {
 	Error   error
 	ID      int32
 	Type    int32
+	Fields  []CustomFieldModel
 }
 
 // InsertRespModel - model insert data response.
@@ -131,7 +143,551 @@ type TextDataModel struct {
 
 // BinaryDataModel - model for binary data.
 type BinaryDataModel struct {
-	ID int32 // id record in database (for update service)
-	// Data []byte // some binary data
-	Data string // in the database, the data is stored in text format
+	ID     int32 // id record in database (for update service)
+	Data   []byte
+	Size   int64  // size of the data in bytes, set by the storage
+	SHA256 string // hex SHA-256 of the data, set by the storage
+}
+
+// SSHKeyModel - model SSH key.
+type SSHKeyModel struct {
+	ID          int32 // id record in database (for update service)
+	PrivateKey  string
+	PublicKey   string // authorized_keys format, derived from the private key
+	Passphrase  string
+	KeyType     string // for example: ssh-ed25519, ssh-rsa
+	Fingerprint string // SHA256 fingerprint of the public key
+}
+
+// ReqSSHKeyModel - model SSH key for request.
+type ReqSSHKeyModel struct {
+	UUID     string
+	Data     SSHKeyModel
+	TechData ReqTechDataModel
+}
+
+// RespSSHKeyModel - model SSH key for response.
+type RespSSHKeyModel struct {
+	Data     SSHKeyModel
+	TechData RespTechDataModel
+}
+
+// SSHKeyInfoModel - model public information about the SSH key.
+type SSHKeyInfoModel struct {
+	ID          int32
+	KeyType     string
+	PublicKey   string
+	Fingerprint string
+}
+
+// IdentityModel - model identity (personal information).
+// Zero expiry dates are not set.
+type IdentityModel struct {
+	ID             int32 // id record in database (for update service)
+	FirstName      string
+	MiddleName     string
+	LastName       string
+	Address1       string
+	Address2       string
+	City           string
+	State          string
+	PostalCode     string
+	Country        string
+	Phone          string
+	Email          string
+	PassportNumber string
+	PassportExpiry time.Time
+	LicenseNumber  string // driver's license
+	LicenseExpiry  time.Time
+	NationalID     string
+	TaxID          string
+}
+
+// ReqIdentityModel - model identity for request.
+type ReqIdentityModel struct {
+	UUID     string
+	Data     IdentityModel
+	TechData ReqTechDataModel
+}
+
+// RespIdentityModel - model identity for response.
+type RespIdentityModel struct {
+	Data     IdentityModel
+	TechData RespTechDataModel
+}
+
+// SyncReqModel - model for request changes since the cursor.
+type SyncReqModel struct {
+	UUID   string // uuid current user
+	Cursor int64  // last change sequence known to the client
+	Limit  int32  // page size
+}
+
+// ChangeModel - model one change of the record.
+type ChangeModel struct {
+	Seq     int64 // change sequence number
+	ID      int32 // record id in database
+	Type    int32 // data type
+	Deleted bool  // tombstone flag
+	Created bool  // record was created after the cursor
+	Title   string
+	Tag     string
+	Comment string
+}
+
+// SyncRespModel - model page of changes.
+type SyncRespModel struct {
+	Changes []ChangeModel
+	Cursor  int64 // cursor for the next page
+	HasMore bool  // there are more changes after the cursor
+}
+
+// Actions of the batch operations.
+const (
+	BatchInsert int32 = iota + 1
+	BatchUpdate
+	BatchDelete
+)
+
+// BatchOperationModel - model one operation of the batch.
+// Only the data model corresponding to the Type is used.
+type BatchOperationModel struct {
+	Action   int32 // insert, update or delete
+	Type     int32 // data type
+	ID       int32 // record id in database (for update and delete)
+	TechData ReqTechDataModel
+	LogPwd   LogPwdModel
+	Card     CardModel
+	Text     TextDataModel
+	Binary   BinaryDataModel
+	Identity IdentityModel
+	SSHKey   SSHKeyModel
+}
+
+// BatchReqModel - model batch of operations for request.
+type BatchReqModel struct {
+	UUID       string // uuid current user
+	Operations []BatchOperationModel
+}
+
+// BatchResultModel - model result of one operation of the batch.
+type BatchResultModel struct {
+	ID    int32
+	Title string
+	Error error
+}
+
+// BatchRespModel - model batch results for response.
+type BatchRespModel struct {
+	Results   []BatchResultModel
+	Committed bool // false if the batch is rolled back
+}
+
+// IdempotencyModel - model idempotency key of the write request.
+type IdempotencyModel struct {
+	UUID        string    // uuid current user
+	Key         string    // idempotency key from the request metadata
+	Method      string    // full name of the called method
+	RequestHash []byte    // SHA-256 of the serialized request
+	Response    []byte    // serialized response, nil while the request is in progress
+	Expired     time.Time // keys created before this time are expired
+}
+
+// Sort orders of the list of records.
+const (
+	SortByTitle int32 = iota
+	SortByCreated
+	SortByUpdated
+	SortByRecent   // last access time
+	SortByFrequent // number of accesses
+)
+
+// ItemsCursorModel - model position in the sorted list of records.
+type ItemsCursorModel struct {
+	Value    string // value of the sort field of the last record
+	Type     int32  // data type of the last record
+	ID       int32  // id of the last record
+	Favorite bool   // the last record is favorite, used with FavoritesFirst
+}
+
+// ListItemsReqModel - model for request a filtered page of records.
+// Zero values of the filters are not used.
+type ListItemsReqModel struct {
+	UUID           string   // uuid current user
+	Types          []int32  // data types
+	Tags           []string // records with any of the tags
+	Title          string   // substring of the title, case insensitive
+	CreatedFrom    time.Time
+	CreatedTo      time.Time
+	UpdatedFrom    time.Time
+	UpdatedTo      time.Time
+	SortBy         int32
+	Desc           bool
+	FavoritesFirst bool              // favorite records go before the others
+	Cursor         *ItemsCursorModel // nil for the first page
+	Limit          int32
+}
+
+// ListItemsRespModel - model page of records.
+type ListItemsRespModel struct {
+	Items []DataRecordModel
+	Next  *ItemsCursorModel // nil if it is the last page
+}
+
+// TagModel - model tag with the number of records.
+type TagModel struct {
+	Name  string
+	Count int32 // number of not deleted records with the tag
+}
+
+// TagReqModel - model tag of the current user for request.
+type TagReqModel struct {
+	UUID string // uuid current user
+	Name string
+}
+
+// RenameTagModel - model for rename the tag.
+type RenameTagModel struct {
+	UUID    string // uuid current user
+	Name    string
+	NewName string
+}
+
+// MergeTagsModel - model for merge the tags into the target tag.
+type MergeTagsModel struct {
+	UUID   string // uuid current user
+	Names  []string
+	Target string
+}
+
+// FolderModel - model folder of the records.
+type FolderModel struct {
+	UUID     string // uuid current user
+	ID       int32
+	ParentID int32 // 0 - root folder
+	Name     string
+}
+
+// FolderReqModel - model folder of the current user for request.
+type FolderReqModel struct {
+	UUID string // uuid current user
+	ID   int32  // 0 - root folder
+}
+
+// MoveItemsModel - model for move the records to the folder.
+type MoveItemsModel struct {
+	UUID     string // uuid current user
+	FolderID int32  // 0 - root folder
+	Items    []IDModel
+}
+
+// CustomFieldModel - model custom field of the record.
+type CustomFieldModel struct {
+	Name  string `json:"name"`
+	Type  int32  `json:"type"` // field type (for example: 1 - text, 2 - hidden, 3 - boolean, 4 - url)
+	Value string `json:"value"`
+}
+
+// CustomFieldsModel - model for replace the custom fields of the record.
+type CustomFieldsModel struct {
+	UUID   string // uuid current user
+	ID     int32
+	Type   int32
+	Fields []CustomFieldModel
+}
+
+// LoginURIModel - model URI of the login/password record.
+type LoginURIModel struct {
+	URI        string
+	Match      int32  // match strategy (for example: 0 - base domain, 1 - host, 2 - starts with, 3 - exact, 4 - regex)
+	Host       string // host of the URI, empty for regex
+	BaseDomain string // registrable domain of the URI, empty for regex
+}
+
+// LoginURIsModel - model for replace the URIs of the login/password record.
+type LoginURIsModel struct {
+	UUID string // uuid current user
+	ID   int32
+	URIs []LoginURIModel
+}
+
+// LookupLoginsModel - model for search the login/password records by the page domain.
+type LookupLoginsModel struct {
+	UUID       string // uuid current user
+	BaseDomain string
+}
+
+// LoginMatchModel - model login/password record with its URIs.
+type LoginMatchModel struct {
+	ID      int32
+	Title   string
+	Tag     string
+	Comment string
+	Data    LogPwdModel
+	URIs    []LoginURIModel
+}
+
+// TOTPModel - model TOTP secret of the login/password record.
+type TOTPModel struct {
+	UUID string // uuid current user
+	ID   int32
+	URI  string // otpauth://totp URI or base32 secret
+}
+
+// ExpiryModel - model for set the expiry date of the record.
+type ExpiryModel struct {
+	UUID      string // uuid current user
+	ID        int32
+	Type      int32
+	ExpiresAt time.Time // zero removes the expiry date
+}
+
+// ExpiringReqModel - model for request the records expiring before the time.
+type ExpiringReqModel struct {
+	UUID   string // uuid current user
+	Before time.Time
+}
+
+// ExpiringItemModel - model record with the expiry date.
+type ExpiringItemModel struct {
+	UUID      string // uuid of the owner, the member of the collection for the reminder about the record of the collection
+	ID        int32
+	Type      int32
+	Title     string
+	Tag       string
+	ExpiresAt time.Time // the record is valid through this day
+}
+
+// FavoriteModel - model for mark the record as favorite.
+type FavoriteModel struct {
+	UUID     string // uuid current user
+	ID       int32
+	Type     int32
+	Favorite bool
+}
+
+// RecentItemsModel - model for request the recently used records.
+type RecentItemsModel struct {
+	UUID  string // uuid current user
+	Limit int32
+}
+
+// BinaryUploadModel - model of the binary data uploaded in chunks.
+type BinaryUploadModel struct {
+	ID        string // id of the upload
+	UUID      string // uuid current user
+	ItemID    int32  // 0 - new record, otherwise the record whose data is replaced
+	TechData  ReqTechDataModel
+	Size      int64  // size of the whole data in bytes
+	SHA256    string // expected hex SHA-256 of the whole data
+	Received  int64  // number of the received bytes
+	HashState []byte // state of the SHA-256 of the received bytes
+	// the attachment of the record ItemID of the type TechData.Type with the filename TechData.Title
+	Attachment bool
+	MimeType   string
+}
+
+// UploadReqModel - model for request the upload.
+type UploadReqModel struct {
+	UUID string // uuid current user
+	ID   string // id of the upload
+}
+
+// BinaryChunkModel - model of the chunk of the upload.
+type BinaryChunkModel struct {
+	UUID      string // uuid current user
+	UploadID  string
+	Offset    int64 // must be equal to the number of the received bytes
+	Data      []byte
+	HashState []byte // state of the SHA-256 including the chunk
+}
+
+// BinaryReadModel - model for read the chunk of the binary data of the record.
+type BinaryReadModel struct {
+	UUID   string // uuid current user
+	ID     int32  // id record in database
+	Offset int64
+	Limit  int32
+}
+
+// AttachmentModel - model of the file attached to the record.
+type AttachmentModel struct {
+	ID        int32
+	Type      int32 // type of the record
+	ItemID    int32 // id of the record
+	Filename  string
+	MimeType  string
+	Size      int64
+	SHA256    string
+	CreatedAt time.Time
+}
+
+// AttachmentReqModel - model for request the attachment.
+type AttachmentReqModel struct {
+	UUID string // uuid current user
+	ID   int32  // id of the attachment
+}
+
+// SizeModel - logical size of the data and its size in the storage.
+type SizeModel struct {
+	Logical int64
+	Stored  int64
+}
+
+// UsageModel - model of the storage usage of the user.
+type UsageModel struct {
+	Text        SizeModel
+	Binary      SizeModel
+	Attachments SizeModel
+	Quota       int64 // storage quota of the user in bytes, 0 - unlimited
+}
+
+// KeyVersionModel - number of the data keys wrapped with the version of the master key.
+type KeyVersionModel struct {
+	Version int32
+	Keys    int64
+	Current bool // the version wraps the new data keys
+}
+
+// VaultParamsModel - key derivation parameters of the user in the vault mode.
+type VaultParamsModel struct {
+	UUID        string // uuid current user
+	KDF         string // pbkdf2-sha256 or argon2id
+	Salt        []byte
+	Iterations  int32
+	Memory      int32 // KiB, argon2id only
+	Parallelism int32 // argon2id only
+}
+
+// VaultItemModel - item encrypted by the client.
+type VaultItemModel struct {
+	UUID      string // uuid current user
+	ID        int32
+	Blob      []byte
+	Metadata  map[string]string // plain data exposed by the client
+	Revision  int64             // on the change - the revision of the item known to the client
+	Deleted   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// VaultListReqModel - model for request the vault items changed since the revision.
+type VaultListReqModel struct {
+	UUID  string // uuid current user
+	Since int64
+	Limit int32
+	Match map[string]string // only the items with this metadata
+}
+
+// VaultListModel - page of the vault items.
+type VaultListModel struct {
+	Items    []VaultItemModel
+	Revision int64 // revision of the last item
+	HasMore  bool
+}
+
+// SRPUserModel - salt and verifier of the SRP login of the user.
+type SRPUserModel struct {
+	UUID     string
+	Login    string
+	Salt     []byte
+	Verifier []byte
+}
+
+// SRPSessionModel - started SRP login.
+type SRPSessionModel struct {
+	ID           string
+	User         SRPUserModel
+	ClientPublic []byte // A
+	Secret       []byte // b
+	Expires      time.Time
+}
+
+// KeyPairModel - public key of the user and its private key encrypted by the client.
+type KeyPairModel struct {
+	UUID                string // uuid current user
+	Algorithm           string
+	PublicKey           []byte
+	EncryptedPrivateKey []byte // empty for the keys of the other users
+	Fingerprint         string // hex SHA-256 of the public key
+}
+
+// ShareModel - model for share the vault item with the recipient.
+type ShareModel struct {
+	Owner          string // uuid current user
+	ItemID         int32
+	RecipientLogin string
+	WrappedKey     []byte // key of the item wrapped with the public key of the recipient
+	Fingerprint    string // fingerprint of the public key the item key is wrapped with
+	Permission     int32  // 1 - read-only, 2 - read-write
+}
+
+// SharedItemModel - vault item shared with the current user.
+type SharedItemModel struct {
+	Item        VaultItemModel
+	OwnerLogin  string
+	WrappedKey  []byte
+	Fingerprint string
+	Permission  int32
+	SharedAt    time.Time
+}
+
+// OrganizationModel - organization of the users that owns the records of its collections.
+type OrganizationModel struct {
+	ID        string
+	UUID      string // uuid current user
+	Name      string
+	Admin     bool // the current user is the administrator of the organization
+	CreatedAt time.Time
+}
+
+// OrgMemberModel - member of the organization.
+type OrgMemberModel struct {
+	OrgID    string
+	UUID     string // uuid current user
+	Login    string // login of the member
+	Admin    bool
+	JoinedAt time.Time
+}
+
+// InvitationModel - invitation of the user to the organization.
+type InvitationModel struct {
+	OrgID          string
+	UUID           string // uuid current user
+	Login          string // login of the invited user
+	OrgName        string
+	InvitedByLogin string
+	CreatedAt      time.Time
+}
+
+// CollectionModel - collection of the records of the organization.
+type CollectionModel struct {
+	ID        int32
+	OrgID     string
+	UUID      string // uuid current user
+	Name      string
+	Role      int32 // role of the current user, 0 - the administrator of the organization without the role
+	CreatedAt time.Time
+}
+
+// CollectionRoleModel - role of the member in the collection.
+type CollectionRoleModel struct {
+	CollectionID int32
+	UUID         string // uuid current user
+	Login        string // login of the member
+	Role         int32  // 0 - removes the member from the collection
+}
+
+// CollectionReqModel - model for request the collection.
+type CollectionReqModel struct {
+	ID   int32
+	UUID string // uuid current user
+}
+
+// MoveToCollectionModel - model for move the record of the current user to the collection.
+type MoveToCollectionModel struct {
+	UUID         string // uuid current user
+	ID           int32
+	Type         int32
+	CollectionID int32
 }
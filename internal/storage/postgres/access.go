@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+)
+
+// SetFavorite - marks the record readable by the current user as favorite or removes the mark.
+// The mark belongs to the user, so the members of the collection mark its records apart.
+func (c *ClientPostgres) SetFavorite(ctx context.Context, model models.FavoriteModel) error {
+	table, ok := dataTables[model.Type]
+	if !ok {
+		return customerror.ErrUnknownDataType
+	}
+	return c.inTx(ctx, func(tc *ClientPostgres) error {
+		owner, err := tc.itemOwner(ctx, model.UUID, model.Type, model.ID, datatypes.ViewerRole)
+		if err != nil {
+			return err
+		}
+		var exists bool
+		q := fmt.Sprintf(`SELECT EXISTS(SELECT 1 FROM %s WHERE id = $1 AND uuid = $2 AND deleted = false);`, table)
+		if err := tc.conn().QueryRow(ctx, q, model.ID, owner).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return customerror.ErrRecordNotFound
+		}
+		q = `DELETE FROM item_favorites WHERE uuid = $1 AND type = $2 AND id = $3;`
+		if model.Favorite {
+			q = `INSERT INTO item_favorites(uuid, type, id) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING;`
+		}
+		if _, err := tc.conn().Exec(ctx, q, model.UUID, model.Type, model.ID); err != nil {
+			return err
+		}
+		// the other clients of the user read the mark again
+		_, err = tc.conn().Exec(ctx, `SELECT register_user_change($1, $2, $3, false);`, model.UUID, model.Type, model.ID)
+		return err
+	})
+}
+
+// TouchItem - saves that the record was read by the current user: updates the time of the last access
+// and increments the access counter of the user. The record itself is not changed.
+func (c *ClientPostgres) TouchItem(ctx context.Context, model models.IDModel) error {
+	q := `INSERT INTO item_access(uuid, type, id) VALUES ($1, $2, $3)
+	ON CONFLICT (uuid, type, id) DO UPDATE SET last_accessed_at = now(), access_count = item_access.access_count + 1;`
+	_, err := c.conn().Exec(ctx, q, model.UUID, model.Type, model.ID)
+	return err
+}
+
+// SelectRecentItems - get the recently read records of the current user and its collections, the last read goes first.
+func (c *ClientPostgres) SelectRecentItems(ctx context.Context, model models.RecentItemsModel) ([]models.DataRecordModel, error) {
+	res := make([]models.DataRecordModel, 0, model.Limit)
+	q := `SELECT i.title, i.tag, i.comment, i.type, i.id, i.created_at, i.updated_at, COALESCE(i.folder_id, 0), i.custom_fields,
+	EXISTS(SELECT 1 FROM item_favorites f WHERE f.uuid = $1 AND f.type = i.type AND f.id = i.id),
+	a.last_accessed_at, a.access_count, i.seal_version, i.uuid FROM items i
+	JOIN item_access a ON a.uuid = $1 AND a.type = i.type AND a.id = i.id
+	WHERE ` + availableRecords("i.") + ` AND i.deleted = false
+	ORDER BY a.last_accessed_at DESC, i.type, i.id LIMIT $2;`
+	rows, err := c.conn().Query(ctx, q, model.UUID, model.Limit)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		record := models.DataRecordModel{}
+		var lastAccessedAt time.Time
+		var version int16
+		var owner string
+		err := rows.Scan(&record.Title, &record.Tag, &record.Comment, &record.Type, &record.ID,
+			&record.CreatedAt, &record.UpdatedAt, &record.FolderID, &record.Fields,
+			&record.Favorite, &lastAccessedAt, &record.AccessCount, &version, &owner)
+		if err != nil {
+			return res, err
+		}
+		if err := c.openFields(ctx, owner, recordRef(record.Type, record.ID), version, record.Fields); err != nil {
+			return res, err
+		}
+		record.LastAccessedAt = lastAccessedAt
+		res = append(res, record)
+	}
+	return res, rows.Err()
+}
@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// (c *ClientPostgres) insertAttachment - writes the attachment from the finished upload, the record must exist.
+// The attachment belongs to the owner of the record.
+func (c *ClientPostgres) insertAttachment(ctx context.Context, upload models.BinaryUploadModel) (int32, error) {
+	var id int32
+	owner, err := c.itemOwner(ctx, upload.UUID, upload.TechData.Type, upload.ItemID, datatypes.EditorRole)
+	if err != nil {
+		return id, err
+	}
+	ok, err := c.RecordIsExists(ctx, models.IDModel{UUID: owner, ID: upload.ItemID, Type: upload.TechData.Type})
+	if err != nil {
+		return id, err
+	}
+	if !ok {
+		return id, customerror.ErrRecordNotFound
+	}
+	q := `INSERT INTO attachments(uuid, type, item_id, filename, mime_type, size, sha256)
+	SELECT $2, type, item_id, title, mime_type, size, sha256 FROM binary_uploads WHERE id = $1 RETURNING id;`
+	err = c.conn().QueryRow(ctx, q, upload.ID, owner).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return id, customerror.ErrUploadNotFound
+	}
+	return id, err
+}
+
+// SelectAttachments - get the attachments of the record of the current user.
+func (c *ClientPostgres) SelectAttachments(ctx context.Context, model models.IDModel) ([]models.AttachmentModel, error) {
+	res := make([]models.AttachmentModel, 0)
+	owner, err := c.itemOwner(ctx, model.UUID, model.Type, model.ID, datatypes.ViewerRole)
+	if err != nil {
+		return res, err
+	}
+	model.UUID = owner
+	ok, err := c.RecordIsExists(ctx, model)
+	if err != nil {
+		return res, err
+	}
+	if !ok {
+		return res, customerror.ErrRecordNotFound
+	}
+	q := `SELECT id, type, item_id, filename, mime_type, size, sha256, created_at FROM attachments
+	WHERE uuid = $1 AND type = $2 AND item_id = $3 ORDER BY id;`
+	rows, err := c.conn().Query(ctx, q, model.UUID, model.Type, model.ID)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		attachment := models.AttachmentModel{}
+		err := rows.Scan(&attachment.ID, &attachment.Type, &attachment.ItemID, &attachment.Filename,
+			&attachment.MimeType, &attachment.Size, &attachment.SHA256, &attachment.CreatedAt)
+		if err != nil {
+			return res, err
+		}
+		res = append(res, attachment)
+	}
+	return res, rows.Err()
+}
+
+// SelectAttachment - get the attachment of the current user or of the record of its collection.
+// The attachments of the deleted records are not available.
+func (c *ClientPostgres) SelectAttachment(ctx context.Context, model models.AttachmentReqModel) (models.AttachmentModel, error) {
+	res, owner, err := c.selectAttachment(ctx, model, datatypes.ViewerRole)
+	if err != nil {
+		return res, err
+	}
+	ok, err := c.RecordIsExists(ctx, models.IDModel{UUID: owner, ID: res.ItemID, Type: res.Type})
+	if err != nil {
+		return res, err
+	}
+	if !ok {
+		return res, customerror.ErrAttachmentNotFound
+	}
+	return res, nil
+}
+
+// ReadAttachmentChunk - get the part of the attachment starting at the offset.
+// Returns the empty chunk at the end of the data.
+func (c *ClientPostgres) ReadAttachmentChunk(ctx context.Context, model models.BinaryReadModel) ([]byte, error) {
+	attachment, err := c.SelectAttachment(ctx, models.AttachmentReqModel{UUID: model.UUID, ID: model.ID})
+	if err != nil {
+		return nil, err
+	}
+	return c.readBlob(ctx, attachment.SHA256, attachment.Size, model.Offset, int64(model.Limit))
+}
+
+// (c *ClientPostgres) selectAttachment - get the attachment and its owner if the user may access the record
+// of the attachment with the role.
+func (c *ClientPostgres) selectAttachment(ctx context.Context, model models.AttachmentReqModel, role int32) (models.AttachmentModel, string, error) {
+	res := models.AttachmentModel{}
+	var uuid string
+	q := `SELECT id, type, item_id, filename, mime_type, size, sha256, created_at, uuid FROM attachments WHERE id = $1;`
+	err := c.conn().QueryRow(ctx, q, model.ID).Scan(&res.ID, &res.Type, &res.ItemID, &res.Filename,
+		&res.MimeType, &res.Size, &res.SHA256, &res.CreatedAt, &uuid)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return res, "", customerror.ErrAttachmentNotFound
+	}
+	if err != nil {
+		return res, "", err
+	}
+	owner, err := c.itemOwner(ctx, model.UUID, res.Type, res.ItemID, role)
+	if err != nil {
+		return res, "", err
+	}
+	if owner != uuid {
+		return res, "", customerror.ErrAttachmentNotFound
+	}
+	return res, owner, nil
+}
+
+// DeleteAttachment - deletes the attachment of the current user or of the record of its collection.
+// Its content is deleted by the collector.
+func (c *ClientPostgres) DeleteAttachment(ctx context.Context, model models.AttachmentReqModel) error {
+	_, owner, err := c.selectAttachment(ctx, model, datatypes.EditorRole)
+	if err != nil {
+		return err
+	}
+	q := `DELETE FROM attachments WHERE id = $1 AND uuid = $2;`
+	tag, err := c.conn().Exec(ctx, q, model.ID, owner)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return customerror.ErrAttachmentNotFound
+	}
+	return nil
+}
+
+// (c *ClientPostgres) checkQuota - checks that the data of the size fits into the storage quota of the user.
+// The usage includes the binary records except the replaced one, the attachments and the unfinished uploads.
+// Must be called in the transaction: the user is locked until it ends, so the concurrent uploads are checked one by one.
+func (c *ClientPostgres) checkQuota(ctx context.Context, uuid string, size int64, replaced int32) error {
+	if c.Quota <= 0 {
+		return nil
+	}
+	q := `SELECT 1 FROM users WHERE uuid = $1 FOR UPDATE;`
+	if _, err := c.conn().Exec(ctx, q, uuid); err != nil {
+		return err
+	}
+	var usage int64
+	q = `SELECT (SELECT COALESCE(sum(size), 0) FROM binary_data WHERE uuid = $1 AND id <> $2)::bigint
+	+ (SELECT COALESCE(sum(size), 0) FROM attachments WHERE uuid = $1)::bigint
+	+ (SELECT COALESCE(sum(size), 0) FROM binary_uploads WHERE uuid = $1)::bigint;`
+	if err := c.conn().QueryRow(ctx, q, uuid, replaced).Scan(&usage); err != nil {
+		return err
+	}
+	if usage+size > c.Quota {
+		return customerror.ErrQuotaExceeded
+	}
+	return nil
+}
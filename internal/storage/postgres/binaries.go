@@ -0,0 +1,219 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/convertuuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// CreateUpload - creates the upload of the binary data and returns its id.
+// If the data of the existing record is replaced or the file is attached to the record, the record must exist.
+// The size of the upload is counted in the storage quota of the user until the upload is finished or deleted.
+func (c *ClientPostgres) CreateUpload(ctx context.Context, model models.BinaryUploadModel) (string, error) {
+	var id [16]byte
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		replaced := int32(0)
+		if model.ItemID != 0 {
+			owner, err := tc.itemOwner(ctx, model.UUID, model.TechData.Type, model.ItemID, datatypes.EditorRole)
+			if err != nil {
+				return err
+			}
+			ok, err := tc.RecordIsExists(ctx, models.IDModel{UUID: owner, ID: model.ItemID, Type: model.TechData.Type})
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return customerror.ErrRecordNotFound
+			}
+			if !model.Attachment {
+				replaced = model.ItemID
+			}
+		}
+		if err := tc.checkQuota(ctx, model.UUID, model.Size, replaced); err != nil {
+			return err
+		}
+		q := `INSERT INTO binary_uploads(uuid, item_id, title, tag, comment, size, sha256, attachment, type, mime_type)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id;`
+		return tc.conn().QueryRow(ctx, q, model.UUID, model.ItemID, model.TechData.Title, model.TechData.Tag,
+			model.TechData.Comment, model.Size, model.SHA256, model.Attachment, model.TechData.Type, model.MimeType).Scan(&id)
+	})
+	if err != nil {
+		return "", err
+	}
+	return convertuuid.UUID(id).String(), nil
+}
+
+// SelectUpload - get the state of the upload of the current user.
+func (c *ClientPostgres) SelectUpload(ctx context.Context, model models.UploadReqModel) (models.BinaryUploadModel, error) {
+	res := models.BinaryUploadModel{ID: model.ID, UUID: model.UUID}
+	q := `SELECT item_id, COALESCE(title, ''), COALESCE(tag, ''), COALESCE(comment, ''), size, sha256, received, hash_state,
+	attachment, type, mime_type FROM binary_uploads WHERE id = $1 AND uuid = $2;`
+	err := c.conn().QueryRow(ctx, q, model.ID, model.UUID).Scan(&res.ItemID, &res.TechData.Title, &res.TechData.Tag,
+		&res.TechData.Comment, &res.Size, &res.SHA256, &res.Received, &res.HashState, &res.Attachment, &res.TechData.Type, &res.MimeType)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return res, customerror.ErrUploadNotFound
+	}
+	return res, err
+}
+
+// AppendUploadChunk - saves the chunk of the upload and returns the number of the received bytes.
+// The offset of the chunk must be equal to the number of the bytes received before it,
+// otherwise ErrInvalidOffset is returned with the number of the received bytes.
+func (c *ClientPostgres) AppendUploadChunk(ctx context.Context, model models.BinaryChunkModel) (int64, error) {
+	var received int64
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		var size int64
+		q := `SELECT size, received FROM binary_uploads WHERE id = $1 AND uuid = $2 FOR UPDATE;`
+		err := tc.conn().QueryRow(ctx, q, model.UploadID, model.UUID).Scan(&size, &received)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return customerror.ErrUploadNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if model.Offset != received {
+			return customerror.ErrInvalidOffset
+		}
+		if received+int64(len(model.Data)) > size {
+			return customerror.ErrInvalidUploadSize
+		}
+		q = `INSERT INTO binary_upload_chunks(upload_id, pos, data) VALUES ($1, $2, $3);`
+		if _, err := tc.conn().Exec(ctx, q, model.UploadID, model.Offset, model.Data); err != nil {
+			return err
+		}
+		received += int64(len(model.Data))
+		q = `UPDATE binary_uploads SET received = $2, hash_state = $3 WHERE id = $1;`
+		_, err = tc.conn().Exec(ctx, q, model.UploadID, received, model.HashState)
+		return err
+	})
+	return received, err
+}
+
+// FinishUpload - writes the received data to the record or to the attachment and deletes the upload.
+// The data is streamed from the chunks in the database to the blob store.
+func (c *ClientPostgres) FinishUpload(ctx context.Context, model models.UploadReqModel) (models.InsertRespModel, error) {
+	res := models.InsertRespModel{}
+	upload, err := c.SelectUpload(ctx, model)
+	if err != nil {
+		return res, err
+	}
+	if upload.Received != upload.Size {
+		return res, customerror.ErrInvalidUploadSize
+	}
+	content := &uploadReader{ctx: ctx, c: c, id: upload.ID}
+	if err := c.putBlob(ctx, upload.SHA256, content, upload.Size); err != nil {
+		return res, err
+	}
+	err = c.inTx(ctx, func(tc *ClientPostgres) error {
+		if upload.Attachment {
+			id, err := tc.insertAttachment(ctx, upload)
+			if err != nil {
+				return err
+			}
+			res.ID = id
+			res.Title = upload.TechData.Title
+			return tc.DeleteUpload(ctx, model)
+		}
+		id := upload.ItemID
+		owner := upload.UUID
+		if id == 0 {
+			q := `INSERT INTO binary_data(uuid, type, title, tag, comment, size, sha256, seal_version)
+			SELECT uuid, $2, title, tag, comment, size, sha256, $3 FROM binary_uploads WHERE id = $1 RETURNING id;`
+			err := tc.conn().QueryRow(ctx, q, upload.ID, datatypes.BinaryDataType, tc.sealVersion()).Scan(&id)
+			if errors.Is(err, pgx.ErrNoRows) {
+				return customerror.ErrUploadNotFound
+			}
+			if err != nil {
+				return err
+			}
+		} else {
+			var err error
+			owner, err = tc.itemOwner(ctx, upload.UUID, datatypes.BinaryDataType, id, datatypes.EditorRole)
+			if err != nil {
+				return err
+			}
+			q := `UPDATE binary_data b SET title = u.title, tag = u.tag, comment = u.comment,
+			size = u.size, sha256 = u.sha256 FROM binary_uploads u WHERE u.id = $1 AND b.id = $2 AND b.uuid = $3 AND b.deleted = false;`
+			tag, err := tc.conn().Exec(ctx, q, upload.ID, id, owner)
+			if err != nil {
+				return err
+			}
+			if tag.RowsAffected() == 0 {
+				return customerror.ErrRecordNotFound
+			}
+		}
+		if err := tc.setItemTags(ctx, owner, datatypes.BinaryDataType, id, upload.TechData.Tag); err != nil {
+			return err
+		}
+		res.ID = id
+		res.Title = upload.TechData.Title
+		return tc.DeleteUpload(ctx, model)
+	})
+	return res, err
+}
+
+// DeleteUpload - deletes the upload with the received chunks.
+func (c *ClientPostgres) DeleteUpload(ctx context.Context, model models.UploadReqModel) error {
+	q := `DELETE FROM binary_uploads WHERE id = $1 AND uuid = $2;`
+	_, err := c.conn().Exec(ctx, q, model.ID, model.UUID)
+	return err
+}
+
+// DeleteExpiredUploads - deletes the unfinished uploads created before the time.
+func (c *ClientPostgres) DeleteExpiredUploads(ctx context.Context, expired time.Time) error {
+	q := `DELETE FROM binary_uploads WHERE created_at < $1;`
+	_, err := c.conn().Exec(ctx, q, expired)
+	return err
+}
+
+// SelectBinaryInfo - get the binary record without the data.
+func (c *ClientPostgres) SelectBinaryInfo(ctx context.Context, model models.IDModel) (models.RespBinaryModel, error) {
+	res := models.RespBinaryModel{}
+	owner, err := c.itemOwner(ctx, model.UUID, datatypes.BinaryDataType, model.ID, datatypes.ViewerRole)
+	if err != nil {
+		return res, err
+	}
+	var version int16
+	q := `SELECT id, size, sha256, COALESCE(title, ''), COALESCE(tag, ''), COALESCE(comment, ''), type, custom_fields,
+	seal_version FROM binary_data WHERE id = $1 AND uuid = $2 AND deleted = false;`
+	err = c.conn().QueryRow(ctx, q, model.ID, owner).Scan(&res.Data.ID, &res.Data.Size, &res.Data.SHA256,
+		&res.TechData.Title, &res.TechData.Tag, &res.TechData.Comment, &res.TechData.Type, &res.TechData.Fields, &version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return res, customerror.ErrRecordNotFound
+	}
+	if err != nil {
+		return res, err
+	}
+	err = c.openFields(ctx, owner, recordRef(datatypes.BinaryDataType, model.ID), version, res.TechData.Fields)
+	if err != nil {
+		return res, err
+	}
+	res.TechData.ID = res.Data.ID
+	return res, nil
+}
+
+// ReadBinaryChunk - get the part of the binary data of the record starting at the offset.
+// Only the requested part is read from the blob store. Returns the empty chunk at the end of the data.
+func (c *ClientPostgres) ReadBinaryChunk(ctx context.Context, model models.BinaryReadModel) ([]byte, error) {
+	var size int64
+	var sum string
+	owner, err := c.itemOwner(ctx, model.UUID, datatypes.BinaryDataType, model.ID, datatypes.ViewerRole)
+	if err != nil {
+		return nil, err
+	}
+	q := `SELECT size, sha256 FROM binary_data WHERE id = $1 AND uuid = $2 AND deleted = false;`
+	err = c.conn().QueryRow(ctx, q, model.ID, owner).Scan(&size, &sum)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, customerror.ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.readBlob(ctx, sum, size, model.Offset, int64(model.Limit))
+}
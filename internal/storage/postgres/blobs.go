@@ -0,0 +1,264 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"time"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/tools/chunktools"
+	"github.com/BillyBones007/pwdm_server/internal/tools/compresstools"
+	"github.com/jackc/pgx/v5"
+)
+
+// (c *ClientPostgres) putBlob - registers the content and saves it to the blob store.
+// The registration is committed before the content is saved and outside the current transaction,
+// so the collector does not delete the content while the record referring to it is written.
+// The content is compressed in frames; if no frame shrinks, it is stored as is under its own SHA-256.
+// The content is not encrypted: it is shared by the records of the different owners with the same content,
+// so it is protected by the encryption of the blob store itself.
+func (c *ClientPostgres) putBlob(ctx context.Context, sum string, r io.Reader, size int64) error {
+	if size == 0 {
+		// the empty content is not stored
+		return nil
+	}
+	var objectKey string
+	q := `INSERT INTO blobs(sha256, size) VALUES ($1, $2) ON CONFLICT (sha256) DO UPDATE SET created_at = now() RETURNING object_key;`
+	if err := c.Pool.QueryRow(ctx, q, sum, size).Scan(&objectKey); err != nil {
+		return err
+	}
+	if objectKey != "" {
+		// the same content is already stored
+		return nil
+	}
+
+	tmp, err := os.CreateTemp("", "blob-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	content := chunktools.NewHash()
+	stored := sha256.New()
+	frames, n, err := compresstools.CompressFrames(io.TeeReader(r, hashWriter{content}), io.MultiWriter(tmp, stored))
+	if err != nil {
+		return err
+	}
+	if n != size || content.Sum() != sum {
+		return customerror.ErrBlobChecksum
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	encoding := compresstools.FormatRaw
+	objectKey = sum
+	var index []byte
+	if compresstools.Compressed(frames) {
+		encoding = compresstools.FormatGzip
+		objectKey = hex.EncodeToString(stored.Sum(nil))
+		index = compresstools.EncodeFrames(frames)
+	}
+	storedSize := compresstools.StoredSize(frames)
+	if err := c.Blobs.Put(ctx, objectKey, tmp, storedSize); err != nil {
+		return err
+	}
+	q = `UPDATE blobs SET object_key = $2, stored_size = $3, encoding = $4, frames = $5 WHERE sha256 = $1;`
+	_, err = c.Pool.Exec(ctx, q, sum, objectKey, storedSize, int16(encoding), index)
+	return err
+}
+
+// (c *ClientPostgres) readBlob - reads the part of the content starting at the offset.
+// The content that is not moved to the blob store yet is read from the database.
+// Only the frames containing the part are read and decompressed.
+func (c *ClientPostgres) readBlob(ctx context.Context, sum string, size int64, offset int64, limit int64) ([]byte, error) {
+	if offset >= size || limit <= 0 {
+		return []byte{}, nil
+	}
+	if offset+limit > size {
+		limit = size - offset
+	}
+	var res []byte
+	q := `SELECT substring(data FROM $2::integer + 1 FOR $3::integer) FROM blob_pending WHERE sha256 = $1;`
+	err := c.conn().QueryRow(ctx, q, sum, offset, limit).Scan(&res)
+	if err == nil {
+		return res, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	var objectKey string
+	var encoding int16
+	var index []byte
+	q = `SELECT object_key, encoding, frames FROM blobs WHERE sha256 = $1;`
+	err = c.conn().QueryRow(ctx, q, sum).Scan(&objectKey, &encoding, &index)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && objectKey == "") {
+		return nil, customerror.ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if byte(encoding) == compresstools.FormatRaw {
+		r, err := c.Blobs.Get(ctx, objectKey, offset, limit)
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return io.ReadAll(r)
+	}
+	if byte(encoding) != compresstools.FormatGzip {
+		return nil, customerror.ErrUnknownFormat
+	}
+
+	frames, err := compresstools.DecodeFrames(index)
+	if err != nil {
+		return nil, err
+	}
+	start, storedSize, first := compresstools.FrameRange(frames, offset, limit)
+	r, err := c.Blobs.Get(ctx, objectKey, start, storedSize)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	data, err := compresstools.ReadFrames(r, frames, first, storedSize)
+	if err != nil {
+		return nil, err
+	}
+	skip := offset - int64(first)*compresstools.FrameSize
+	if skip+limit > int64(len(data)) {
+		return nil, customerror.ErrUnknownFormat
+	}
+	return data[skip : skip+limit], nil
+}
+
+// hashWriter - writer that adds the data to the hash.
+type hashWriter struct {
+	h *chunktools.Hash
+}
+
+// Write - adds the data to the hash.
+func (w hashWriter) Write(p []byte) (int, error) {
+	w.h.Write(p)
+	return len(p), nil
+}
+
+// CollectBlobs - deletes the contents registered before the time that no binary record or attachment refers to.
+// Returns the number of the deleted contents.
+func (c *ClientPostgres) CollectBlobs(ctx context.Context, before time.Time) (int, error) {
+	q := `SELECT sha256 FROM blobs b WHERE created_at < $1
+	AND NOT EXISTS (SELECT 1 FROM binary_data d WHERE d.sha256 = b.sha256)
+	AND NOT EXISTS (SELECT 1 FROM attachments a WHERE a.sha256 = b.sha256);`
+	rows, err := c.conn().Query(ctx, q, before)
+	if err != nil {
+		return 0, err
+	}
+	sums := make([]string, 0)
+	for rows.Next() {
+		var sum string
+		if err := rows.Scan(&sum); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		sums = append(sums, sum)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for _, sum := range sums {
+		// the row stays locked until the content is deleted, so the concurrent registration waits for it
+		err := c.inTx(ctx, func(tc *ClientPostgres) error {
+			var objectKey string
+			q := `DELETE FROM blobs b WHERE sha256 = $1 AND created_at < $2
+			AND NOT EXISTS (SELECT 1 FROM binary_data d WHERE d.sha256 = b.sha256)
+			AND NOT EXISTS (SELECT 1 FROM attachments a WHERE a.sha256 = b.sha256) RETURNING object_key;`
+			err := tc.conn().QueryRow(ctx, q, sum, before).Scan(&objectKey)
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			count++
+			// the stored content of the other registered content is not deleted
+			var shared bool
+			q = `SELECT EXISTS(SELECT 1 FROM blobs WHERE object_key = $1);`
+			if err := tc.conn().QueryRow(ctx, q, objectKey).Scan(&shared); err != nil {
+				return err
+			}
+			if objectKey == "" || shared {
+				return nil
+			}
+			return tc.Blobs.Delete(ctx, objectKey)
+		})
+		if err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+// MovePendingBlobs - moves the contents left in the database by the migration to the blob store.
+// Returns the number of the moved contents.
+func (c *ClientPostgres) MovePendingBlobs(ctx context.Context) (int, error) {
+	count := 0
+	for {
+		var sum string
+		var data []byte
+		q := `SELECT sha256, data FROM blob_pending ORDER BY sha256 LIMIT 1;`
+		err := c.conn().QueryRow(ctx, q).Scan(&sum, &data)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return count, nil
+		}
+		if err != nil {
+			return count, err
+		}
+		if err := c.putBlob(ctx, sum, bytes.NewReader(data), int64(len(data))); err != nil {
+			return count, err
+		}
+		q = `DELETE FROM blob_pending WHERE sha256 = $1;`
+		if _, err := c.conn().Exec(ctx, q, sum); err != nil {
+			return count, err
+		}
+		count++
+	}
+}
+
+// uploadReader - reader of the received chunks of the upload in the order of their offsets.
+// Only one chunk is loaded from the database at a time.
+type uploadReader struct {
+	ctx    context.Context
+	c      *ClientPostgres
+	id     string
+	offset int64
+	buf    []byte
+}
+
+// Read - reads the data, loads the next chunk when the current one is read.
+func (u *uploadReader) Read(p []byte) (int, error) {
+	if len(u.buf) == 0 {
+		q := `SELECT data FROM binary_upload_chunks WHERE upload_id = $1 AND pos = $2;`
+		err := u.c.conn().QueryRow(u.ctx, q, u.id, u.offset).Scan(&u.buf)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, io.EOF
+		}
+		if err != nil {
+			return 0, err
+		}
+		if len(u.buf) == 0 {
+			return 0, io.EOF
+		}
+	}
+	n := copy(p, u.buf)
+	u.buf = u.buf[n:]
+	u.offset += int64(n)
+	return n, nil
+}
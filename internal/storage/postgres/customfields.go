@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+)
+
+// SetCustomFields - replaces the custom fields of the record. The order of the fields is kept,
+// the values of the hidden fields are encrypted.
+func (c *ClientPostgres) SetCustomFields(ctx context.Context, model models.CustomFieldsModel) error {
+	table, ok := dataTables[model.Type]
+	if !ok {
+		return customerror.ErrUnknownDataType
+	}
+	owner, err := c.itemOwner(ctx, model.UUID, model.Type, model.ID, datatypes.EditorRole)
+	if err != nil {
+		return err
+	}
+	// the fields of the caller are not changed by the encryption
+	fields := make([]models.CustomFieldModel, len(model.Fields))
+	copy(fields, model.Fields)
+	return c.inTx(ctx, func(tc *ClientPostgres) error {
+		if err := tc.upgradeRecord(ctx, model.Type, model.ID, owner); err != nil {
+			return err
+		}
+		if err := tc.sealSecrets(ctx, owner, recordRef(model.Type, model.ID), hiddenFields(fields)...); err != nil {
+			return err
+		}
+		data, err := json.Marshal(fields)
+		if err != nil {
+			return err
+		}
+
+		q := fmt.Sprintf(`UPDATE %s SET custom_fields = $3::jsonb WHERE id = $1 AND uuid = $2 AND deleted = false;`, table)
+		tag, err := tc.conn().Exec(ctx, q, model.ID, owner, string(data))
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return customerror.ErrRecordNotFound
+		}
+		return nil
+	})
+}
@@ -0,0 +1,515 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/compresstools"
+	"github.com/BillyBones007/pwdm_server/internal/tools/sealtools"
+	"github.com/jackc/pgx/v5"
+)
+
+// Secret columns. The value is encrypted with the data key of the owner,
+// the owner, the record and the column are authenticated with the value.
+const (
+	colPassword   = "log_pwd_data.password"
+	colTOTP       = "log_pwd_data.totp"
+	colCardNum    = "card_data.num"
+	colCardCVC    = "card_data.cvc"
+	colText       = "text_data.data_z"
+	colPrivateKey = "ssh_key_data.private_key"
+	colPassphrase = "ssh_key_data.passphrase"
+	colPassport   = "identity_data.passport_number"
+	colLicense    = "identity_data.license_number"
+	colNationalID = "identity_data.national_id"
+	colTaxID      = "identity_data.tax_id"
+)
+
+// Prefix of the column of the value of the hidden custom field, the name of the field follows it.
+const colCustomField = "custom_fields."
+
+// Text columns of the secrets grouped by the tables, the text notes are stored as the payload.
+var secretTables = [][]string{
+	{colPassword, colTOTP},
+	{colCardNum, colCardCVC},
+	{colPrivateKey, colPassphrase},
+	{colPassport, colLicense, colNationalID, colTaxID},
+}
+
+// Versions of the encryption of the record stored in its seal_version column.
+const (
+	// the values only look encrypted, they are checked by decrypting them with the owner authenticated
+	sealUnknown int16 = -1
+	sealPlain   int16 = 0
+	// the secret columns are authenticated with the owner, the custom fields are plain
+	sealOwner int16 = 1
+	// the secret columns and the hidden custom fields are authenticated with the owner and the record
+	sealRecord int16 = 2
+)
+
+// Number of the records encrypted in one transaction by SealPlainSecrets.
+const sealBatchSize = 100
+
+// keyCache - cache of the unwrapped data keys of the users. The data key does not change,
+// rewrapping it with the other master key does not invalidate the cache.
+type keyCache struct {
+	mu   sync.RWMutex
+	keys map[string][]byte
+}
+
+// newKeyCache - constructor keyCache.
+func newKeyCache() *keyCache {
+	return &keyCache{keys: make(map[string][]byte)}
+}
+
+// get - returns the cached data key of the user.
+func (k *keyCache) get(uuid string) ([]byte, bool) {
+	if k == nil {
+		return nil, false
+	}
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	key, ok := k.keys[uuid]
+	return key, ok
+}
+
+// put - caches the data key of the user.
+func (k *keyCache) put(uuid string, key []byte) {
+	if k == nil {
+		return
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.keys[uuid] = key
+}
+
+// secret - pointer to the value of the secret column.
+type secret struct {
+	column string
+	value  *string
+}
+
+// recordRef - returns the reference of the record authenticated with its secrets.
+func recordRef(dataType int32, id int32) string {
+	return fmt.Sprintf("%d/%d", dataType, id)
+}
+
+// secretAAD - returns the additional data authenticated with the value of the secret column of the version.
+func secretAAD(version int16, uuid, ref, column string) []byte {
+	if version == sealRecord {
+		return []byte(uuid + "/" + ref + "/" + column)
+	}
+	return []byte(uuid + "/" + column)
+}
+
+// hiddenFields - returns the values of the hidden custom fields.
+func hiddenFields(fields []models.CustomFieldModel) []secret {
+	res := make([]secret, 0)
+	for i := range fields {
+		if fields[i].Type == datatypes.HiddenFieldType {
+			res = append(res, secret{colCustomField + fields[i].Name, &fields[i].Value})
+		}
+	}
+	return res
+}
+
+// (c *ClientPostgres) sealVersion - returns the version of the encryption of the written records.
+func (c *ClientPostgres) sealVersion() int16 {
+	if c.Keys == nil {
+		return sealPlain
+	}
+	return sealRecord
+}
+
+// (c *ClientPostgres) dataKey - returns the data key of the user. The missing key is created
+// and wrapped with the current master key.
+func (c *ClientPostgres) dataKey(ctx context.Context, uuid string) ([]byte, error) {
+	if c.Keys == nil {
+		return nil, customerror.ErrNoMasterKey
+	}
+	if key, ok := c.dataKeys.get(uuid); ok {
+		return key, nil
+	}
+	var version int32
+	var wrapped []byte
+	// the key is registered outside of the current transaction, so the concurrent requests
+	// of the user get the same key and rolling back the request does not lose it
+	q := `SELECT version, wrapped FROM user_keys WHERE uuid = $1;`
+	err := c.Pool.QueryRow(ctx, q, uuid).Scan(&version, &wrapped)
+	if errors.Is(err, pgx.ErrNoRows) {
+		var key []byte
+		key, err = sealtools.NewKey()
+		if err != nil {
+			return nil, err
+		}
+		version, wrapped, err = c.Keys.Wrap(ctx, key, []byte(uuid))
+		if err != nil {
+			return nil, err
+		}
+		// the key of the concurrent request is returned if it is registered first
+		q := `INSERT INTO user_keys(uuid, version, wrapped) VALUES ($1, $2, $3)
+		ON CONFLICT (uuid) DO UPDATE SET uuid = EXCLUDED.uuid RETURNING version, wrapped;`
+		err = c.Pool.QueryRow(ctx, q, uuid, version, wrapped).Scan(&version, &wrapped)
+	}
+	if err != nil {
+		return nil, err
+	}
+	key, err := c.Keys.Unwrap(ctx, version, wrapped, []byte(uuid))
+	if err != nil {
+		return nil, err
+	}
+	c.dataKeys.put(uuid, key)
+	return key, nil
+}
+
+// (c *ClientPostgres) sealSecrets - encrypts the values of the secret columns of the record in place.
+// The empty values stay empty. Without the key provider the values are stored as is.
+func (c *ClientPostgres) sealSecrets(ctx context.Context, uuid, ref string, secrets ...secret) error {
+	if c.Keys == nil || len(secrets) == 0 {
+		return nil
+	}
+	key, err := c.dataKey(ctx, uuid)
+	if err != nil {
+		return err
+	}
+	for _, s := range secrets {
+		sealed, err := sealtools.SealString(key, *s.value, secretAAD(sealRecord, uuid, ref, s.column))
+		if err != nil {
+			return err
+		}
+		*s.value = sealed
+	}
+	return nil
+}
+
+// (c *ClientPostgres) openSecrets - decrypts the values of the secret columns of the record
+// sealed with the version in place. The plain values are returned as is.
+func (c *ClientPostgres) openSecrets(ctx context.Context, uuid, ref string, version int16, secrets ...secret) error {
+	if version == sealPlain {
+		return nil
+	}
+	var key []byte
+	for _, s := range secrets {
+		if !sealtools.IsSealedString(*s.value) {
+			continue
+		}
+		if key == nil {
+			var err error
+			if key, err = c.dataKey(ctx, uuid); err != nil {
+				return err
+			}
+		}
+		value, err := sealtools.OpenString(key, *s.value, secretAAD(version, uuid, ref, s.column))
+		// the plain value of the unknown version only looks encrypted
+		if version == sealUnknown && errors.Is(err, customerror.ErrDecrypt) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		*s.value = value
+	}
+	return nil
+}
+
+// (c *ClientPostgres) openFields - decrypts the hidden custom fields of the record sealed with the version in place.
+func (c *ClientPostgres) openFields(ctx context.Context, uuid, ref string, version int16, fields []models.CustomFieldModel) error {
+	// the custom fields were not encrypted before the record was authenticated
+	if version == sealOwner {
+		return nil
+	}
+	return c.openSecrets(ctx, uuid, ref, version, hiddenFields(fields)...)
+}
+
+// (c *ClientPostgres) sealPayload - encrypts the payload of the secret column of the record.
+// Without the key provider the payload is stored as is.
+func (c *ClientPostgres) sealPayload(ctx context.Context, uuid, ref, column string, payload []byte) ([]byte, error) {
+	if c.Keys == nil {
+		return payload, nil
+	}
+	key, err := c.dataKey(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	return sealtools.SealBytes(key, payload, secretAAD(sealRecord, uuid, ref, column))
+}
+
+// (c *ClientPostgres) openPayload - decrypts the payload of the secret column of the record sealed with the version.
+// The plain payload is returned as is.
+func (c *ClientPostgres) openPayload(ctx context.Context, uuid, ref string, version int16, column string, payload []byte) ([]byte, error) {
+	if version == sealPlain || !sealtools.IsSealedBytes(payload) {
+		return payload, nil
+	}
+	key, err := c.dataKey(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+	res, err := sealtools.OpenBytes(key, payload, secretAAD(version, uuid, ref, column))
+	// the plain payload of the unknown version only looks encrypted
+	if version == sealUnknown && errors.Is(err, customerror.ErrDecrypt) {
+		return payload, nil
+	}
+	return res, err
+}
+
+// (c *ClientPostgres) nextID - returns the id of the new record of the table, so its secrets are sealed before the insert.
+func (c *ClientPostgres) nextID(ctx context.Context, table string) (int32, error) {
+	var id int32
+	err := c.conn().QueryRow(ctx, `SELECT nextval(pg_get_serial_sequence($1, 'id'));`, table).Scan(&id)
+	return id, err
+}
+
+// (c *ClientPostgres) upgradeRecord - encrypts the secrets of the record of the owner with the current version,
+// so the record written partially keeps one version. Returns customerror.ErrRecordNotFound
+// if the owner has no such record.
+func (c *ClientPostgres) upgradeRecord(ctx context.Context, dataType int32, id int32, owner string) error {
+	return c.resealRecord(ctx, dataType, id, owner, owner)
+}
+
+// (c *ClientPostgres) resealRecord - encrypts the secrets of the record with the data key of the new owner
+// and the current version. The secrets are authenticated with the owner, so they cannot be moved
+// to the other owner as is. Returns customerror.ErrRecordNotFound if the old owner has no such record.
+func (c *ClientPostgres) resealRecord(ctx context.Context, dataType int32, id int32, from string, to string) error {
+	table, ok := dataTables[dataType]
+	if !ok {
+		return customerror.ErrUnknownDataType
+	}
+	var columns []string
+	for _, group := range secretTables {
+		if strings.Split(group[0], ".")[0] == table {
+			columns = group
+		}
+	}
+	names := []string{"seal_version", "custom_fields"}
+	for _, column := range columns {
+		names = append(names, strings.Split(column, ".")[1])
+	}
+	var version int16
+	var fields []models.CustomFieldModel
+	var data *string
+	var payload []byte
+	values := make([]*string, len(columns))
+	dest := []any{&version, &fields}
+	for i := range values {
+		dest = append(dest, &values[i])
+	}
+	if table == "text_data" {
+		names = append(names, "data", "data_z")
+		dest = append(dest, &data, &payload)
+	}
+	q := fmt.Sprintf(`SELECT %s FROM %s WHERE id = $1 AND uuid = $2 FOR UPDATE;`, strings.Join(names, ", "), table)
+	err := c.conn().QueryRow(ctx, q, id, from).Scan(dest...)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return customerror.ErrRecordNotFound
+	}
+	if err != nil {
+		return err
+	}
+	current := c.sealVersion()
+	if from == to && version == current {
+		return nil
+	}
+
+	ref := recordRef(dataType, id)
+	secrets := make([]secret, 0, len(columns))
+	for i, column := range columns {
+		// the missing values stay missing
+		if values[i] != nil {
+			secrets = append(secrets, secret{column, values[i]})
+		}
+	}
+	if err := c.openSecrets(ctx, from, ref, version, secrets...); err != nil {
+		return err
+	}
+	if err := c.openFields(ctx, from, ref, version, fields); err != nil {
+		return err
+	}
+	if err := c.sealSecrets(ctx, to, ref, secrets...); err != nil {
+		return err
+	}
+	if err := c.sealSecrets(ctx, to, ref, hiddenFields(fields)...); err != nil {
+		return err
+	}
+	if fields == nil {
+		fields = make([]models.CustomFieldModel, 0)
+	}
+	encoded, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	sets := []string{"seal_version = $2", "custom_fields = $3::jsonb"}
+	args := []any{id, current, string(encoded)}
+	for i, column := range columns {
+		args = append(args, values[i])
+		sets = append(sets, fmt.Sprintf("%s = $%d", strings.Split(column, ".")[1], len(args)))
+	}
+	if table == "text_data" {
+		size := 0
+		// the notes stored before the compression are compressed
+		if data != nil {
+			payload = compresstools.Encode([]byte(*data))
+			size = len(*data)
+		} else if payload != nil {
+			if payload, err = c.openPayload(ctx, from, ref, version, colText, payload); err != nil {
+				return err
+			}
+		}
+		if payload != nil {
+			if payload, err = c.sealPayload(ctx, to, ref, colText, payload); err != nil {
+				return err
+			}
+		}
+		args = append(args, payload, size)
+		sets = append(sets, "data = NULL", fmt.Sprintf("data_z = $%d", len(args)-1),
+			fmt.Sprintf("size = COALESCE(size, $%d)", len(args)))
+	}
+	q = fmt.Sprintf(`UPDATE %s SET %s WHERE id = $1;`, table, strings.Join(sets, ", "))
+	_, err = c.conn().Exec(ctx, q, args...)
+	return err
+}
+
+// SealPlainSecrets - encrypts the secrets of the records stored with the older versions of the encryption
+// or before the encryption was enabled. It is not a change of the records, so they are not synchronized again.
+// Returns the number of the encrypted records.
+func (c *ClientPostgres) SealPlainSecrets(ctx context.Context) (int, error) {
+	if c.Keys == nil {
+		return 0, nil
+	}
+	total := 0
+	for _, dataType := range collectionTypes {
+		for {
+			n, err := c.sealPlainBatch(ctx, dataType)
+			total += n
+			if err != nil {
+				return total, err
+			}
+			if n == 0 {
+				break
+			}
+		}
+	}
+	return total, nil
+}
+
+// (c *ClientPostgres) sealPlainBatch - encrypts the batch of the records of the type stored with the older version.
+func (c *ClientPostgres) sealPlainBatch(ctx context.Context, dataType int32) (int, error) {
+	n := 0
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		if _, err := tc.conn().Exec(ctx, `SET LOCAL pwdm.skip_changes = 'on';`); err != nil {
+			return err
+		}
+		q := fmt.Sprintf(`SELECT id, uuid FROM %s WHERE seal_version <> $1 ORDER BY id LIMIT %d FOR UPDATE;`,
+			dataTables[dataType], sealBatchSize)
+		rows, err := tc.conn().Query(ctx, q, tc.sealVersion())
+		if err != nil {
+			return err
+		}
+		type record struct {
+			id   int32
+			uuid string
+		}
+		records := make([]record, 0, sealBatchSize)
+		for rows.Next() {
+			r := record{}
+			if err := rows.Scan(&r.id, &r.uuid); err != nil {
+				rows.Close()
+				return err
+			}
+			records = append(records, r)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		for _, r := range records {
+			if err := tc.upgradeRecord(ctx, dataType, r.id, r.uuid); err != nil {
+				return err
+			}
+		}
+		n = len(records)
+		return nil
+	})
+	return n, err
+}
+
+// RewrapDataKeys - wraps the batch of the data keys wrapped with the old versions of the master key
+// with the current version. The data keys and the secrets do not change, so the job is resumable
+// at any point. Returns the number of the rewrapped keys, 0 - all keys use the current version.
+func (c *ClientPostgres) RewrapDataKeys(ctx context.Context, limit int) (int, error) {
+	if c.Keys == nil {
+		return 0, nil
+	}
+	n := 0
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		// the keys locked by the other server are rewrapped by it
+		q := `SELECT uuid, version, wrapped FROM user_keys WHERE version < $1 ORDER BY uuid LIMIT $2 FOR UPDATE SKIP LOCKED;`
+		rows, err := tc.conn().Query(ctx, q, tc.Keys.CurrentVersion(), limit)
+		if err != nil {
+			return err
+		}
+		type record struct {
+			uuid    string
+			version int32
+			wrapped []byte
+		}
+		records := make([]record, 0, limit)
+		for rows.Next() {
+			r := record{}
+			if err := rows.Scan(&r.uuid, &r.version, &r.wrapped); err != nil {
+				rows.Close()
+				return err
+			}
+			records = append(records, r)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		q = `UPDATE user_keys SET version = $2, wrapped = $3 WHERE uuid = $1;`
+		for _, r := range records {
+			key, err := tc.Keys.Unwrap(ctx, r.version, r.wrapped, []byte(r.uuid))
+			if err != nil {
+				return err
+			}
+			version, wrapped, err := tc.Keys.Wrap(ctx, key, []byte(r.uuid))
+			if err != nil {
+				return err
+			}
+			if _, err := tc.conn().Exec(ctx, q, r.uuid, version, wrapped); err != nil {
+				return err
+			}
+		}
+		n = len(records)
+		return nil
+	})
+	return n, err
+}
+
+// SelectKeyVersions - get the number of the data keys wrapped with each version of the master key.
+// The version can be retired when no data keys use it.
+func (c *ClientPostgres) SelectKeyVersions(ctx context.Context) ([]models.KeyVersionModel, error) {
+	res := make([]models.KeyVersionModel, 0)
+	q := `SELECT version, count(*) FROM user_keys GROUP BY version ORDER BY version;`
+	rows, err := c.conn().Query(ctx, q)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		v := models.KeyVersionModel{}
+		if err := rows.Scan(&v.Version, &v.Keys); err != nil {
+			return res, err
+		}
+		v.Current = c.Keys != nil && v.Version == c.Keys.CurrentVersion()
+		res = append(res, v)
+	}
+	return res, rows.Err()
+}
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/cardtools"
+)
+
+// SetExpiry - sets the expiry date of the record, the zero date removes it.
+// The expiry date of the card is derived from its validity period and cannot be set.
+func (c *ClientPostgres) SetExpiry(ctx context.Context, model models.ExpiryModel) error {
+	if model.Type == datatypes.CardDataType {
+		return customerror.ErrExpiryDerived
+	}
+	table, ok := dataTables[model.Type]
+	if !ok {
+		return customerror.ErrUnknownDataType
+	}
+	owner, err := c.itemOwner(ctx, model.UUID, model.Type, model.ID, datatypes.EditorRole)
+	if err != nil {
+		return err
+	}
+	q := fmt.Sprintf(`UPDATE %s SET expires_at = $3 WHERE id = $1 AND uuid = $2 AND deleted = false;`, table)
+	tag, err := c.conn().Exec(ctx, q, model.ID, owner, nullDate(model.ExpiresAt))
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return customerror.ErrRecordNotFound
+	}
+	return nil
+}
+
+// SelectExpiring - get the records of the current user and its collections that expire on or before the time,
+// including the expired ones. The records are sorted by the expiry date.
+func (c *ClientPostgres) SelectExpiring(ctx context.Context, model models.ExpiringReqModel) ([]models.ExpiringItemModel, error) {
+	q := `SELECT uuid, id, type, title, tag, expires_at FROM items
+	WHERE ` + availableRecords("") + ` AND deleted = false AND expires_at IS NOT NULL AND expires_at <= $2
+	ORDER BY expires_at, type, id;`
+	return c.selectExpiringItems(ctx, q, model.UUID, model.Before)
+}
+
+// SelectDueReminders - get the records of all users that expire on or before the time
+// and have no reminder about their current expiry date yet. The record is returned for each user to remind:
+// the owner of the record or each member of the collection of the record.
+func (c *ClientPostgres) SelectDueReminders(ctx context.Context, before time.Time, limit int32) ([]models.ExpiringItemModel, error) {
+	q := `SELECT COALESCE(m.uuid, i.uuid), i.id, i.type, i.title, i.tag, i.expires_at FROM items i
+	LEFT JOIN collection_members m ON m.collection_id = i.collection_id
+	WHERE i.deleted = false AND i.expires_at IS NOT NULL AND i.expires_at <= $1
+	AND (i.collection_id IS NULL OR m.uuid IS NOT NULL)
+	AND NOT EXISTS (SELECT 1 FROM expiry_reminders r WHERE r.uuid = COALESCE(m.uuid, i.uuid) AND r.type = i.type
+	AND r.id = i.id AND r.expires_at = i.expires_at)
+	ORDER BY i.expires_at, i.type, i.id, 1 LIMIT $2;`
+	return c.selectExpiringItems(ctx, q, before, limit)
+}
+
+// MarkReminded - saves that the reminder about the current expiry date of the record is sent to the user.
+func (c *ClientPostgres) MarkReminded(ctx context.Context, model models.ExpiringItemModel) error {
+	q := `INSERT INTO expiry_reminders(uuid, type, id, expires_at) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING;`
+	_, err := c.conn().Exec(ctx, q, model.UUID, model.Type, model.ID, model.ExpiresAt)
+	return err
+}
+
+// selectExpiringItems - get the records with the expiry date by the query.
+func (c *ClientPostgres) selectExpiringItems(ctx context.Context, q string, args ...any) ([]models.ExpiringItemModel, error) {
+	res := make([]models.ExpiringItemModel, 0)
+	rows, err := c.conn().Query(ctx, q, args...)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		item := models.ExpiringItemModel{}
+		if err := rows.Scan(&item.UUID, &item.ID, &item.Type, &item.Title, &item.Tag, &item.ExpiresAt); err != nil {
+			return res, err
+		}
+		res = append(res, item)
+	}
+	return res, rows.Err()
+}
+
+// cardExpiry - returns the expiry date of the card derived from its validity period,
+// nil if the validity period is invalid.
+func cardExpiry(date string) *time.Time {
+	expiresAt, err := cardtools.ParseExpiry(date)
+	if err != nil {
+		return nil
+	}
+	return &expiresAt
+}
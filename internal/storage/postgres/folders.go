@@ -0,0 +1,264 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// folderIsExists - checks that the folder of the current user exists. The root folder always exists.
+func (c *ClientPostgres) folderIsExists(ctx context.Context, uuid string, id int32) (bool, error) {
+	if id == 0 {
+		return true, nil
+	}
+	var flag bool
+	q := `SELECT EXISTS(SELECT id FROM folders WHERE id = $1 AND uuid = $2);`
+	if err := c.conn().QueryRow(ctx, q, id, uuid).Scan(&flag); err != nil {
+		return flag, err
+	}
+	return flag, nil
+}
+
+// folderNameIsTaken - checks that the parent folder contains another folder with the name.
+func (c *ClientPostgres) folderNameIsTaken(ctx context.Context, model models.FolderModel) (bool, error) {
+	var flag bool
+	q := `SELECT EXISTS(SELECT id FROM folders WHERE uuid = $1 AND COALESCE(parent_id, 0) = $2 AND name = $3 AND id <> $4);`
+	if err := c.conn().QueryRow(ctx, q, model.UUID, model.ParentID, model.Name, model.ID).Scan(&flag); err != nil {
+		return flag, err
+	}
+	return flag, nil
+}
+
+// selectFolder - returns the folder of the current user.
+func (c *ClientPostgres) selectFolder(ctx context.Context, uuid string, id int32) (models.FolderModel, error) {
+	folder := models.FolderModel{UUID: uuid, ID: id}
+	q := `SELECT COALESCE(parent_id, 0), name FROM folders WHERE id = $1 AND uuid = $2;`
+	err := c.conn().QueryRow(ctx, q, id, uuid).Scan(&folder.ParentID, &folder.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return folder, customerror.ErrFolderNotFound
+	}
+	return folder, err
+}
+
+// subfolders - returns id of the folder and all its nested folders.
+func (c *ClientPostgres) subfolders(ctx context.Context, uuid string, id int32) ([]int32, error) {
+	res := make([]int32, 0)
+	q := `WITH RECURSIVE subtree AS (SELECT id FROM folders WHERE id = $1 AND uuid = $2
+	UNION ALL SELECT f.id FROM folders f JOIN subtree s ON f.parent_id = s.id) SELECT id FROM subtree;`
+	rows, err := c.conn().Query(ctx, q, id, uuid)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var folderID int32
+		if err := rows.Scan(&folderID); err != nil {
+			return res, err
+		}
+		res = append(res, folderID)
+	}
+	return res, rows.Err()
+}
+
+// SelectFolders - get all folders of the current user.
+func (c *ClientPostgres) SelectFolders(ctx context.Context, uuid string) ([]models.FolderModel, error) {
+	res := make([]models.FolderModel, 0)
+	q := `SELECT id, COALESCE(parent_id, 0), name FROM folders WHERE uuid = $1 ORDER BY name, id;`
+	rows, err := c.conn().Query(ctx, q, uuid)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		folder := models.FolderModel{UUID: uuid}
+		if err := rows.Scan(&folder.ID, &folder.ParentID, &folder.Name); err != nil {
+			return res, err
+		}
+		res = append(res, folder)
+	}
+	return res, rows.Err()
+}
+
+// SelectFolderItems - get the not deleted records that are directly in the folder.
+func (c *ClientPostgres) SelectFolderItems(ctx context.Context, model models.FolderReqModel) ([]models.DataRecordModel, error) {
+	res := make([]models.DataRecordModel, 0)
+	exists, err := c.folderIsExists(ctx, model.UUID, model.ID)
+	if err != nil {
+		return res, err
+	}
+	if !exists {
+		return res, customerror.ErrFolderNotFound
+	}
+
+	q := `SELECT title, tag, comment, type, id, created_at, updated_at, COALESCE(folder_id, 0), custom_fields, seal_version FROM items
+	WHERE uuid = $1 AND deleted = false AND COALESCE(folder_id, 0) = $2 ORDER BY title, type, id;`
+	rows, err := c.conn().Query(ctx, q, model.UUID, model.ID)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		record := models.DataRecordModel{}
+		var version int16
+		err := rows.Scan(&record.Title, &record.Tag, &record.Comment, &record.Type, &record.ID,
+			&record.CreatedAt, &record.UpdatedAt, &record.FolderID, &record.Fields, &version)
+		if err != nil {
+			return res, err
+		}
+		if err := c.openFields(ctx, model.UUID, recordRef(record.Type, record.ID), version, record.Fields); err != nil {
+			return res, err
+		}
+		res = append(res, record)
+	}
+	return res, rows.Err()
+}
+
+// CreateFolder - creates the folder in the parent folder. Returns id of the new folder.
+func (c *ClientPostgres) CreateFolder(ctx context.Context, model models.FolderModel) (int32, error) {
+	var id int32
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		exists, err := tc.folderIsExists(ctx, model.UUID, model.ParentID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return customerror.ErrFolderNotFound
+		}
+		taken, err := tc.folderNameIsTaken(ctx, model)
+		if err != nil {
+			return err
+		}
+		if taken {
+			return customerror.ErrFolderIsExists
+		}
+
+		q := `INSERT INTO folders(uuid, parent_id, name) VALUES ($1, NULLIF($2, 0), $3) RETURNING id;`
+		return tc.conn().QueryRow(ctx, q, model.UUID, model.ParentID, model.Name).Scan(&id)
+	})
+	return id, err
+}
+
+// RenameFolder - renames the folder.
+func (c *ClientPostgres) RenameFolder(ctx context.Context, model models.FolderModel) error {
+	return c.inTx(ctx, func(tc *ClientPostgres) error {
+		folder, err := tc.selectFolder(ctx, model.UUID, model.ID)
+		if err != nil {
+			return err
+		}
+		folder.Name = model.Name
+		taken, err := tc.folderNameIsTaken(ctx, folder)
+		if err != nil {
+			return err
+		}
+		if taken {
+			return customerror.ErrFolderIsExists
+		}
+
+		q := `UPDATE folders SET name = $3 WHERE id = $1 AND uuid = $2;`
+		_, err = tc.conn().Exec(ctx, q, model.ID, model.UUID, model.Name)
+		return err
+	})
+}
+
+// MoveFolder - moves the folder with its contents to the parent folder.
+func (c *ClientPostgres) MoveFolder(ctx context.Context, model models.FolderModel) error {
+	return c.inTx(ctx, func(tc *ClientPostgres) error {
+		folder, err := tc.selectFolder(ctx, model.UUID, model.ID)
+		if err != nil {
+			return err
+		}
+		exists, err := tc.folderIsExists(ctx, model.UUID, model.ParentID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return customerror.ErrFolderNotFound
+		}
+		subtree, err := tc.subfolders(ctx, model.UUID, model.ID)
+		if err != nil {
+			return err
+		}
+		for _, id := range subtree {
+			if id == model.ParentID {
+				return customerror.ErrFolderCycle
+			}
+		}
+		folder.ParentID = model.ParentID
+		taken, err := tc.folderNameIsTaken(ctx, folder)
+		if err != nil {
+			return err
+		}
+		if taken {
+			return customerror.ErrFolderIsExists
+		}
+
+		q := `UPDATE folders SET parent_id = NULLIF($3, 0) WHERE id = $1 AND uuid = $2;`
+		_, err = tc.conn().Exec(ctx, q, model.ID, model.UUID, model.ParentID)
+		return err
+	})
+}
+
+// DeleteFolder - deletes the folder with the nested folders, their records are moved to the trash.
+// Returns the number of records moved to the trash.
+func (c *ClientPostgres) DeleteFolder(ctx context.Context, model models.FolderReqModel) (int32, error) {
+	var count int32
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		subtree, err := tc.subfolders(ctx, model.UUID, model.ID)
+		if err != nil {
+			return err
+		}
+		if len(subtree) == 0 {
+			return customerror.ErrFolderNotFound
+		}
+
+		for _, table := range dataTables {
+			q := fmt.Sprintf(`UPDATE %s SET deleted = true, folder_id = NULL WHERE uuid = $1 AND folder_id = ANY($2) AND deleted = false;`, table)
+			tag, err := tc.conn().Exec(ctx, q, model.UUID, subtree)
+			if err != nil {
+				return err
+			}
+			count += int32(tag.RowsAffected())
+		}
+		// nested folders are deleted by the foreign key
+		q := `DELETE FROM folders WHERE id = $1 AND uuid = $2;`
+		_, err = tc.conn().Exec(ctx, q, model.ID, model.UUID)
+		return err
+	})
+	return count, err
+}
+
+// MoveItems - moves the records to the folder. Returns the number of moved records.
+func (c *ClientPostgres) MoveItems(ctx context.Context, model models.MoveItemsModel) (int32, error) {
+	var count int32
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		exists, err := tc.folderIsExists(ctx, model.UUID, model.FolderID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return customerror.ErrFolderNotFound
+		}
+
+		for _, item := range model.Items {
+			table, ok := dataTables[item.Type]
+			if !ok {
+				return customerror.ErrUnknownDataType
+			}
+			q := fmt.Sprintf(`UPDATE %s SET folder_id = NULLIF($3, 0) WHERE id = $1 AND uuid = $2 AND deleted = false;`, table)
+			tag, err := tc.conn().Exec(ctx, q, item.ID, model.UUID, model.FolderID)
+			if err != nil {
+				return err
+			}
+			if tag.RowsAffected() == 0 {
+				return customerror.ErrRecordNotFound
+			}
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
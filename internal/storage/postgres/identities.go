@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// InsertIdentity - inserting the identity in database.
+func (c *ClientPostgres) InsertIdentity(ctx context.Context, model models.ReqIdentityModel) (models.InsertRespModel, error) {
+	res := models.InsertRespModel{}
+	var id int32
+	d := model.Data
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		var err error
+		if id, err = tc.nextID(ctx, "identity_data"); err != nil {
+			return err
+		}
+		ref := recordRef(datatypes.IdentityDataType, id)
+		if err := tc.sealSecrets(ctx, model.UUID, ref, identitySecrets(&d)...); err != nil {
+			return err
+		}
+		q := `INSERT INTO identity_data(id, uuid, type, title, first_name, middle_name, last_name, address1, address2, city,
+		state, postal_code, country, phone, email, passport_number, passport_expiry, license_number, license_expiry,
+		national_id, tax_id, tag, comment, seal_version)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24);`
+		if _, err := tc.conn().Exec(ctx, q, id, model.UUID, datatypes.IdentityDataType, model.TechData.Title, d.FirstName,
+			d.MiddleName, d.LastName, d.Address1, d.Address2, d.City, d.State, d.PostalCode, d.Country, d.Phone, d.Email,
+			d.PassportNumber, nullDate(d.PassportExpiry), d.LicenseNumber, nullDate(d.LicenseExpiry), d.NationalID, d.TaxID,
+			model.TechData.Tag, model.TechData.Comment, tc.sealVersion()); err != nil {
+			return err
+		}
+		return tc.setItemTags(ctx, model.UUID, datatypes.IdentityDataType, id, model.TechData.Tag)
+	})
+	if err != nil {
+		return res, err
+	}
+	res.ID = id
+	res.Title = model.TechData.Title
+	return res, nil
+}
+
+// UpdateIdentity - update the identity in database.
+// Returns customerror.ErrRecordNotFound if the identity does not exist.
+func (c *ClientPostgres) UpdateIdentity(ctx context.Context, model models.ReqIdentityModel) (models.InsertRespModel, error) {
+	res := models.InsertRespModel{}
+	d := model.Data
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		owner, err := tc.itemOwner(ctx, model.UUID, datatypes.IdentityDataType, d.ID, datatypes.EditorRole)
+		if err != nil {
+			return err
+		}
+		if err := tc.upgradeRecord(ctx, datatypes.IdentityDataType, d.ID, owner); err != nil {
+			return err
+		}
+		ref := recordRef(datatypes.IdentityDataType, d.ID)
+		if err := tc.sealSecrets(ctx, owner, ref, identitySecrets(&d)...); err != nil {
+			return err
+		}
+		q := `UPDATE identity_data SET title = $1, first_name = $2, middle_name = $3, last_name = $4, address1 = $5,
+		address2 = $6, city = $7, state = $8, postal_code = $9, country = $10, phone = $11, email = $12,
+		passport_number = $13, passport_expiry = $14, license_number = $15, license_expiry = $16, national_id = $17,
+		tax_id = $18, tag = $19, comment = $20 WHERE uuid = $21 AND id = $22 AND deleted = false;`
+		tag, err := tc.conn().Exec(ctx, q, model.TechData.Title, d.FirstName, d.MiddleName, d.LastName, d.Address1,
+			d.Address2, d.City, d.State, d.PostalCode, d.Country, d.Phone, d.Email, d.PassportNumber,
+			nullDate(d.PassportExpiry), d.LicenseNumber, nullDate(d.LicenseExpiry), d.NationalID, d.TaxID,
+			model.TechData.Tag, model.TechData.Comment, owner, d.ID)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return customerror.ErrRecordNotFound
+		}
+		return tc.setItemTags(ctx, owner, datatypes.IdentityDataType, d.ID, model.TechData.Tag)
+	})
+	if err != nil {
+		return res, err
+	}
+	res.ID = d.ID
+	res.Title = model.TechData.Title
+	return res, nil
+}
+
+// SelectIdentity - get the identity from database.
+// Returns customerror.ErrRecordNotFound if the identity does not exist.
+func (c *ClientPostgres) SelectIdentity(ctx context.Context, model models.IDModel) (models.RespIdentityModel, error) {
+	res := models.RespIdentityModel{}
+	d := &res.Data
+	var passportExpiry, licenseExpiry *time.Time
+	var version int16
+	owner, err := c.itemOwner(ctx, model.UUID, datatypes.IdentityDataType, model.ID, datatypes.ViewerRole)
+	if err != nil {
+		return res, err
+	}
+	q := `SELECT id, first_name, middle_name, last_name, address1, address2, city, state, postal_code, country, phone,
+	email, passport_number, passport_expiry, license_number, license_expiry, national_id, tax_id, title, tag, comment,
+	type, custom_fields, seal_version FROM identity_data WHERE id = $1 AND uuid = $2 AND deleted = false;`
+	err = c.conn().QueryRow(ctx, q, model.ID, owner).Scan(&d.ID, &d.FirstName, &d.MiddleName, &d.LastName,
+		&d.Address1, &d.Address2, &d.City, &d.State, &d.PostalCode, &d.Country, &d.Phone, &d.Email, &d.PassportNumber,
+		&passportExpiry, &d.LicenseNumber, &licenseExpiry, &d.NationalID, &d.TaxID, &res.TechData.Title,
+		&res.TechData.Tag, &res.TechData.Comment, &res.TechData.Type, &res.TechData.Fields, &version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return res, customerror.ErrRecordNotFound
+	}
+	if err != nil {
+		return res, err
+	}
+	ref := recordRef(datatypes.IdentityDataType, model.ID)
+	if err := c.openSecrets(ctx, owner, ref, version, identitySecrets(d)...); err != nil {
+		return res, err
+	}
+	if err := c.openFields(ctx, owner, ref, version, res.TechData.Fields); err != nil {
+		return res, err
+	}
+	if passportExpiry != nil {
+		d.PassportExpiry = *passportExpiry
+	}
+	if licenseExpiry != nil {
+		d.LicenseExpiry = *licenseExpiry
+	}
+	res.TechData.ID = d.ID
+	return res, nil
+}
+
+// identitySecrets - returns the secret fields of the identity.
+func identitySecrets(d *models.IdentityModel) []secret {
+	return []secret{{colPassport, &d.PassportNumber}, {colLicense, &d.LicenseNumber},
+		{colNationalID, &d.NationalID}, {colTaxID, &d.TaxID}}
+}
+
+// nullDate - returns nil for the zero date, so it is stored as NULL.
+func nullDate(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/urimatch"
+)
+
+// SetLoginURIs - replaces the URIs of the login/password record. The order of the URIs is kept.
+func (c *ClientPostgres) SetLoginURIs(ctx context.Context, model models.LoginURIsModel) error {
+	return c.inTx(ctx, func(tc *ClientPostgres) error {
+		owner, err := tc.itemOwner(ctx, model.UUID, datatypes.LoginPasswordDataType, model.ID, datatypes.EditorRole)
+		if err != nil {
+			return err
+		}
+		// the record is marked as changed for the synchronization
+		q := `UPDATE log_pwd_data SET updated_at = now() WHERE id = $1 AND uuid = $2 AND deleted = false;`
+		tag, err := tc.conn().Exec(ctx, q, model.ID, owner)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return customerror.ErrRecordNotFound
+		}
+
+		q = `DELETE FROM login_uris WHERE item_id = $1;`
+		if _, err := tc.conn().Exec(ctx, q, model.ID); err != nil {
+			return err
+		}
+		q = `INSERT INTO login_uris(item_id, position, uri, match, host, base_domain) VALUES ($1, $2, $3, $4, $5, $6);`
+		for i, uri := range model.URIs {
+			if _, err := tc.conn().Exec(ctx, q, model.ID, i, uri.URI, uri.Match, uri.Host, uri.BaseDomain); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// SelectLoginURIs - get the URIs of the login/password record.
+func (c *ClientPostgres) SelectLoginURIs(ctx context.Context, model models.IDModel) ([]models.LoginURIModel, error) {
+	res := make([]models.LoginURIModel, 0)
+	exists, err := c.RecordIsExists(ctx, models.IDModel{UUID: model.UUID, ID: model.ID, Type: datatypes.LoginPasswordDataType})
+	if err != nil {
+		return res, err
+	}
+	if !exists {
+		return res, customerror.ErrRecordNotFound
+	}
+
+	q := `SELECT uri, match, host, base_domain FROM login_uris WHERE item_id = $1 ORDER BY position;`
+	rows, err := c.conn().Query(ctx, q, model.ID)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		uri := models.LoginURIModel{}
+		if err := rows.Scan(&uri.URI, &uri.Match, &uri.Host, &uri.BaseDomain); err != nil {
+			return res, err
+		}
+		res = append(res, uri)
+	}
+	return res, rows.Err()
+}
+
+// LookupLogins - get the login/password records of the current user and its collections that have the URIs
+// with the base domain or the regular expressions. The URIs still need to be matched with the page URL.
+func (c *ClientPostgres) LookupLogins(ctx context.Context, model models.LookupLoginsModel) ([]models.LoginMatchModel, error) {
+	res := make([]models.LoginMatchModel, 0)
+	q := `SELECT d.id, d.uuid, d.title, d.tag, d.comment, d.login, d.password, d.seal_version, u.uri, u.match, u.host, u.base_domain
+	FROM log_pwd_data d JOIN login_uris u ON u.item_id = d.id
+	WHERE ` + availableRecords("d.") + ` AND d.deleted = false AND d.id IN (SELECT item_id FROM login_uris WHERE base_domain = $2 OR match = $3)
+	ORDER BY d.title, d.id, u.position;`
+	rows, err := c.conn().Query(ctx, q, model.UUID, model.BaseDomain, urimatch.Regex)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		login := models.LoginMatchModel{}
+		uri := models.LoginURIModel{}
+		var version int16
+		var owner string
+		err := rows.Scan(&login.ID, &owner, &login.Title, &login.Tag, &login.Comment, &login.Data.Login, &login.Data.Password,
+			&version, &uri.URI, &uri.Match, &uri.Host, &uri.BaseDomain)
+		if err != nil {
+			return res, err
+		}
+		ref := recordRef(datatypes.LoginPasswordDataType, login.ID)
+		if err := c.openSecrets(ctx, owner, ref, version, secret{colPassword, &login.Data.Password}); err != nil {
+			return res, err
+		}
+		if len(res) > 0 && res[len(res)-1].ID == login.ID {
+			res[len(res)-1].URIs = append(res[len(res)-1].URIs, uri)
+			continue
+		}
+		login.URIs = []models.LoginURIModel{uri}
+		res = append(res, login)
+	}
+	return res, rows.Err()
+}
@@ -0,0 +1,512 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// Data types in the order of the records of the collection.
+var collectionTypes = []int32{datatypes.LoginPasswordDataType, datatypes.CardDataType, datatypes.TextDataType,
+	datatypes.BinaryDataType, datatypes.SSHKeyDataType, datatypes.IdentityDataType}
+
+// availableRecords - returns the condition of the records available to the user $1 in the lists: the records
+// of the user and the records of the collections where the user has any role. The prefix is the alias of the table.
+func availableRecords(prefix string) string {
+	return fmt.Sprintf(`(%[1]suuid = $1 OR %[1]scollection_id IN (SELECT collection_id FROM collection_members WHERE uuid = $1))`,
+		prefix)
+}
+
+// (c *ClientPostgres) itemOwner - returns the owner of the record if the user may access it with the role:
+// the user owns the record or has the role in the collection of the record. If the record is not available
+// to the user at all, the user is returned, so the record is not found by the following query.
+// Returns customerror.ErrCollectionRole if the role of the user in the collection is lower.
+func (c *ClientPostgres) itemOwner(ctx context.Context, uuid string, dataType int32, id int32, role int32) (string, error) {
+	table, ok := dataTables[dataType]
+	if !ok {
+		return "", customerror.ErrUnknownDataType
+	}
+	var owner string
+	var allowed bool
+	q := fmt.Sprintf(`SELECT d.uuid, d.uuid = $2 OR COALESCE(m.role, 0) >= $3 FROM %s d
+	LEFT JOIN collection_members m ON m.collection_id = d.collection_id AND m.uuid = $2
+	WHERE d.id = $1 AND (d.uuid = $2 OR m.uuid IS NOT NULL);`, table)
+	err := c.conn().QueryRow(ctx, q, id, uuid, role).Scan(&owner, &allowed)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return uuid, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if !allowed {
+		return "", customerror.ErrCollectionRole
+	}
+	return owner, nil
+}
+
+// (c *ClientPostgres) registerMemberChanges - registers the changes of the records of the collections for the member
+// who joined them (the records appear) or left them (the records are deleted for the member), so the clients
+// of the member synchronize the records of the collections.
+func (c *ClientPostgres) registerMemberChanges(ctx context.Context, uuid string, collections []int32, deleted bool) error {
+	q := `SELECT register_user_change($1, type, id, $2) FROM items WHERE collection_id = ANY($3) AND deleted = false
+	ORDER BY type, id;`
+	_, err := c.conn().Exec(ctx, q, uuid, deleted, collections)
+	return err
+}
+
+// (c *ClientPostgres) checkOrgAdmin - checks that the user is the administrator of the organization.
+// Returns customerror.ErrOrgNotFound if the user is not a member of the organization.
+func (c *ClientPostgres) checkOrgAdmin(ctx context.Context, orgID string, uuid string) error {
+	var admin bool
+	q := `SELECT admin FROM org_members WHERE org_id = $1 AND uuid = $2;`
+	err := c.conn().QueryRow(ctx, q, orgID, uuid).Scan(&admin)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return customerror.ErrOrgNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if !admin {
+		return customerror.ErrNotOrgAdmin
+	}
+	return nil
+}
+
+// (c *ClientPostgres) orgMember - returns the uuid and the admin flag of the member of the organization by the login.
+func (c *ClientPostgres) orgMember(ctx context.Context, orgID string, login string) (string, bool, error) {
+	var uuid string
+	var admin bool
+	q := `SELECT m.uuid, m.admin FROM org_members m JOIN users u ON u.uuid = m.uuid WHERE m.org_id = $1 AND u.login = $2;`
+	err := c.conn().QueryRow(ctx, q, orgID, login).Scan(&uuid, &admin)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", false, customerror.ErrNotOrgMember
+	}
+	return uuid, admin, err
+}
+
+// (c *ClientPostgres) collectionRole - returns the collection with the role of the user in it and whether the user
+// is the administrator of its organization. Returns customerror.ErrCollectionNotFound if the user is neither
+// a member of the collection nor the administrator.
+func (c *ClientPostgres) collectionRole(ctx context.Context, id int32, uuid string) (models.CollectionModel, bool, error) {
+	res := models.CollectionModel{ID: id, UUID: uuid}
+	var admin bool
+	q := `SELECT c.org_id, c.name, COALESCE(cm.role, 0), COALESCE(m.admin, false), c.created_at FROM collections c
+	LEFT JOIN collection_members cm ON cm.collection_id = c.id AND cm.uuid = $2
+	LEFT JOIN org_members m ON m.org_id = c.org_id AND m.uuid = $2 WHERE c.id = $1;`
+	err := c.conn().QueryRow(ctx, q, id, uuid).Scan(&res.OrgID, &res.Name, &res.Role, &admin, &res.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return res, false, customerror.ErrCollectionNotFound
+	}
+	if err != nil {
+		return res, false, err
+	}
+	if res.Role == 0 && !admin {
+		return res, false, customerror.ErrCollectionNotFound
+	}
+	return res, admin, nil
+}
+
+// CreateOrganization - creates the organization, the current user becomes its administrator.
+func (c *ClientPostgres) CreateOrganization(ctx context.Context, model models.OrganizationModel) (string, error) {
+	var id string
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		q := `INSERT INTO organizations(name) VALUES ($1) RETURNING id;`
+		if err := tc.conn().QueryRow(ctx, q, model.Name).Scan(&id); err != nil {
+			return err
+		}
+		q = `INSERT INTO org_members(org_id, uuid, admin) VALUES ($1, $2, true);`
+		_, err := tc.conn().Exec(ctx, q, id, model.UUID)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// SelectOrganizations - get the organizations of the current user.
+func (c *ClientPostgres) SelectOrganizations(ctx context.Context, uuid string) ([]models.OrganizationModel, error) {
+	res := make([]models.OrganizationModel, 0)
+	q := `SELECT o.id, o.name, m.admin, o.created_at FROM organizations o JOIN org_members m ON m.org_id = o.id
+	WHERE m.uuid = $1 ORDER BY o.name, o.id;`
+	rows, err := c.conn().Query(ctx, q, uuid)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		org := models.OrganizationModel{UUID: uuid}
+		if err := rows.Scan(&org.ID, &org.Name, &org.Admin, &org.CreatedAt); err != nil {
+			return res, err
+		}
+		res = append(res, org)
+	}
+	return res, rows.Err()
+}
+
+// SelectOrgMembers - get the members of the organization of the current user.
+func (c *ClientPostgres) SelectOrgMembers(ctx context.Context, model models.OrgMemberModel) ([]models.OrgMemberModel, error) {
+	res := make([]models.OrgMemberModel, 0)
+	var member bool
+	q := `SELECT EXISTS(SELECT 1 FROM org_members WHERE org_id = $1 AND uuid = $2);`
+	if err := c.conn().QueryRow(ctx, q, model.OrgID, model.UUID).Scan(&member); err != nil {
+		return res, err
+	}
+	if !member {
+		return res, customerror.ErrOrgNotFound
+	}
+
+	q = `SELECT u.login, m.admin, m.joined_at FROM org_members m JOIN users u ON u.uuid = m.uuid
+	WHERE m.org_id = $1 ORDER BY u.login;`
+	rows, err := c.conn().Query(ctx, q, model.OrgID)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		m := models.OrgMemberModel{OrgID: model.OrgID, UUID: model.UUID}
+		if err := rows.Scan(&m.Login, &m.Admin, &m.JoinedAt); err != nil {
+			return res, err
+		}
+		res = append(res, m)
+	}
+	return res, rows.Err()
+}
+
+// RemoveOrgMember - removes the member from the organization and from all its collections.
+// The administrator removes any member, the other members can only leave. The last administrator cannot leave.
+func (c *ClientPostgres) RemoveOrgMember(ctx context.Context, model models.OrgMemberModel) error {
+	return c.inTx(ctx, func(tc *ClientPostgres) error {
+		// the organization is locked, so the concurrent requests cannot remove all administrators
+		q := `SELECT 1 FROM organizations WHERE id = $1 FOR UPDATE;`
+		if _, err := tc.conn().Exec(ctx, q, model.OrgID); err != nil {
+			return err
+		}
+		uuid, admin, err := tc.orgMember(ctx, model.OrgID, model.Login)
+		if errors.Is(err, customerror.ErrNotOrgMember) || (err == nil && uuid != model.UUID) {
+			// only the administrator removes the other members and learns who is not a member
+			if err := tc.checkOrgAdmin(ctx, model.OrgID, model.UUID); err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if admin {
+			var admins int
+			q = `SELECT count(*) FROM org_members WHERE org_id = $1 AND admin = true;`
+			if err := tc.conn().QueryRow(ctx, q, model.OrgID).Scan(&admins); err != nil {
+				return err
+			}
+			if admins == 1 {
+				return customerror.ErrLastOrgAdmin
+			}
+		}
+
+		collections := make([]int32, 0)
+		q = `DELETE FROM collection_members WHERE uuid = $2 AND collection_id IN (SELECT id FROM collections WHERE org_id = $1)
+		RETURNING collection_id;`
+		rows, err := tc.conn().Query(ctx, q, model.OrgID, uuid)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			var id int32
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return err
+			}
+			collections = append(collections, id)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		if err := tc.registerMemberChanges(ctx, uuid, collections, true); err != nil {
+			return err
+		}
+		q = `DELETE FROM org_members WHERE org_id = $1 AND uuid = $2;`
+		_, err = tc.conn().Exec(ctx, q, model.OrgID, uuid)
+		return err
+	})
+}
+
+// InviteOrgMember - invites the user to the organization, only the administrator invites the users.
+// The repeated invitation is kept as is.
+func (c *ClientPostgres) InviteOrgMember(ctx context.Context, model models.InvitationModel) error {
+	return c.inTx(ctx, func(tc *ClientPostgres) error {
+		if err := tc.checkOrgAdmin(ctx, model.OrgID, model.UUID); err != nil {
+			return err
+		}
+		var uuid string
+		q := `SELECT uuid FROM users WHERE login = $1;`
+		err := tc.conn().QueryRow(ctx, q, model.Login).Scan(&uuid)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return customerror.ErrUserNotFound
+		}
+		if err != nil {
+			return err
+		}
+		var member bool
+		q = `SELECT EXISTS(SELECT 1 FROM org_members WHERE org_id = $1 AND uuid = $2);`
+		if err := tc.conn().QueryRow(ctx, q, model.OrgID, uuid).Scan(&member); err != nil {
+			return err
+		}
+		if member {
+			return customerror.ErrAlreadyOrgMember
+		}
+		q = `INSERT INTO org_invitations(org_id, uuid, invited_by) VALUES ($1, $2, $3) ON CONFLICT (org_id, uuid) DO NOTHING;`
+		_, err = tc.conn().Exec(ctx, q, model.OrgID, uuid, model.UUID)
+		return err
+	})
+}
+
+// SelectInvitations - get the invitations of the current user.
+func (c *ClientPostgres) SelectInvitations(ctx context.Context, uuid string) ([]models.InvitationModel, error) {
+	res := make([]models.InvitationModel, 0)
+	q := `SELECT i.org_id, o.name, COALESCE(u.login, ''), i.created_at FROM org_invitations i
+	JOIN organizations o ON o.id = i.org_id LEFT JOIN users u ON u.uuid = i.invited_by
+	WHERE i.uuid = $1 ORDER BY i.created_at, i.org_id;`
+	rows, err := c.conn().Query(ctx, q, uuid)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		invitation := models.InvitationModel{UUID: uuid}
+		if err := rows.Scan(&invitation.OrgID, &invitation.OrgName, &invitation.InvitedByLogin, &invitation.CreatedAt); err != nil {
+			return res, err
+		}
+		res = append(res, invitation)
+	}
+	return res, rows.Err()
+}
+
+// AcceptInvitation - the current user joins the organization by the invitation.
+func (c *ClientPostgres) AcceptInvitation(ctx context.Context, model models.InvitationModel) error {
+	return c.inTx(ctx, func(tc *ClientPostgres) error {
+		if err := tc.DeclineInvitation(ctx, model); err != nil {
+			return err
+		}
+		q := `INSERT INTO org_members(org_id, uuid) VALUES ($1, $2) ON CONFLICT (org_id, uuid) DO NOTHING;`
+		_, err := tc.conn().Exec(ctx, q, model.OrgID, model.UUID)
+		return err
+	})
+}
+
+// DeclineInvitation - deletes the invitation of the current user.
+func (c *ClientPostgres) DeclineInvitation(ctx context.Context, model models.InvitationModel) error {
+	q := `DELETE FROM org_invitations WHERE org_id = $1 AND uuid = $2;`
+	tag, err := c.conn().Exec(ctx, q, model.OrgID, model.UUID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return customerror.ErrInvitationNotFound
+	}
+	return nil
+}
+
+// CreateCollection - creates the collection in the organization, only the administrator creates the collections.
+// The current user becomes the manager of the collection.
+func (c *ClientPostgres) CreateCollection(ctx context.Context, model models.CollectionModel) (int32, error) {
+	var id int32
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		if err := tc.checkOrgAdmin(ctx, model.OrgID, model.UUID); err != nil {
+			return err
+		}
+		q := `INSERT INTO collections(org_id, name) VALUES ($1, $2) ON CONFLICT (org_id, name) DO NOTHING RETURNING id;`
+		err := tc.conn().QueryRow(ctx, q, model.OrgID, model.Name).Scan(&id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return customerror.ErrCollectionIsExists
+		}
+		if err != nil {
+			return err
+		}
+		q = `INSERT INTO collection_members(collection_id, uuid, role) VALUES ($1, $2, $3);`
+		_, err = tc.conn().Exec(ctx, q, id, model.UUID, datatypes.ManagerRole)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// SelectCollections - get the collections where the current user has a role and all collections
+// of the organizations where the user is the administrator.
+func (c *ClientPostgres) SelectCollections(ctx context.Context, uuid string) ([]models.CollectionModel, error) {
+	res := make([]models.CollectionModel, 0)
+	q := `SELECT c.id, c.org_id, c.name, COALESCE(cm.role, 0), c.created_at FROM collections c
+	LEFT JOIN collection_members cm ON cm.collection_id = c.id AND cm.uuid = $1
+	WHERE cm.uuid IS NOT NULL OR EXISTS(SELECT 1 FROM org_members m WHERE m.org_id = c.org_id AND m.uuid = $1 AND m.admin = true)
+	ORDER BY c.org_id, c.name;`
+	rows, err := c.conn().Query(ctx, q, uuid)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		collection := models.CollectionModel{UUID: uuid}
+		if err := rows.Scan(&collection.ID, &collection.OrgID, &collection.Name, &collection.Role, &collection.CreatedAt); err != nil {
+			return res, err
+		}
+		res = append(res, collection)
+	}
+	return res, rows.Err()
+}
+
+// SetCollectionRole - grants the role in the collection to the member of its organization, the role 0 removes it.
+// The roles are granted by the managers of the collection and by the administrators of the organization.
+func (c *ClientPostgres) SetCollectionRole(ctx context.Context, model models.CollectionRoleModel) error {
+	return c.inTx(ctx, func(tc *ClientPostgres) error {
+		collection, admin, err := tc.collectionRole(ctx, model.CollectionID, model.UUID)
+		if err != nil {
+			return err
+		}
+		if !admin && collection.Role < datatypes.ManagerRole {
+			return customerror.ErrCollectionRole
+		}
+		uuid, _, err := tc.orgMember(ctx, collection.OrgID, model.Login)
+		if err != nil {
+			return err
+		}
+		var joined bool
+		collections := []int32{model.CollectionID}
+		if model.Role == 0 {
+			q := `DELETE FROM collection_members WHERE collection_id = $1 AND uuid = $2;`
+			tag, err := tc.conn().Exec(ctx, q, model.CollectionID, uuid)
+			if err != nil || tag.RowsAffected() == 0 {
+				return err
+			}
+			return tc.registerMemberChanges(ctx, uuid, collections, true)
+		}
+		// the new member gets the records of the collection, the changed role does not change them
+		q := `INSERT INTO collection_members(collection_id, uuid, role) VALUES ($1, $2, $3)
+		ON CONFLICT (collection_id, uuid) DO UPDATE SET role = EXCLUDED.role RETURNING xmax = 0;`
+		if err := tc.conn().QueryRow(ctx, q, model.CollectionID, uuid, model.Role).Scan(&joined); err != nil {
+			return err
+		}
+		if !joined {
+			return nil
+		}
+		return tc.registerMemberChanges(ctx, uuid, collections, false)
+	})
+}
+
+// SelectCollectionMembers - get the members of the collection with their roles.
+func (c *ClientPostgres) SelectCollectionMembers(ctx context.Context, model models.CollectionReqModel) ([]models.CollectionRoleModel, error) {
+	res := make([]models.CollectionRoleModel, 0)
+	if _, _, err := c.collectionRole(ctx, model.ID, model.UUID); err != nil {
+		return res, err
+	}
+	q := `SELECT u.login, cm.role FROM collection_members cm JOIN users u ON u.uuid = cm.uuid
+	WHERE cm.collection_id = $1 ORDER BY u.login;`
+	rows, err := c.conn().Query(ctx, q, model.ID)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		member := models.CollectionRoleModel{CollectionID: model.ID, UUID: model.UUID}
+		if err := rows.Scan(&member.Login, &member.Role); err != nil {
+			return res, err
+		}
+		res = append(res, member)
+	}
+	return res, rows.Err()
+}
+
+// SelectCollectionItems - get the records of the collection. The administrator of the organization
+// needs a role in the collection to see its records.
+func (c *ClientPostgres) SelectCollectionItems(ctx context.Context, model models.CollectionReqModel) ([]models.DataRecordModel, error) {
+	res := make([]models.DataRecordModel, 0)
+	collection, _, err := c.collectionRole(ctx, model.ID, model.UUID)
+	if err != nil {
+		return res, err
+	}
+	if collection.Role < datatypes.ViewerRole {
+		return res, customerror.ErrCollectionRole
+	}
+	for _, dataType := range collectionTypes {
+		q := fmt.Sprintf(`SELECT COALESCE(title, ''), COALESCE(tag, ''), COALESCE(comment, ''), type, id, created_at, updated_at,
+		EXISTS(SELECT 1 FROM item_favorites f WHERE f.uuid = $2 AND f.type = $3 AND f.id = d.id), custom_fields, seal_version
+		FROM %s d WHERE collection_id = $1 AND deleted = false ORDER BY id;`, dataTables[dataType])
+		rows, err := c.conn().Query(ctx, q, model.ID, model.UUID, dataType)
+		if err != nil {
+			return res, err
+		}
+		for rows.Next() {
+			record := models.DataRecordModel{}
+			var version int16
+			err := rows.Scan(&record.Title, &record.Tag, &record.Comment, &record.Type, &record.ID,
+				&record.CreatedAt, &record.UpdatedAt, &record.Favorite, &record.Fields, &version)
+			if err != nil {
+				rows.Close()
+				return res, err
+			}
+			err = c.openFields(ctx, collection.OrgID, recordRef(record.Type, record.ID), version, record.Fields)
+			if err != nil {
+				rows.Close()
+				return res, err
+			}
+			res = append(res, record)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+// MoveToCollection - moves the record of the current user to the collection where the user is at least the editor.
+// The record is owned by the organization after it: its secrets are encrypted with the data key of the organization,
+// it leaves the folder and the tags of the user. The members of the collection, the user too, get it as the change.
+func (c *ClientPostgres) MoveToCollection(ctx context.Context, model models.MoveToCollectionModel) error {
+	table, ok := dataTables[model.Type]
+	if !ok {
+		return customerror.ErrUnknownDataType
+	}
+	return c.inTx(ctx, func(tc *ClientPostgres) error {
+		collection, _, err := tc.collectionRole(ctx, model.CollectionID, model.UUID)
+		if err != nil {
+			return err
+		}
+		if collection.Role < datatypes.EditorRole {
+			return customerror.ErrCollectionRole
+		}
+
+		// only the own record is moved, the record of the organization stays in it
+		var tag string
+		q := fmt.Sprintf(`SELECT COALESCE(tag, '') FROM %s WHERE id = $1 AND uuid = $2 AND deleted = false FOR UPDATE;`, table)
+		err = tc.conn().QueryRow(ctx, q, model.ID, model.UUID).Scan(&tag)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return customerror.ErrRecordNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if err := tc.resealRecord(ctx, model.Type, model.ID, model.UUID, collection.OrgID); err != nil {
+			return err
+		}
+		q = fmt.Sprintf(`UPDATE %s SET uuid = $2, collection_id = $3, folder_id = NULL WHERE id = $1;`, table)
+		if _, err := tc.conn().Exec(ctx, q, model.ID, collection.OrgID, model.CollectionID); err != nil {
+			return err
+		}
+		if err := tc.setItemTags(ctx, model.UUID, model.Type, model.ID, ""); err != nil {
+			return err
+		}
+		if err := tc.setItemTags(ctx, collection.OrgID, model.Type, model.ID, tag); err != nil {
+			return err
+		}
+		q = `UPDATE attachments SET uuid = $3 WHERE type = $1 AND item_id = $2;`
+		_, err = tc.conn().Exec(ctx, q, model.Type, model.ID, collection.OrgID)
+		return err
+	})
+}
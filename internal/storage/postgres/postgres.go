@@ -1,18 +1,28 @@
 package postgres
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/BillyBones007/pwdm_server/internal/blobstore"
 	"github.com/BillyBones007/pwdm_server/internal/customerror"
 	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/keyprovider"
 	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/chunktools"
+	"github.com/BillyBones007/pwdm_server/internal/tools/compresstools"
 	"github.com/BillyBones007/pwdm_server/internal/tools/convertuuid"
 	"github.com/BillyBones007/pwdm_server/internal/tools/encpass"
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/postgres"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/sirupsen/logrus"
 )
@@ -22,10 +32,32 @@ type ClientPostgres struct {
 	Pool     *pgxpool.Pool
 	ConfigCP *pgxpool.Config
 	Logger   *logrus.Logger
+	Blobs    blobstore.BlobStore     // contents of the binary records and the attachments
+	Quota    int64                   // storage quota of the user in bytes, 0 - unlimited
+	Keys     keyprovider.KeyProvider // master keys of the data keys, without them the secrets are stored as is
+	dataKeys *keyCache               // unwrapped data keys of the users
+	tx       pgx.Tx                  // current transaction, if the client is bound to it
+}
+
+// querier - common methods of the pool connections and the transaction.
+type querier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// Tables of the data types.
+var dataTables = map[int32]string{
+	datatypes.LoginPasswordDataType: "log_pwd_data",
+	datatypes.CardDataType:          "card_data",
+	datatypes.TextDataType:          "text_data",
+	datatypes.BinaryDataType:        "binary_data",
+	datatypes.SSHKeyDataType:        "ssh_key_data",
+	datatypes.IdentityDataType:      "identity_data",
 }
 
 // NewClientPostgres - returns a pointer to the ClientPostgres.
-func NewClientPostgres(dsn string) (*ClientPostgres, error) {
+func NewClientPostgres(dsn string, blobs blobstore.BlobStore, keys keyprovider.KeyProvider) (*ClientPostgres, error) {
 	if dsn == "" {
 		return nil, customerror.ErrDSNEmpty
 	}
@@ -37,7 +69,7 @@ func NewClientPostgres(dsn string) (*ClientPostgres, error) {
 	if err != nil {
 		return nil, err
 	}
-	cp := ClientPostgres{Pool: pool, ConfigCP: config}
+	cp := ClientPostgres{Pool: pool, ConfigCP: config, Blobs: blobs, Keys: keys, dataKeys: newKeyCache()}
 	err = cp.createTable()
 	if err != nil {
 		return nil, err
@@ -52,12 +84,45 @@ func (c *ClientPostgres) createTable() error {
 		return customerror.ErrMigrations
 	}
 	err = m.Up()
-	if err != migrate.ErrNoChange {
+	if err != nil && err != migrate.ErrNoChange {
 		return customerror.ErrMigrations
 	}
 	return nil
 }
 
+// (c *ClientPostgres) conn - returns the current transaction or the pool connections.
+func (c *ClientPostgres) conn() querier {
+	if c.tx != nil {
+		return c.tx
+	}
+	return c.Pool
+}
+
+// (c *ClientPostgres) withTx - returns a copy of the client bound to the transaction.
+func (c *ClientPostgres) withTx(tx pgx.Tx) *ClientPostgres {
+	return &ClientPostgres{Pool: c.Pool, ConfigCP: c.ConfigCP, Logger: c.Logger, Blobs: c.Blobs, Quota: c.Quota,
+		Keys: c.Keys, dataKeys: c.dataKeys, tx: tx}
+}
+
+// (c *ClientPostgres) inTx - calls the function with the client bound to the transaction.
+// If the client is already bound to a transaction, the function is called in it.
+func (c *ClientPostgres) inTx(ctx context.Context, fn func(tc *ClientPostgres) error) error {
+	if c.tx != nil {
+		return fn(c)
+	}
+	tx, err := c.Pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	// does nothing if the transaction is committed
+	defer tx.Rollback(context.Background())
+
+	if err := fn(c.withTx(tx)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
 // (c *ClientPostgres) Close - close the pool connections.
 func (c *ClientPostgres) Close() {
 	c.Pool.Close()
@@ -82,7 +147,7 @@ func (c *ClientPostgres) CreateUser(ctx context.Context, model models.UserModel)
 	}
 
 	q := "INSERT INTO users (uuid, login, password) VALUES (uuid_generate_v4(), $1, $2) RETURNING uuid;"
-	if err := c.Pool.QueryRow(ctx, q, model.Login, encPass).Scan(&uuid); err != nil {
+	if err := c.conn().QueryRow(ctx, q, model.Login, encPass).Scan(&uuid); err != nil {
 		return "", err
 	}
 
@@ -93,13 +158,17 @@ func (c *ClientPostgres) CreateUser(ctx context.Context, model models.UserModel)
 func (c *ClientPostgres) ValidUser(ctx context.Context, model models.UserModel) (bool, error) {
 	var encPass string
 	q := "SELECT password FROM users WHERE login = $1;"
-	if err := c.Pool.QueryRow(ctx, q, model.Login).Scan(&encPass); err != nil {
+	if err := c.conn().QueryRow(ctx, q, model.Login).Scan(&encPass); err != nil {
 		if errors.Is(err, customerror.ErrNoRows) {
 			return false, customerror.ErrLoginOrPassIncorrect
 		}
 		return false, err
 	}
 
+	// the password of the user who logged in with SRP is not kept
+	if encPass == "" {
+		return false, customerror.ErrSRPAccount
+	}
 	if !encpass.ComparePassword(model.Password, encPass) {
 		return false, customerror.ErrLoginOrPassIncorrect
 	}
@@ -110,7 +179,7 @@ func (c *ClientPostgres) ValidUser(ctx context.Context, model models.UserModel)
 func (c *ClientPostgres) UserIsExists(ctx context.Context, model models.UserModel) (bool, error) {
 	var flag bool
 	q := "SELECT EXISTS(SELECT login FROM users WHERE login = $1);"
-	if err := c.Pool.QueryRow(ctx, q, model.Login).Scan(&flag); err != nil {
+	if err := c.conn().QueryRow(ctx, q, model.Login).Scan(&flag); err != nil {
 		return flag, err
 	}
 	return flag, nil
@@ -120,7 +189,7 @@ func (c *ClientPostgres) UserIsExists(ctx context.Context, model models.UserMode
 func (c *ClientPostgres) GetUUID(ctx context.Context, model models.UserModel) (string, error) {
 	var uuid [16]byte
 	q := "SELECT uuid FROM users WHERE login = $1;"
-	if err := c.Pool.QueryRow(ctx, q, model.Login).Scan(&uuid); err != nil {
+	if err := c.conn().QueryRow(ctx, q, model.Login).Scan(&uuid); err != nil {
 		return "", err
 	}
 
@@ -130,7 +199,7 @@ func (c *ClientPostgres) GetUUID(ctx context.Context, model models.UserModel) (s
 // DeleteUser - delete user from database.
 func (c *ClientPostgres) DeleteUser(ctx context.Context, uuid string) error {
 	q := "DELETE FROM users WHERE uuid = $1;"
-	_, err := c.Pool.Exec(ctx, q, uuid)
+	_, err := c.conn().Exec(ctx, q, uuid)
 	if err != nil {
 		return err
 	}
@@ -140,9 +209,30 @@ func (c *ClientPostgres) DeleteUser(ctx context.Context, uuid string) error {
 // UpdateLogPwdPair - updates the login/password pair in database.
 func (c *ClientPostgres) UpdateLogPwdPair(ctx context.Context, model models.ReqLogPwdModel) (models.InsertRespModel, error) {
 	res := models.InsertRespModel{}
-	q := `UPDATE log_pwd_data SET title = $1, login = $2, password = $3, tag = $4, comment = $5 WHERE uuid = $6 AND id = $7;`
-	_, err := c.Pool.Exec(ctx, q, model.TechData.Title, model.Data.Login, model.Data.Password, model.TechData.Tag,
-		model.TechData.Comment, model.UUID, model.Data.ID)
+	password := model.Data.Password
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		owner, err := tc.itemOwner(ctx, model.UUID, datatypes.LoginPasswordDataType, model.Data.ID, datatypes.EditorRole)
+		if err != nil {
+			return err
+		}
+		if err := tc.upgradeRecord(ctx, datatypes.LoginPasswordDataType, model.Data.ID, owner); err != nil {
+			return err
+		}
+		ref := recordRef(datatypes.LoginPasswordDataType, model.Data.ID)
+		if err := tc.sealSecrets(ctx, owner, ref, secret{colPassword, &password}); err != nil {
+			return err
+		}
+		q := `UPDATE log_pwd_data SET title = $1, login = $2, password = $3, tag = $4, comment = $5 WHERE uuid = $6 AND id = $7;`
+		tag, err := tc.conn().Exec(ctx, q, model.TechData.Title, model.Data.Login, password, model.TechData.Tag,
+			model.TechData.Comment, owner, model.Data.ID)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return customerror.ErrRecordNotFound
+		}
+		return tc.setItemTags(ctx, owner, datatypes.LoginPasswordDataType, model.Data.ID, model.TechData.Tag)
+	})
 	if err != nil {
 		return res, err
 	}
@@ -154,11 +244,32 @@ func (c *ClientPostgres) UpdateLogPwdPair(ctx context.Context, model models.ReqL
 // UpdateCardData - updates the card data in database.
 func (c *ClientPostgres) UpdateCardData(ctx context.Context, model models.ReqCardModel) (models.InsertRespModel, error) {
 	res := models.InsertRespModel{}
-	q := `UPDATE card_data SET title = $1, num = $2, date = $3, cvc = $4, first_name = $5, last_name = $6, 
-	tag = $7, comment = $8 WHERE uuid = $9 AND id = $10;`
-	_, err := c.Pool.Exec(ctx, q, model.TechData.Title, model.Data.Num, model.Data.Date, model.Data.CVC,
-		model.Data.FirstName, model.Data.LastName, model.TechData.Tag,
-		model.TechData.Comment, model.UUID, model.Data.ID)
+	num, cvc := model.Data.Num, model.Data.CVC
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		owner, err := tc.itemOwner(ctx, model.UUID, datatypes.CardDataType, model.Data.ID, datatypes.EditorRole)
+		if err != nil {
+			return err
+		}
+		if err := tc.upgradeRecord(ctx, datatypes.CardDataType, model.Data.ID, owner); err != nil {
+			return err
+		}
+		ref := recordRef(datatypes.CardDataType, model.Data.ID)
+		if err := tc.sealSecrets(ctx, owner, ref, secret{colCardNum, &num}, secret{colCardCVC, &cvc}); err != nil {
+			return err
+		}
+		q := `UPDATE card_data SET title = $1, num = $2, date = $3, cvc = $4, first_name = $5, last_name = $6, 
+		tag = $7, comment = $8, expires_at = $11 WHERE uuid = $9 AND id = $10;`
+		tag, err := tc.conn().Exec(ctx, q, model.TechData.Title, num, model.Data.Date, cvc,
+			model.Data.FirstName, model.Data.LastName, model.TechData.Tag,
+			model.TechData.Comment, owner, model.Data.ID, cardExpiry(model.Data.Date))
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return customerror.ErrRecordNotFound
+		}
+		return tc.setItemTags(ctx, owner, datatypes.CardDataType, model.Data.ID, model.TechData.Tag)
+	})
 	if err != nil {
 		return res, err
 	}
@@ -170,9 +281,30 @@ func (c *ClientPostgres) UpdateCardData(ctx context.Context, model models.ReqCar
 // UpdateTextData - updates the text data in database.
 func (c *ClientPostgres) UpdateTextData(ctx context.Context, model models.ReqTextModel) (models.InsertRespModel, error) {
 	res := models.InsertRespModel{}
-	q := `UPDATE text_data SET title = $1, data = $2, tag = $3, comment = $4 WHERE uuid = $5 AND id = $6;`
-	_, err := c.Pool.Exec(ctx, q, model.TechData.Title, model.Data.Data, model.TechData.Tag,
-		model.TechData.Comment, model.UUID, model.Data.ID)
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		owner, err := tc.itemOwner(ctx, model.UUID, datatypes.TextDataType, model.Data.ID, datatypes.EditorRole)
+		if err != nil {
+			return err
+		}
+		if err := tc.upgradeRecord(ctx, datatypes.TextDataType, model.Data.ID, owner); err != nil {
+			return err
+		}
+		ref := recordRef(datatypes.TextDataType, model.Data.ID)
+		payload, err := tc.sealPayload(ctx, owner, ref, colText, compresstools.Encode([]byte(model.Data.Data)))
+		if err != nil {
+			return err
+		}
+		q := `UPDATE text_data SET title = $1, data = NULL, data_z = $2, size = $7, tag = $3, comment = $4 WHERE uuid = $5 AND id = $6;`
+		tag, err := tc.conn().Exec(ctx, q, model.TechData.Title, payload, model.TechData.Tag,
+			model.TechData.Comment, owner, model.Data.ID, len(model.Data.Data))
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return customerror.ErrRecordNotFound
+		}
+		return tc.setItemTags(ctx, owner, datatypes.TextDataType, model.Data.ID, model.TechData.Tag)
+	})
 	if err != nil {
 		return res, err
 	}
@@ -184,9 +316,29 @@ func (c *ClientPostgres) UpdateTextData(ctx context.Context, model models.ReqTex
 // UpdateBinaryData - updates the binary data in database.
 func (c *ClientPostgres) UpdateBinaryData(ctx context.Context, model models.ReqBinaryModel) (models.InsertRespModel, error) {
 	res := models.InsertRespModel{}
-	q := `UPDATE binary_data SET title = $1, data = $2, tag = $3, comment = $4 WHERE uuid = $5 AND id = $6;`
-	_, err := c.Pool.Exec(ctx, q, model.TechData.Title, model.Data.Data, model.TechData.Tag,
-		model.TechData.Comment, model.UUID, model.Data.ID)
+	sum := chunktools.Sum(model.Data.Data)
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		owner, err := tc.itemOwner(ctx, model.UUID, datatypes.BinaryDataType, model.Data.ID, datatypes.EditorRole)
+		if err != nil {
+			return err
+		}
+		if err := tc.checkQuota(ctx, owner, int64(len(model.Data.Data)), model.Data.ID); err != nil {
+			return err
+		}
+		if err := tc.putBlob(ctx, sum, bytes.NewReader(model.Data.Data), int64(len(model.Data.Data))); err != nil {
+			return err
+		}
+		q := `UPDATE binary_data SET title = $1, tag = $2, comment = $3, size = $6, sha256 = $7 WHERE uuid = $4 AND id = $5;`
+		tag, err := tc.conn().Exec(ctx, q, model.TechData.Title, model.TechData.Tag,
+			model.TechData.Comment, owner, model.Data.ID, len(model.Data.Data), sum)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return customerror.ErrRecordNotFound
+		}
+		return tc.setItemTags(ctx, owner, datatypes.BinaryDataType, model.Data.ID, model.TechData.Tag)
+	})
 	if err != nil {
 		return res, err
 	}
@@ -199,10 +351,25 @@ func (c *ClientPostgres) UpdateBinaryData(ctx context.Context, model models.ReqB
 func (c *ClientPostgres) InsertLogPwdPair(ctx context.Context, model models.ReqLogPwdModel) (models.InsertRespModel, error) {
 	res := models.InsertRespModel{}
 	var id int32
-	q := `INSERT INTO log_pwd_data(uuid, type, title, login, password, tag, comment) 
-	VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id;`
-	if err := c.Pool.QueryRow(ctx, q, model.UUID, model.TechData.Type, model.TechData.Title, model.Data.Login,
-		model.Data.Password, model.TechData.Tag, model.TechData.Comment).Scan(&id); err != nil {
+	password := model.Data.Password
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		var err error
+		if id, err = tc.nextID(ctx, "log_pwd_data"); err != nil {
+			return err
+		}
+		ref := recordRef(datatypes.LoginPasswordDataType, id)
+		if err := tc.sealSecrets(ctx, model.UUID, ref, secret{colPassword, &password}); err != nil {
+			return err
+		}
+		q := `INSERT INTO log_pwd_data(id, uuid, type, title, login, password, tag, comment, seal_version) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);`
+		if _, err := tc.conn().Exec(ctx, q, id, model.UUID, model.TechData.Type, model.TechData.Title, model.Data.Login,
+			password, model.TechData.Tag, model.TechData.Comment, tc.sealVersion()); err != nil {
+			return err
+		}
+		return tc.setItemTags(ctx, model.UUID, datatypes.LoginPasswordDataType, id, model.TechData.Tag)
+	})
+	if err != nil {
 		return res, err
 	}
 	res.ID = id
@@ -214,10 +381,26 @@ func (c *ClientPostgres) InsertLogPwdPair(ctx context.Context, model models.ReqL
 func (c *ClientPostgres) InsertCardData(ctx context.Context, model models.ReqCardModel) (models.InsertRespModel, error) {
 	res := models.InsertRespModel{}
 	var id int32
-	q := `INSERT INTO card_data(uuid, type, title, num, date, cvc, first_name, last_name, tag, comment) 
-	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id;`
-	if err := c.Pool.QueryRow(ctx, q, model.UUID, model.TechData.Type, model.TechData.Title, model.Data.Num, model.Data.Date,
-		model.Data.CVC, model.Data.FirstName, model.Data.LastName, model.TechData.Tag, model.TechData.Comment).Scan(&id); err != nil {
+	num, cvc := model.Data.Num, model.Data.CVC
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		var err error
+		if id, err = tc.nextID(ctx, "card_data"); err != nil {
+			return err
+		}
+		ref := recordRef(datatypes.CardDataType, id)
+		if err := tc.sealSecrets(ctx, model.UUID, ref, secret{colCardNum, &num}, secret{colCardCVC, &cvc}); err != nil {
+			return err
+		}
+		q := `INSERT INTO card_data(id, uuid, type, title, num, date, cvc, first_name, last_name, tag, comment, expires_at, seal_version) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13);`
+		if _, err := tc.conn().Exec(ctx, q, id, model.UUID, model.TechData.Type, model.TechData.Title, num, model.Data.Date,
+			cvc, model.Data.FirstName, model.Data.LastName, model.TechData.Tag, model.TechData.Comment,
+			cardExpiry(model.Data.Date), tc.sealVersion()); err != nil {
+			return err
+		}
+		return tc.setItemTags(ctx, model.UUID, datatypes.CardDataType, id, model.TechData.Tag)
+	})
+	if err != nil {
 		return res, err
 	}
 	res.ID = id
@@ -229,10 +412,25 @@ func (c *ClientPostgres) InsertCardData(ctx context.Context, model models.ReqCar
 func (c *ClientPostgres) InsertTextData(ctx context.Context, model models.ReqTextModel) (models.InsertRespModel, error) {
 	res := models.InsertRespModel{}
 	var id int32
-	q := `INSERT INTO text_data(uuid, type, title, data, tag, comment) 
-	VALUES ($1, $2, $3, $4, $5, $6) RETURNING id;`
-	if err := c.Pool.QueryRow(ctx, q, model.UUID, model.TechData.Type, model.TechData.Title, model.Data.Data,
-		model.TechData.Tag, model.TechData.Comment).Scan(&id); err != nil {
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		var err error
+		if id, err = tc.nextID(ctx, "text_data"); err != nil {
+			return err
+		}
+		ref := recordRef(datatypes.TextDataType, id)
+		payload, err := tc.sealPayload(ctx, model.UUID, ref, colText, compresstools.Encode([]byte(model.Data.Data)))
+		if err != nil {
+			return err
+		}
+		q := `INSERT INTO text_data(id, uuid, type, title, data_z, size, tag, comment, seal_version) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);`
+		if _, err := tc.conn().Exec(ctx, q, id, model.UUID, model.TechData.Type, model.TechData.Title, payload,
+			len(model.Data.Data), model.TechData.Tag, model.TechData.Comment, tc.sealVersion()); err != nil {
+			return err
+		}
+		return tc.setItemTags(ctx, model.UUID, datatypes.TextDataType, id, model.TechData.Tag)
+	})
+	if err != nil {
 		return res, err
 	}
 	res.ID = id
@@ -244,10 +442,23 @@ func (c *ClientPostgres) InsertTextData(ctx context.Context, model models.ReqTex
 func (c *ClientPostgres) InsertBinaryData(ctx context.Context, model models.ReqBinaryModel) (models.InsertRespModel, error) {
 	res := models.InsertRespModel{}
 	var id int32
-	q := `INSERT INTO binary_data(uuid, type, title, data, tag, comment) 
-	VALUES ($1, $2, $3, $4, $5, $6) RETURNING id;`
-	if err := c.Pool.QueryRow(ctx, q, model.UUID, model.TechData.Type, model.TechData.Title, model.Data.Data,
-		model.TechData.Tag, model.TechData.Comment).Scan(&id); err != nil {
+	sum := chunktools.Sum(model.Data.Data)
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		if err := tc.checkQuota(ctx, model.UUID, int64(len(model.Data.Data)), 0); err != nil {
+			return err
+		}
+		if err := tc.putBlob(ctx, sum, bytes.NewReader(model.Data.Data), int64(len(model.Data.Data))); err != nil {
+			return err
+		}
+		q := `INSERT INTO binary_data(uuid, type, title, tag, comment, size, sha256, seal_version) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id;`
+		if err := tc.conn().QueryRow(ctx, q, model.UUID, model.TechData.Type, model.TechData.Title,
+			model.TechData.Tag, model.TechData.Comment, len(model.Data.Data), sum, tc.sealVersion()).Scan(&id); err != nil {
+			return err
+		}
+		return tc.setItemTags(ctx, model.UUID, datatypes.BinaryDataType, id, model.TechData.Tag)
+	})
+	if err != nil {
 		return res, err
 	}
 	res.ID = id
@@ -258,10 +469,22 @@ func (c *ClientPostgres) InsertBinaryData(ctx context.Context, model models.ReqB
 // SelectLogPwdPair - get a login/password pair from database.
 func (c *ClientPostgres) SelectLogPwdPair(ctx context.Context, model models.IDModel) (models.RespLogPwdModel, error) {
 	res := models.RespLogPwdModel{}
+	owner, err := c.itemOwner(ctx, model.UUID, datatypes.LoginPasswordDataType, model.ID, datatypes.ViewerRole)
+	if err != nil {
+		return res, err
+	}
 
-	q := `SELECT login, password, title, tag, comment, type FROM log_pwd_data WHERE id = $1 AND uuid = $2 AND deleted = false;`
-	if err := c.Pool.QueryRow(ctx, q, model.ID, model.UUID).Scan(&res.Data.Login, &res.Data.Password,
-		&res.TechData.Title, &res.TechData.Tag, &res.TechData.Comment, &res.TechData.Type); err != nil {
+	var version int16
+	q := `SELECT login, password, title, tag, comment, type, custom_fields, seal_version FROM log_pwd_data WHERE id = $1 AND uuid = $2 AND deleted = false;`
+	if err := c.conn().QueryRow(ctx, q, model.ID, owner).Scan(&res.Data.Login, &res.Data.Password,
+		&res.TechData.Title, &res.TechData.Tag, &res.TechData.Comment, &res.TechData.Type, &res.TechData.Fields, &version); err != nil {
+		return res, err
+	}
+	ref := recordRef(datatypes.LoginPasswordDataType, model.ID)
+	if err := c.openSecrets(ctx, owner, ref, version, secret{colPassword, &res.Data.Password}); err != nil {
+		return res, err
+	}
+	if err := c.openFields(ctx, owner, ref, version, res.TechData.Fields); err != nil {
 		return res, err
 	}
 
@@ -271,25 +494,85 @@ func (c *ClientPostgres) SelectLogPwdPair(ctx context.Context, model models.IDMo
 // SelectCardData - get a card data from database.
 func (c *ClientPostgres) SelectCardData(ctx context.Context, model models.IDModel) (models.RespCardModel, error) {
 	res := models.RespCardModel{}
+	owner, err := c.itemOwner(ctx, model.UUID, datatypes.CardDataType, model.ID, datatypes.ViewerRole)
+	if err != nil {
+		return res, err
+	}
 
-	q := `SELECT num, date, cvc, first_name, last_name, title, tag, comment, type FROM card_data WHERE id = $1 AND uuid = $2 AND deleted = false;`
-	if err := c.Pool.QueryRow(ctx, q, model.ID, model.UUID).Scan(&res.Data.Num, &res.Data.Date,
+	var version int16
+	q := `SELECT num, date, cvc, first_name, last_name, title, tag, comment, type, custom_fields, seal_version FROM card_data WHERE id = $1 AND uuid = $2 AND deleted = false;`
+	if err := c.conn().QueryRow(ctx, q, model.ID, owner).Scan(&res.Data.Num, &res.Data.Date,
 		&res.Data.CVC, &res.Data.FirstName, &res.Data.LastName, &res.TechData.Title, &res.TechData.Tag,
-		&res.TechData.Comment, &res.TechData.Type); err != nil {
+		&res.TechData.Comment, &res.TechData.Type, &res.TechData.Fields, &version); err != nil {
+		return res, err
+	}
+	ref := recordRef(datatypes.CardDataType, model.ID)
+	err = c.openSecrets(ctx, owner, ref, version, secret{colCardNum, &res.Data.Num}, secret{colCardCVC, &res.Data.CVC})
+	if err != nil {
+		return res, err
+	}
+	if err := c.openFields(ctx, owner, ref, version, res.TechData.Fields); err != nil {
 		return res, err
 	}
 
 	return res, nil
 }
 
+// SelectCardNumbers - get the numbers of the cards of the current user and its collections by their id.
+func (c *ClientPostgres) SelectCardNumbers(ctx context.Context, model models.ListRecordsModel) (map[int32]string, error) {
+	res := make(map[int32]string, len(model.ListID))
+	q := `SELECT id, uuid, num, seal_version FROM card_data WHERE ` + availableRecords("") + ` AND id = ANY($2) AND deleted = false;`
+	rows, err := c.conn().Query(ctx, q, model.UUID, model.ListID)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id int32
+		var owner, num string
+		var version int16
+		if err := rows.Scan(&id, &owner, &num, &version); err != nil {
+			return res, err
+		}
+		err := c.openSecrets(ctx, owner, recordRef(datatypes.CardDataType, id), version, secret{colCardNum, &num})
+		if err != nil {
+			return res, err
+		}
+		res[id] = num
+	}
+	return res, rows.Err()
+}
+
 // SelectTextData - get some text data from database.
 func (c *ClientPostgres) SelectTextData(ctx context.Context, model models.IDModel) (models.RespTextModel, error) {
 	res := models.RespTextModel{}
-	q := `SELECT data, title, tag, comment, type FROM text_data WHERE id = $1 AND uuid = $2 AND deleted = false;`
-	if err := c.Pool.QueryRow(ctx, q, model.ID, model.UUID).Scan(&res.Data.Data, &res.TechData.Title,
-		&res.TechData.Tag, &res.TechData.Comment, &res.TechData.Type); err != nil {
+	owner, err := c.itemOwner(ctx, model.UUID, datatypes.TextDataType, model.ID, datatypes.ViewerRole)
+	if err != nil {
+		return res, err
+	}
+	var payload []byte
+	var version int16
+	q := `SELECT COALESCE(data, ''), data_z, title, tag, comment, type, custom_fields, seal_version FROM text_data WHERE id = $1 AND uuid = $2 AND deleted = false;`
+	if err := c.conn().QueryRow(ctx, q, model.ID, owner).Scan(&res.Data.Data, &payload, &res.TechData.Title,
+		&res.TechData.Tag, &res.TechData.Comment, &res.TechData.Type, &res.TechData.Fields, &version); err != nil {
+		return res, err
+	}
+	ref := recordRef(datatypes.TextDataType, model.ID)
+	if err := c.openFields(ctx, owner, ref, version, res.TechData.Fields); err != nil {
 		return res, err
 	}
+	// the old rows keep the text uncompressed
+	if payload != nil {
+		payload, err := c.openPayload(ctx, owner, ref, version, colText, payload)
+		if err != nil {
+			return res, err
+		}
+		data, err := compresstools.Decode(payload)
+		if err != nil {
+			return res, err
+		}
+		res.Data.Data = string(data)
+	}
 
 	return res, nil
 }
@@ -297,81 +580,483 @@ func (c *ClientPostgres) SelectTextData(ctx context.Context, model models.IDMode
 // SelectBinaryData - get some binary data from database.
 func (c *ClientPostgres) SelectBinaryData(ctx context.Context, model models.IDModel) (models.RespBinaryModel, error) {
 	res := models.RespBinaryModel{}
+	owner, err := c.itemOwner(ctx, model.UUID, datatypes.BinaryDataType, model.ID, datatypes.ViewerRole)
+	if err != nil {
+		return res, err
+	}
 
-	q := `SELECT data, title, tag, comment, type FROM binary_data WHERE id = $1 AND uuid = $2 AND deleted = false;`
-	if err := c.Pool.QueryRow(ctx, q, model.ID, model.UUID).Scan(&res.Data.Data, &res.TechData.Title,
-		&res.TechData.Tag, &res.TechData.Comment, &res.TechData.Type); err != nil {
+	var version int16
+	q := `SELECT size, sha256, title, tag, comment, type, custom_fields, seal_version FROM binary_data
+	WHERE id = $1 AND uuid = $2 AND deleted = false;`
+	if err := c.conn().QueryRow(ctx, q, model.ID, owner).Scan(&res.Data.Size, &res.Data.SHA256,
+		&res.TechData.Title, &res.TechData.Tag, &res.TechData.Comment, &res.TechData.Type, &res.TechData.Fields, &version); err != nil {
+		return res, err
+	}
+	err = c.openFields(ctx, owner, recordRef(datatypes.BinaryDataType, model.ID), version, res.TechData.Fields)
+	if err != nil {
 		return res, err
 	}
 
-	return res, nil
+	res.Data.Data, err = c.readBlob(ctx, res.Data.SHA256, res.Data.Size, 0, res.Data.Size)
+	return res, err
 }
 
-// SelectAllInfoUser - get all info by current user.
+// SelectAllInfoUser - get all info by current user, the records of the collections of the user too.
 func (c *ClientPostgres) SelectAllInfoUser(ctx context.Context, uuid string) ([]models.DataRecordModel, error) {
 	res := make([]models.DataRecordModel, 0)
 
-	q := []string{
-		`SELECT title, tag, comment, type, id FROM log_pwd_data WHERE uuid = $1 AND deleted = false;`,
-		`SELECT title, tag, comment, type, id FROM card_data WHERE uuid = $1 AND deleted = false;`,
-		`SELECT title, tag, comment, type, id FROM text_data WHERE uuid = $1 AND deleted = false;`,
-		`SELECT title, tag, comment, type, id FROM binary_data WHERE uuid = $1 AND deleted = false;`,
-	}
-
-	for _, query := range q {
-		rows, err := c.Pool.Query(ctx, query, uuid)
+	for _, dataType := range collectionTypes {
+		query := fmt.Sprintf(`SELECT title, tag, comment, type, id, COALESCE(folder_id, 0), custom_fields, seal_version, uuid
+		FROM %s WHERE %s AND deleted = false;`, dataTables[dataType], availableRecords(""))
+		rows, err := c.conn().Query(ctx, query, uuid)
 		if err != nil {
 			return res, err
 		}
 		for rows.Next() {
 			record := models.DataRecordModel{}
-			err := rows.Scan(&record.Title, &record.Tag, &record.Comment, &record.Type, &record.ID)
+			var version int16
+			var owner string
+			err := rows.Scan(&record.Title, &record.Tag, &record.Comment, &record.Type, &record.ID, &record.FolderID,
+				&record.Fields, &version, &owner)
 			if err != nil {
 				return res, err
 			}
+			if err := c.openFields(ctx, owner, recordRef(record.Type, record.ID), version, record.Fields); err != nil {
+				return res, err
+			}
 			res = append(res, record)
 		}
 	}
 	return res, nil
 }
 
+// Sort expressions and their cursor value types for the list of records.
+var sortColumns = map[int32][2]string{
+	models.SortByTitle:    {"COALESCE(title, '')", "text"},
+	models.SortByCreated:  {"created_at", "timestamptz"},
+	models.SortByUpdated:  {"updated_at", "timestamptz"},
+	models.SortByRecent:   {"COALESCE(last_accessed_at, 'epoch'::timestamptz)", "timestamptz"},
+	models.SortByFrequent: {"access_count", "integer"},
+}
+
+// ListItems - get a filtered and sorted page of records of the current user and its collections.
+func (c *ClientPostgres) ListItems(ctx context.Context, model models.ListItemsReqModel) (models.ListItemsRespModel, error) {
+	res := models.ListItemsRespModel{Items: make([]models.DataRecordModel, 0)}
+	sort, ok := sortColumns[model.SortBy]
+	if !ok {
+		return res, customerror.ErrUnknownSortOrder
+	}
+
+	args := []any{model.UUID}
+	where := []string{availableRecords(""), "deleted = false"}
+	addFilter := func(cond string, arg any) {
+		args = append(args, arg)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+	if len(model.Types) > 0 {
+		addFilter("type = ANY($%d)", model.Types)
+	}
+	if len(model.Tags) > 0 {
+		addFilter(`EXISTS(SELECT 1 FROM item_tags it JOIN tags t ON t.id = it.tag_id
+		WHERE it.type = items.type AND it.item_id = items.id AND t.name = ANY($%d))`, model.Tags)
+	}
+	if model.Title != "" {
+		addFilter(`title ILIKE $%d ESCAPE '\'`, "%"+escapeLike(model.Title)+"%")
+	}
+	if !model.CreatedFrom.IsZero() {
+		addFilter("created_at >= $%d", model.CreatedFrom)
+	}
+	if !model.CreatedTo.IsZero() {
+		addFilter("created_at < $%d", model.CreatedTo)
+	}
+	if !model.UpdatedFrom.IsZero() {
+		addFilter("updated_at >= $%d", model.UpdatedFrom)
+	}
+	if !model.UpdatedTo.IsZero() {
+		addFilter("updated_at < $%d", model.UpdatedTo)
+	}
+
+	order, cmp := "ASC", ">"
+	if model.Desc {
+		order, cmp = "DESC", "<"
+	}
+	// the favorite records go first in both orders
+	rank, orderBy := "", ""
+	if model.FavoritesFirst {
+		rank = "NOT favorite"
+		if model.Desc {
+			rank = "favorite"
+		}
+		orderBy = fmt.Sprintf("%s %s, ", rank, order)
+	}
+	if model.Cursor != nil {
+		args = append(args, model.Cursor.Value, model.Cursor.Type, model.Cursor.ID)
+		n := len(args)
+		if model.FavoritesFirst {
+			args = append(args, model.Cursor.Favorite == model.Desc)
+			where = append(where, fmt.Sprintf("(%s, %s, type, id) %s ($%d::boolean, $%d::%s, $%d, $%d)",
+				rank, sort[0], cmp, n+1, n-2, sort[1], n-1, n))
+		} else {
+			where = append(where, fmt.Sprintf("(%s, type, id) %s ($%d::%s, $%d, $%d)", sort[0], cmp, n-2, sort[1], n-1, n))
+		}
+	}
+	// one extra row shows whether there is a next page
+	args = append(args, model.Limit+1)
+
+	q := fmt.Sprintf(`SELECT title, tag, comment, type, id, created_at, updated_at, COALESCE(folder_id, 0), custom_fields,
+	favorite, last_accessed_at, access_count, seal_version, uuid FROM (SELECT i.*, a.last_accessed_at, COALESCE(a.access_count, 0) AS access_count,
+	f.uuid IS NOT NULL AS favorite FROM items i LEFT JOIN item_access a ON a.uuid = $1 AND a.type = i.type AND a.id = i.id
+	LEFT JOIN item_favorites f ON f.uuid = $1 AND f.type = i.type AND f.id = i.id) AS items
+	WHERE %s ORDER BY %s%s %s, type %s, id %s LIMIT $%d;`, strings.Join(where, " AND "), orderBy, sort[0], order, order, order, len(args))
+	rows, err := c.conn().Query(ctx, q, args...)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		record := models.DataRecordModel{}
+		var lastAccessedAt *time.Time
+		var version int16
+		var owner string
+		err := rows.Scan(&record.Title, &record.Tag, &record.Comment, &record.Type, &record.ID,
+			&record.CreatedAt, &record.UpdatedAt, &record.FolderID, &record.Fields,
+			&record.Favorite, &lastAccessedAt, &record.AccessCount, &version, &owner)
+		if err != nil {
+			return res, err
+		}
+		if err := c.openFields(ctx, owner, recordRef(record.Type, record.ID), version, record.Fields); err != nil {
+			return res, err
+		}
+		if lastAccessedAt != nil {
+			record.LastAccessedAt = *lastAccessedAt
+		}
+		res.Items = append(res.Items, record)
+	}
+	if err := rows.Err(); err != nil {
+		return res, err
+	}
+
+	if len(res.Items) > int(model.Limit) {
+		res.Items = res.Items[:model.Limit]
+		last := res.Items[len(res.Items)-1]
+		res.Next = &models.ItemsCursorModel{Type: last.Type, ID: last.ID, Favorite: last.Favorite}
+		switch model.SortBy {
+		case models.SortByTitle:
+			res.Next.Value = last.Title
+		case models.SortByCreated:
+			res.Next.Value = last.CreatedAt.Format(time.RFC3339Nano)
+		case models.SortByUpdated:
+			res.Next.Value = last.UpdatedAt.Format(time.RFC3339Nano)
+		case models.SortByRecent:
+			if last.LastAccessedAt.IsZero() {
+				res.Next.Value = time.Unix(0, 0).UTC().Format(time.RFC3339Nano)
+			} else {
+				res.Next.Value = last.LastAccessedAt.Format(time.RFC3339Nano)
+			}
+		case models.SortByFrequent:
+			res.Next.Value = strconv.Itoa(int(last.AccessCount))
+		}
+	}
+	return res, nil
+}
+
+// escapeLike - escapes the special characters of the LIKE pattern.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 // DeleteRecord - delete current record from database.
+// The record of the collection is deleted by the editor of the collection.
 func (c *ClientPostgres) DeleteRecord(ctx context.Context, model models.IDModel) error {
-	switch model.Type {
-	case datatypes.LoginPasswordDataType:
-		q := `UPDATE log_pwd_data SET deleted = true WHERE id = $1 AND uuid = $2;`
-		_, err := c.Pool.Exec(ctx, q, model.ID, model.UUID)
+	table, ok := dataTables[model.Type]
+	if !ok {
+		return nil
+	}
+	owner, err := c.itemOwner(ctx, model.UUID, model.Type, model.ID, datatypes.EditorRole)
+	if err != nil {
+		return err
+	}
+	q := fmt.Sprintf(`UPDATE %s SET deleted = true WHERE id = $1 AND uuid = $2;`, table)
+	_, err = c.conn().Exec(ctx, q, model.ID, owner)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// SelectChanges - get a page of changes of the current user and the records of its collections after the cursor.
+// Deleted records are returned as tombstones.
+func (c *ClientPostgres) SelectChanges(ctx context.Context, model models.SyncReqModel) (models.SyncRespModel, error) {
+	res := models.SyncRespModel{Changes: make([]models.ChangeModel, 0), Cursor: model.Cursor}
+
+	q := `SELECT c.seq, c.id, c.type, c.deleted, c.created_seq > $2, d.title, d.tag, d.comment FROM changes c
+	JOIN items d ON d.type = c.type AND d.id = c.id WHERE c.uuid = $1 AND c.seq > $2
+	ORDER BY c.seq LIMIT $3;`
+	// one extra row shows whether there is a next page
+	rows, err := c.conn().Query(ctx, q, model.UUID, model.Cursor, model.Limit+1)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		change := models.ChangeModel{}
+		err := rows.Scan(&change.Seq, &change.ID, &change.Type, &change.Deleted, &change.Created, &change.Title, &change.Tag, &change.Comment)
 		if err != nil {
-			return err
+			return res, err
 		}
-		return nil
-	case datatypes.CardDataType:
-		q := `UPDATE card_data SET deleted = true WHERE id = $1 AND uuid = $2;`
-		_, err := c.Pool.Exec(ctx, q, model.ID, model.UUID)
+		res.Changes = append(res.Changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return res, err
+	}
+
+	if len(res.Changes) > int(model.Limit) {
+		res.Changes = res.Changes[:model.Limit]
+		res.HasMore = true
+	}
+	if len(res.Changes) > 0 {
+		res.Cursor = res.Changes[len(res.Changes)-1].Seq
+	}
+	return res, nil
+}
+
+// ListenChanges - listens for change notifications from the database and sends
+// the uuid of the user whose data has changed to the channel. Blocks until the context is done
+// or the connection fails.
+func (c *ClientPostgres) ListenChanges(ctx context.Context, out chan<- string) error {
+	conn, err := c.Pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		conn.Exec(context.Background(), "UNLISTEN *;")
+		conn.Release()
+	}()
+
+	if _, err := conn.Exec(ctx, "LISTEN changes;"); err != nil {
+		return err
+	}
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
 		if err != nil {
 			return err
 		}
-		return nil
-	case datatypes.TextDataType:
-		q := `UPDATE text_data SET deleted = true WHERE id = $1 AND uuid = $2;`
-		_, err := c.Pool.Exec(ctx, q, model.ID, model.UUID)
+		select {
+		case out <- notification.Payload:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// DeleteAllRecords - delete all records specified in the list from database.
+// The records of the collections are deleted only where the user is at least the editor.
+func (c *ClientPostgres) DeleteAllRecords(ctx context.Context, model models.ListRecordsModel) error {
+	table, ok := dataTables[model.Type]
+	if !ok {
+		return customerror.ErrUnknownDataType
+	}
+	q := fmt.Sprintf(`UPDATE %s SET deleted = true WHERE id = ANY($2) AND (uuid = $1 OR collection_id IN
+	(SELECT collection_id FROM collection_members WHERE uuid = $1 AND role >= $3));`, table)
+	_, err := c.conn().Exec(ctx, q, model.UUID, model.ListID, datatypes.EditorRole)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RecordIsExists - checks if the record of the current user or of its collection exists and is not deleted.
+func (c *ClientPostgres) RecordIsExists(ctx context.Context, model models.IDModel) (bool, error) {
+	var flag bool
+	table, ok := dataTables[model.Type]
+	if !ok {
+		return flag, customerror.ErrUnknownDataType
+	}
+	owner, err := c.itemOwner(ctx, model.UUID, model.Type, model.ID, datatypes.ViewerRole)
+	if err != nil {
+		return flag, err
+	}
+	q := fmt.Sprintf(`SELECT EXISTS(SELECT id FROM %s WHERE id = $1 AND uuid = $2 AND deleted = false);`, table)
+	if err := c.conn().QueryRow(ctx, q, model.ID, owner).Scan(&flag); err != nil {
+		return flag, err
+	}
+	return flag, nil
+}
+
+// Batch - performs all operations in a single transaction.
+// If one of the operations fails, the transaction is rolled back and
+// the error of the operation is returned in its result.
+func (c *ClientPostgres) Batch(ctx context.Context, model models.BatchReqModel) (models.BatchRespModel, error) {
+	res := models.BatchRespModel{Results: make([]models.BatchResultModel, 0, len(model.Operations))}
+	tx, err := c.Pool.Begin(ctx)
+	if err != nil {
+		return res, err
+	}
+	// does nothing if the transaction is committed
+	defer tx.Rollback(context.Background())
+
+	txClient := c.withTx(tx)
+	for _, op := range model.Operations {
+		result, err := txClient.batchOperation(ctx, model.UUID, op)
+		res.Results = append(res.Results, result)
 		if err != nil {
-			return err
+			return res, customerror.ErrBatchRolledBack
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return res, err
+	}
+	res.Committed = true
+	return res, nil
+}
+
+// batchOperation - performs one operation of the batch.
+func (c *ClientPostgres) batchOperation(ctx context.Context, uuid string, op models.BatchOperationModel) (models.BatchResultModel, error) {
+	result := models.BatchResultModel{}
+	if _, ok := dataTables[op.Type]; !ok {
+		result.Error = customerror.ErrUnknownDataType
+		return result, result.Error
+	}
+
+	if op.Action == models.BatchUpdate || op.Action == models.BatchDelete {
+		exists, err := c.RecordIsExists(ctx, models.IDModel{UUID: uuid, ID: op.ID, Type: op.Type})
+		if err == nil && !exists {
+			err = customerror.ErrRecordNotFound
 		}
-		return nil
-	case datatypes.BinaryDataType:
-		q := `UPDATE binary_data SET deleted = true WHERE id = $1 AND uuid = $2;`
-		_, err := c.Pool.Exec(ctx, q, model.ID, model.UUID)
 		if err != nil {
-			return err
+			result.Error = err
+			return result, err
 		}
-		return nil
 	}
 
+	var resp models.InsertRespModel
+	var err error
+	switch op.Action {
+	case models.BatchInsert:
+		resp, err = c.insertRecord(ctx, uuid, op)
+	case models.BatchUpdate:
+		resp, err = c.updateRecord(ctx, uuid, op)
+	case models.BatchDelete:
+		err = c.DeleteRecord(ctx, models.IDModel{UUID: uuid, ID: op.ID, Type: op.Type})
+		resp = models.InsertRespModel{ID: op.ID, Title: op.TechData.Title}
+	default:
+		err = customerror.ErrUnknownAction
+	}
+	result.ID = resp.ID
+	result.Title = resp.Title
+	result.Error = err
+	return result, err
+}
+
+// insertRecord - writes the record of the batch operation in database.
+func (c *ClientPostgres) insertRecord(ctx context.Context, uuid string, op models.BatchOperationModel) (models.InsertRespModel, error) {
+	techData := op.TechData
+	techData.Type = op.Type
+	switch op.Type {
+	case datatypes.LoginPasswordDataType:
+		return c.InsertLogPwdPair(ctx, models.ReqLogPwdModel{UUID: uuid, Data: op.LogPwd, TechData: techData})
+	case datatypes.CardDataType:
+		return c.InsertCardData(ctx, models.ReqCardModel{UUID: uuid, Data: op.Card, TechData: techData})
+	case datatypes.TextDataType:
+		return c.InsertTextData(ctx, models.ReqTextModel{UUID: uuid, Data: op.Text, TechData: techData})
+	case datatypes.BinaryDataType:
+		return c.InsertBinaryData(ctx, models.ReqBinaryModel{UUID: uuid, Data: op.Binary, TechData: techData})
+	case datatypes.IdentityDataType:
+		return c.InsertIdentity(ctx, models.ReqIdentityModel{UUID: uuid, Data: op.Identity, TechData: techData})
+	case datatypes.SSHKeyDataType:
+		return c.InsertSSHKey(ctx, models.ReqSSHKeyModel{UUID: uuid, Data: op.SSHKey, TechData: techData})
+	}
+	return models.InsertRespModel{}, customerror.ErrUnknownDataType
+}
+
+// updateRecord - updates the record of the batch operation in database.
+func (c *ClientPostgres) updateRecord(ctx context.Context, uuid string, op models.BatchOperationModel) (models.InsertRespModel, error) {
+	techData := op.TechData
+	techData.Type = op.Type
+	switch op.Type {
+	case datatypes.LoginPasswordDataType:
+		data := op.LogPwd
+		data.ID = op.ID
+		return c.UpdateLogPwdPair(ctx, models.ReqLogPwdModel{UUID: uuid, Data: data, TechData: techData})
+	case datatypes.CardDataType:
+		data := op.Card
+		data.ID = op.ID
+		return c.UpdateCardData(ctx, models.ReqCardModel{UUID: uuid, Data: data, TechData: techData})
+	case datatypes.TextDataType:
+		data := op.Text
+		data.ID = op.ID
+		return c.UpdateTextData(ctx, models.ReqTextModel{UUID: uuid, Data: data, TechData: techData})
+	case datatypes.BinaryDataType:
+		data := op.Binary
+		data.ID = op.ID
+		return c.UpdateBinaryData(ctx, models.ReqBinaryModel{UUID: uuid, Data: data, TechData: techData})
+	case datatypes.IdentityDataType:
+		data := op.Identity
+		data.ID = op.ID
+		return c.UpdateIdentity(ctx, models.ReqIdentityModel{UUID: uuid, Data: data, TechData: techData})
+	case datatypes.SSHKeyDataType:
+		data := op.SSHKey
+		data.ID = op.ID
+		return c.UpdateSSHKey(ctx, models.ReqSSHKeyModel{UUID: uuid, Data: data, TechData: techData})
+	}
+	return models.InsertRespModel{}, customerror.ErrUnknownDataType
+}
+
+// ReserveIdempotencyKey - reserves the idempotency key for the request of the current user.
+// Returns true if the key is reserved, otherwise returns the stored key with the response
+// of the previous request (the response is nil if that request is still in progress).
+func (c *ClientPostgres) ReserveIdempotencyKey(ctx context.Context, model models.IdempotencyModel) (models.IdempotencyModel, bool, error) {
+	res := models.IdempotencyModel{UUID: model.UUID, Key: model.Key}
+
+	// the expired key can be used again
+	q := `DELETE FROM idempotency_keys WHERE uuid = $1 AND key = $2 AND created_at < $3;`
+	if _, err := c.conn().Exec(ctx, q, model.UUID, model.Key, model.Expired); err != nil {
+		return res, false, err
+	}
+
+	q = `INSERT INTO idempotency_keys(uuid, key, method, request_hash) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING;`
+	tag, err := c.conn().Exec(ctx, q, model.UUID, model.Key, model.Method, model.RequestHash)
+	if err != nil {
+		return res, false, err
+	}
+	if tag.RowsAffected() == 1 {
+		return res, true, nil
+	}
+
+	q = `SELECT method, request_hash, response FROM idempotency_keys WHERE uuid = $1 AND key = $2;`
+	if err := c.conn().QueryRow(ctx, q, model.UUID, model.Key).Scan(&res.Method, &res.RequestHash, &res.Response); err != nil {
+		return res, false, err
+	}
+	return res, false, nil
+}
+
+// SaveIdempotencyResponse - saves the response of the request for the reserved idempotency key.
+func (c *ClientPostgres) SaveIdempotencyResponse(ctx context.Context, model models.IdempotencyModel) error {
+	q := `UPDATE idempotency_keys SET response = $1 WHERE uuid = $2 AND key = $3;`
+	_, err := c.conn().Exec(ctx, q, model.Response, model.UUID, model.Key)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
-// DeleteAllRecords - delete all records specified in the list from database.
-// func (c *ClientPostgres) DeleteAllRecords(ctx context.Context, model models.ListRecordsModel) error {
-// 	return nil
-// }
+// DeleteIdempotencyKey - deletes the idempotency key, for example if the request failed.
+func (c *ClientPostgres) DeleteIdempotencyKey(ctx context.Context, model models.IdempotencyModel) error {
+	q := `DELETE FROM idempotency_keys WHERE uuid = $1 AND key = $2;`
+	_, err := c.conn().Exec(ctx, q, model.UUID, model.Key)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteExpiredIdempotencyKeys - deletes all idempotency keys created before the time.
+func (c *ClientPostgres) DeleteExpiredIdempotencyKeys(ctx context.Context, expired time.Time) error {
+	q := `DELETE FROM idempotency_keys WHERE created_at < $1;`
+	_, err := c.conn().Exec(ctx, q, expired)
+	if err != nil {
+		return err
+	}
+	return nil
+}
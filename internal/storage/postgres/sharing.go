@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// UpsertKeyPair - saves or replaces the key pair of the user. The shares wrapped with the replaced public key
+// keep its fingerprint, so the recipient sees that the owner has to share the item again.
+func (c *ClientPostgres) UpsertKeyPair(ctx context.Context, model models.KeyPairModel) error {
+	q := `INSERT INTO user_public_keys(uuid, algorithm, public_key, encrypted_private_key, fingerprint) VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (uuid) DO UPDATE SET algorithm = EXCLUDED.algorithm, public_key = EXCLUDED.public_key,
+	encrypted_private_key = EXCLUDED.encrypted_private_key, fingerprint = EXCLUDED.fingerprint, updated_at = now();`
+	_, err := c.conn().Exec(ctx, q, model.UUID, model.Algorithm, model.PublicKey, model.EncryptedPrivateKey, model.Fingerprint)
+	return err
+}
+
+// SelectKeyPair - get the key pair of the current user.
+func (c *ClientPostgres) SelectKeyPair(ctx context.Context, uuid string) (models.KeyPairModel, error) {
+	res := models.KeyPairModel{UUID: uuid}
+	q := `SELECT algorithm, public_key, encrypted_private_key, fingerprint FROM user_public_keys WHERE uuid = $1;`
+	err := c.conn().QueryRow(ctx, q, uuid).Scan(&res.Algorithm, &res.PublicKey, &res.EncryptedPrivateKey, &res.Fingerprint)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return res, customerror.ErrNoPublicKey
+	}
+	return res, err
+}
+
+// SelectPublicKey - get the public key of the user by the login.
+func (c *ClientPostgres) SelectPublicKey(ctx context.Context, login string) (models.KeyPairModel, error) {
+	res := models.KeyPairModel{}
+	q := `SELECT k.uuid, k.algorithm, k.public_key, k.fingerprint FROM user_public_keys k JOIN users u ON u.uuid = k.uuid
+	WHERE u.login = $1;`
+	err := c.conn().QueryRow(ctx, q, login).Scan(&res.UUID, &res.Algorithm, &res.PublicKey, &res.Fingerprint)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return res, customerror.ErrNoPublicKey
+	}
+	return res, err
+}
+
+// InsertShare - shares the vault item of the current user with the recipient or replaces the share.
+// The item key must be wrapped with the current public key of the recipient.
+func (c *ClientPostgres) InsertShare(ctx context.Context, model models.ShareModel) error {
+	return c.inTx(ctx, func(tc *ClientPostgres) error {
+		var exists bool
+		q := `SELECT EXISTS(SELECT id FROM vault_items WHERE id = $1 AND uuid = $2 AND deleted = false);`
+		if err := tc.conn().QueryRow(ctx, q, model.ItemID, model.Owner).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return customerror.ErrRecordNotFound
+		}
+		recipient, err := tc.SelectPublicKey(ctx, model.RecipientLogin)
+		if err != nil {
+			return err
+		}
+		if recipient.UUID == model.Owner {
+			return customerror.ErrShareWithSelf
+		}
+		if recipient.Fingerprint != model.Fingerprint {
+			return customerror.ErrKeyFingerprint
+		}
+		q = `INSERT INTO vault_shares(owner, item_id, recipient, wrapped_key, fingerprint, permission) VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (item_id, recipient) DO UPDATE SET wrapped_key = EXCLUDED.wrapped_key, fingerprint = EXCLUDED.fingerprint,
+		permission = EXCLUDED.permission;`
+		_, err = tc.conn().Exec(ctx, q, model.Owner, model.ItemID, recipient.UUID, model.WrappedKey, model.Fingerprint, model.Permission)
+		return err
+	})
+}
+
+// DeleteShare - revokes the access of the recipient to the vault item of the current user.
+// The recipient may keep the data read before, the owner changes the secret to protect it.
+func (c *ClientPostgres) DeleteShare(ctx context.Context, model models.ShareModel) error {
+	q := `DELETE FROM vault_shares s USING users u WHERE s.owner = $1 AND s.item_id = $2 AND u.uuid = s.recipient AND u.login = $3;`
+	tag, err := c.conn().Exec(ctx, q, model.Owner, model.ItemID, model.RecipientLogin)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return customerror.ErrShareNotFound
+	}
+	return nil
+}
+
+// SelectSharedWithMe - get the not deleted vault items shared with the current user.
+func (c *ClientPostgres) SelectSharedWithMe(ctx context.Context, uuid string) ([]models.SharedItemModel, error) {
+	res := make([]models.SharedItemModel, 0)
+	q := `SELECT v.id, v.blob, v.metadata, v.revision, v.created_at, v.updated_at, u.login, s.wrapped_key, s.fingerprint,
+	s.permission, s.created_at FROM vault_shares s JOIN vault_items v ON v.id = s.item_id JOIN users u ON u.uuid = s.owner
+	WHERE s.recipient = $1 AND v.deleted = false ORDER BY s.created_at, s.id;`
+	rows, err := c.conn().Query(ctx, q, uuid)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		shared := models.SharedItemModel{}
+		err := rows.Scan(&shared.Item.ID, &shared.Item.Blob, &shared.Item.Metadata, &shared.Item.Revision, &shared.Item.CreatedAt,
+			&shared.Item.UpdatedAt, &shared.OwnerLogin, &shared.WrappedKey, &shared.Fingerprint, &shared.Permission, &shared.SharedAt)
+		if err != nil {
+			return res, err
+		}
+		res = append(res, shared)
+	}
+	return res, rows.Err()
+}
@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/srptools"
+	"github.com/jackc/pgx/v5"
+)
+
+// Owner of the data key of the server, it is not a user. The key derives the salts of the unknown logins
+// and encrypts the secret values of the started SRP logins.
+const serverKeyOwner = "00000000-0000-0000-0000-000000000000"
+
+// Secret value of the server of the started SRP login.
+const colSRPSecret = "srp_sessions.secret"
+
+// CreateSRPUser - creates the user with the SRP login. The user has no password, only its verifier is kept.
+func (c *ClientPostgres) CreateSRPUser(ctx context.Context, model models.SRPUserModel) (string, error) {
+	var uuid string
+	q := `INSERT INTO users (uuid, login, password, srp_salt, srp_verifier) VALUES (uuid_generate_v4(), $1, '', $2, $3)
+	ON CONFLICT (login) DO NOTHING RETURNING uuid;`
+	err := c.conn().QueryRow(ctx, q, model.Login, model.Salt, model.Verifier).Scan(&uuid)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", customerror.ErrUserIsExists
+	}
+	return uuid, err
+}
+
+// SelectSRPUser - get the salt and the verifier of the user.
+func (c *ClientPostgres) SelectSRPUser(ctx context.Context, login string) (models.SRPUserModel, error) {
+	res := models.SRPUserModel{Login: login}
+	q := `SELECT uuid, srp_salt, srp_verifier FROM users WHERE login = $1;`
+	err := c.conn().QueryRow(ctx, q, login).Scan(&res.UUID, &res.Salt, &res.Verifier)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return res, customerror.ErrLoginOrPassIncorrect
+	}
+	if err != nil {
+		return res, err
+	}
+	if len(res.Verifier) == 0 {
+		return res, customerror.ErrSRPNotSetUp
+	}
+	return res, nil
+}
+
+// FakeSRPSalt - returns the salt of the login that has no SRP login, so the login cannot be told from
+// the existing one by the start of the SRP login.
+func (c *ClientPostgres) FakeSRPSalt(ctx context.Context, login string) ([]byte, error) {
+	key, err := c.dataKey(ctx, serverKeyOwner)
+	if err != nil {
+		return nil, err
+	}
+	return srptools.FakeSalt(key, login), nil
+}
+
+// SetSRPVerifier - sets up the SRP login of the user who logged in with the password.
+// The verifier set up earlier is not replaced.
+func (c *ClientPostgres) SetSRPVerifier(ctx context.Context, model models.SRPUserModel) error {
+	q := `UPDATE users SET srp_salt = $1, srp_verifier = $2 WHERE uuid = $3 AND srp_verifier IS NULL;`
+	_, err := c.conn().Exec(ctx, q, model.Salt, model.Verifier, model.UUID)
+	return err
+}
+
+// DropPassword - removes the password hash of the user, after that the user logs in only with SRP.
+func (c *ClientPostgres) DropPassword(ctx context.Context, uuid string) error {
+	q := `UPDATE users SET password = '' WHERE uuid = $1 AND password <> '' AND srp_verifier IS NOT NULL;`
+	_, err := c.conn().Exec(ctx, q, uuid)
+	return err
+}
+
+// InsertSRPSession - saves the started SRP login. The secret value of the server is encrypted.
+func (c *ClientPostgres) InsertSRPSession(ctx context.Context, model models.SRPSessionModel) error {
+	secret, err := c.sealPayload(ctx, serverKeyOwner, model.ID, colSRPSecret, model.Secret)
+	if err != nil {
+		return err
+	}
+	q := `INSERT INTO srp_sessions(id, uuid, client_public, secret, expires_at) VALUES ($1, $2, $3, $4, $5);`
+	_, err = c.conn().Exec(ctx, q, model.ID, model.User.UUID, model.ClientPublic, secret, model.Expires)
+	return err
+}
+
+// TakeSRPSession - deletes the not expired SRP login and returns it with the verifier of the user,
+// so the login is finished at most once.
+func (c *ClientPostgres) TakeSRPSession(ctx context.Context, id string) (models.SRPSessionModel, error) {
+	res := models.SRPSessionModel{ID: id}
+	q := `DELETE FROM srp_sessions s USING users u WHERE s.id = $1 AND u.uuid = s.uuid
+	RETURNING s.uuid, u.login, u.srp_salt, u.srp_verifier, s.client_public, s.secret, s.expires_at;`
+	err := c.conn().QueryRow(ctx, q, id).Scan(&res.User.UUID, &res.User.Login, &res.User.Salt, &res.User.Verifier,
+		&res.ClientPublic, &res.Secret, &res.Expires)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return res, customerror.ErrSRPSessionNotFound
+	}
+	if err != nil {
+		return res, err
+	}
+	if !res.Expires.After(time.Now()) || len(res.User.Verifier) == 0 {
+		return res, customerror.ErrSRPSessionNotFound
+	}
+	res.Secret, err = c.openPayload(ctx, serverKeyOwner, id, c.sealVersion(), colSRPSecret, res.Secret)
+	return res, err
+}
+
+// DeleteExpiredSRPSessions - deletes the SRP logins expired before the time.
+func (c *ClientPostgres) DeleteExpiredSRPSessions(ctx context.Context, before time.Time) error {
+	q := `DELETE FROM srp_sessions WHERE expires_at < $1;`
+	_, err := c.conn().Exec(ctx, q, before)
+	return err
+}
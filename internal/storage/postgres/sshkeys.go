@@ -0,0 +1,134 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// InsertSSHKey - inserting the SSH key in database.
+func (c *ClientPostgres) InsertSSHKey(ctx context.Context, model models.ReqSSHKeyModel) (models.InsertRespModel, error) {
+	res := models.InsertRespModel{}
+	var id int32
+	privateKey, passphrase := model.Data.PrivateKey, model.Data.Passphrase
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		var err error
+		if id, err = tc.nextID(ctx, "ssh_key_data"); err != nil {
+			return err
+		}
+		ref := recordRef(datatypes.SSHKeyDataType, id)
+		err = tc.sealSecrets(ctx, model.UUID, ref, secret{colPrivateKey, &privateKey}, secret{colPassphrase, &passphrase})
+		if err != nil {
+			return err
+		}
+		q := `INSERT INTO ssh_key_data(id, uuid, type, title, private_key, public_key, passphrase, key_type, fingerprint, tag,
+		comment, seal_version) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);`
+		if _, err := tc.conn().Exec(ctx, q, id, model.UUID, datatypes.SSHKeyDataType, model.TechData.Title, privateKey,
+			model.Data.PublicKey, passphrase, model.Data.KeyType, model.Data.Fingerprint,
+			model.TechData.Tag, model.TechData.Comment, tc.sealVersion()); err != nil {
+			return err
+		}
+		return tc.setItemTags(ctx, model.UUID, datatypes.SSHKeyDataType, id, model.TechData.Tag)
+	})
+	if err != nil {
+		return res, err
+	}
+	res.ID = id
+	res.Title = model.TechData.Title
+	return res, nil
+}
+
+// UpdateSSHKey - update the SSH key in database.
+// Returns customerror.ErrRecordNotFound if the key does not exist.
+func (c *ClientPostgres) UpdateSSHKey(ctx context.Context, model models.ReqSSHKeyModel) (models.InsertRespModel, error) {
+	res := models.InsertRespModel{}
+	privateKey, passphrase := model.Data.PrivateKey, model.Data.Passphrase
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		owner, err := tc.itemOwner(ctx, model.UUID, datatypes.SSHKeyDataType, model.Data.ID, datatypes.EditorRole)
+		if err != nil {
+			return err
+		}
+		if err := tc.upgradeRecord(ctx, datatypes.SSHKeyDataType, model.Data.ID, owner); err != nil {
+			return err
+		}
+		ref := recordRef(datatypes.SSHKeyDataType, model.Data.ID)
+		err = tc.sealSecrets(ctx, owner, ref, secret{colPrivateKey, &privateKey}, secret{colPassphrase, &passphrase})
+		if err != nil {
+			return err
+		}
+		q := `UPDATE ssh_key_data SET title = $1, private_key = $2, public_key = $3, passphrase = $4, key_type = $5,
+		fingerprint = $6, tag = $7, comment = $8 WHERE uuid = $9 AND id = $10 AND deleted = false;`
+		tag, err := tc.conn().Exec(ctx, q, model.TechData.Title, privateKey, model.Data.PublicKey,
+			passphrase, model.Data.KeyType, model.Data.Fingerprint, model.TechData.Tag,
+			model.TechData.Comment, owner, model.Data.ID)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return customerror.ErrRecordNotFound
+		}
+		return tc.setItemTags(ctx, owner, datatypes.SSHKeyDataType, model.Data.ID, model.TechData.Tag)
+	})
+	if err != nil {
+		return res, err
+	}
+	res.ID = model.Data.ID
+	res.Title = model.TechData.Title
+	return res, nil
+}
+
+// SelectSSHKey - get the SSH key from database.
+// Returns customerror.ErrRecordNotFound if the key does not exist.
+func (c *ClientPostgres) SelectSSHKey(ctx context.Context, model models.IDModel) (models.RespSSHKeyModel, error) {
+	res := models.RespSSHKeyModel{}
+	owner, err := c.itemOwner(ctx, model.UUID, datatypes.SSHKeyDataType, model.ID, datatypes.ViewerRole)
+	if err != nil {
+		return res, err
+	}
+	var version int16
+	q := `SELECT id, private_key, public_key, passphrase, key_type, fingerprint, title, tag, comment, type, custom_fields,
+	seal_version FROM ssh_key_data WHERE id = $1 AND uuid = $2 AND deleted = false;`
+	err = c.conn().QueryRow(ctx, q, model.ID, owner).Scan(&res.Data.ID, &res.Data.PrivateKey, &res.Data.PublicKey,
+		&res.Data.Passphrase, &res.Data.KeyType, &res.Data.Fingerprint, &res.TechData.Title, &res.TechData.Tag,
+		&res.TechData.Comment, &res.TechData.Type, &res.TechData.Fields, &version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return res, customerror.ErrRecordNotFound
+	}
+	if err != nil {
+		return res, err
+	}
+	ref := recordRef(datatypes.SSHKeyDataType, model.ID)
+	err = c.openSecrets(ctx, owner, ref, version, secret{colPrivateKey, &res.Data.PrivateKey},
+		secret{colPassphrase, &res.Data.Passphrase})
+	if err != nil {
+		return res, err
+	}
+	if err := c.openFields(ctx, owner, ref, version, res.TechData.Fields); err != nil {
+		return res, err
+	}
+	res.TechData.ID = res.Data.ID
+	return res, nil
+}
+
+// SelectSSHKeysInfo - get the public information about all SSH keys of the current user.
+func (c *ClientPostgres) SelectSSHKeysInfo(ctx context.Context, uuid string) ([]models.SSHKeyInfoModel, error) {
+	res := make([]models.SSHKeyInfoModel, 0)
+	q := `SELECT id, key_type, public_key, fingerprint FROM ssh_key_data WHERE uuid = $1 AND deleted = false ORDER BY id;`
+	rows, err := c.conn().Query(ctx, q, uuid)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		info := models.SSHKeyInfoModel{}
+		if err := rows.Scan(&info.ID, &info.KeyType, &info.PublicKey, &info.Fingerprint); err != nil {
+			return res, err
+		}
+		res = append(res, info)
+	}
+	return res, rows.Err()
+}
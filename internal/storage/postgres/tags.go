@@ -0,0 +1,182 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/BillyBones007/pwdm_server/internal/tools/tagtools"
+)
+
+// setItemTags - replaces the tags of the record with the tags from the string.
+func (c *ClientPostgres) setItemTags(ctx context.Context, uuid string, dataType int32, id int32, tag string) error {
+	names := tagtools.Parse(tag)
+	q := `DELETE FROM item_tags WHERE type = $1 AND item_id = $2 AND tag_id IN (SELECT id FROM tags WHERE uuid = $3);`
+	if _, err := c.conn().Exec(ctx, q, dataType, id, uuid); err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	q = `INSERT INTO tags(uuid, name) SELECT $1, unnest($2::text[]) ON CONFLICT (uuid, name) DO NOTHING;`
+	if _, err := c.conn().Exec(ctx, q, uuid, names); err != nil {
+		return err
+	}
+	q = `INSERT INTO item_tags(tag_id, type, item_id) SELECT id, $3, $4 FROM tags WHERE uuid = $1 AND name = ANY($2);`
+	if _, err := c.conn().Exec(ctx, q, uuid, names, dataType, id); err != nil {
+		return err
+	}
+	return nil
+}
+
+// taggedItems - returns the records (data type -> list of id) marked with any of the tags.
+func (c *ClientPostgres) taggedItems(ctx context.Context, uuid string, names []string) (map[int32][]int32, error) {
+	res := make(map[int32][]int32)
+	q := `SELECT DISTINCT it.type, it.item_id FROM item_tags it JOIN tags t ON t.id = it.tag_id WHERE t.uuid = $1 AND t.name = ANY($2);`
+	rows, err := c.conn().Query(ctx, q, uuid, names)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var dataType, id int32
+		if err := rows.Scan(&dataType, &id); err != nil {
+			return res, err
+		}
+		res[dataType] = append(res[dataType], id)
+	}
+	return res, rows.Err()
+}
+
+// refreshItemTags - rewrites the tag string of the records from their current tags.
+func (c *ClientPostgres) refreshItemTags(ctx context.Context, uuid string, items map[int32][]int32) error {
+	for dataType, ids := range items {
+		table, ok := dataTables[dataType]
+		if !ok {
+			continue
+		}
+		q := fmt.Sprintf(`UPDATE %s d SET tag = COALESCE((SELECT string_agg(t.name, $1 ORDER BY t.name) FROM item_tags it
+		JOIN tags t ON t.id = it.tag_id WHERE it.type = $2 AND it.item_id = d.id), '') WHERE d.uuid = $3 AND d.id = ANY($4);`, table)
+		if _, err := c.conn().Exec(ctx, q, tagtools.JoinSeparator, dataType, uuid, ids); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// countItems - returns the number of records in the map.
+func countItems(items map[int32][]int32) int32 {
+	var count int32
+	for _, ids := range items {
+		count += int32(len(ids))
+	}
+	return count
+}
+
+// ListTags - get all tags of the current user with the number of records.
+func (c *ClientPostgres) ListTags(ctx context.Context, uuid string) ([]models.TagModel, error) {
+	res := make([]models.TagModel, 0)
+	q := `SELECT t.name, COUNT(i.id) FROM tags t LEFT JOIN item_tags it ON it.tag_id = t.id
+	LEFT JOIN items i ON i.type = it.type AND i.id = it.item_id AND i.deleted = false
+	WHERE t.uuid = $1 GROUP BY t.name ORDER BY t.name;`
+	rows, err := c.conn().Query(ctx, q, uuid)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		tag := models.TagModel{}
+		if err := rows.Scan(&tag.Name, &tag.Count); err != nil {
+			return res, err
+		}
+		res = append(res, tag)
+	}
+	return res, rows.Err()
+}
+
+// RenameTag - renames the tag on all records of the current user.
+// Returns the number of affected records.
+func (c *ClientPostgres) RenameTag(ctx context.Context, model models.RenameTagModel) (int32, error) {
+	var count int32
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		var exists bool
+		q := `SELECT EXISTS(SELECT id FROM tags WHERE uuid = $1 AND name = $2);`
+		if err := tc.conn().QueryRow(ctx, q, model.UUID, model.NewName).Scan(&exists); err != nil {
+			return err
+		}
+		if exists {
+			return customerror.ErrTagIsExists
+		}
+
+		items, err := tc.taggedItems(ctx, model.UUID, []string{model.Name})
+		if err != nil {
+			return err
+		}
+		q = `UPDATE tags SET name = $3 WHERE uuid = $1 AND name = $2;`
+		tag, err := tc.conn().Exec(ctx, q, model.UUID, model.Name, model.NewName)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return customerror.ErrTagNotFound
+		}
+		count = countItems(items)
+		return tc.refreshItemTags(ctx, model.UUID, items)
+	})
+	return count, err
+}
+
+// MergeTags - replaces the tags with the target tag on all records of the current user.
+// Returns the number of affected records.
+func (c *ClientPostgres) MergeTags(ctx context.Context, model models.MergeTagsModel) (int32, error) {
+	var count int32
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		items, err := tc.taggedItems(ctx, model.UUID, model.Names)
+		if err != nil {
+			return err
+		}
+
+		q := `INSERT INTO tags(uuid, name) VALUES ($1, $2) ON CONFLICT (uuid, name) DO NOTHING;`
+		if _, err := tc.conn().Exec(ctx, q, model.UUID, model.Target); err != nil {
+			return err
+		}
+		q = `INSERT INTO item_tags(tag_id, type, item_id)
+		SELECT (SELECT id FROM tags WHERE uuid = $1 AND name = $3), it.type, it.item_id FROM item_tags it
+		JOIN tags t ON t.id = it.tag_id WHERE t.uuid = $1 AND t.name = ANY($2) ON CONFLICT DO NOTHING;`
+		if _, err := tc.conn().Exec(ctx, q, model.UUID, model.Names, model.Target); err != nil {
+			return err
+		}
+		q = `DELETE FROM tags WHERE uuid = $1 AND name = ANY($2) AND name <> $3;`
+		if _, err := tc.conn().Exec(ctx, q, model.UUID, model.Names, model.Target); err != nil {
+			return err
+		}
+		count = countItems(items)
+		return tc.refreshItemTags(ctx, model.UUID, items)
+	})
+	return count, err
+}
+
+// DeleteTag - removes the tag from all records of the current user.
+// Returns the number of affected records.
+func (c *ClientPostgres) DeleteTag(ctx context.Context, model models.TagReqModel) (int32, error) {
+	var count int32
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		items, err := tc.taggedItems(ctx, model.UUID, []string{model.Name})
+		if err != nil {
+			return err
+		}
+		q := `DELETE FROM tags WHERE uuid = $1 AND name = $2;`
+		tag, err := tc.conn().Exec(ctx, q, model.UUID, model.Name)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return customerror.ErrTagNotFound
+		}
+		count = countItems(items)
+		return tc.refreshItemTags(ctx, model.UUID, items)
+	})
+	return count, err
+}
@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// SetTOTP - sets the TOTP secret of the login/password record, the empty secret removes it.
+func (c *ClientPostgres) SetTOTP(ctx context.Context, model models.TOTPModel) error {
+	owner, err := c.itemOwner(ctx, model.UUID, datatypes.LoginPasswordDataType, model.ID, datatypes.EditorRole)
+	if err != nil {
+		return err
+	}
+	uri := model.URI
+	return c.inTx(ctx, func(tc *ClientPostgres) error {
+		if err := tc.upgradeRecord(ctx, datatypes.LoginPasswordDataType, model.ID, owner); err != nil {
+			return err
+		}
+		ref := recordRef(datatypes.LoginPasswordDataType, model.ID)
+		if err := tc.sealSecrets(ctx, owner, ref, secret{colTOTP, &uri}); err != nil {
+			return err
+		}
+		q := `UPDATE log_pwd_data SET totp = $3 WHERE id = $1 AND uuid = $2 AND deleted = false;`
+		tag, err := tc.conn().Exec(ctx, q, model.ID, owner, uri)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return customerror.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// SelectTOTP - get the TOTP secret of the login/password record.
+func (c *ClientPostgres) SelectTOTP(ctx context.Context, model models.IDModel) (string, error) {
+	var uri string
+	var version int16
+	owner, err := c.itemOwner(ctx, model.UUID, datatypes.LoginPasswordDataType, model.ID, datatypes.ViewerRole)
+	if err != nil {
+		return uri, err
+	}
+	q := `SELECT totp, seal_version FROM log_pwd_data WHERE id = $1 AND uuid = $2 AND deleted = false;`
+	err = c.conn().QueryRow(ctx, q, model.ID, owner).Scan(&uri, &version)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return uri, customerror.ErrRecordNotFound
+	}
+	if err != nil {
+		return uri, err
+	}
+	ref := recordRef(datatypes.LoginPasswordDataType, model.ID)
+	if err := c.openSecrets(ctx, owner, ref, version, secret{colTOTP, &uri}); err != nil {
+		return "", err
+	}
+	if uri == "" {
+		return uri, customerror.ErrTOTPNotSet
+	}
+	return uri, nil
+}
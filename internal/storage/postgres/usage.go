@@ -0,0 +1,25 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+)
+
+// SelectUsage - get the logical and the stored size of the text notes, the binary records
+// and the attachments of the user. The deleted records are counted until they are purged.
+func (c *ClientPostgres) SelectUsage(ctx context.Context, uuid string) (models.UsageModel, error) {
+	res := models.UsageModel{Quota: c.Quota}
+	q := `SELECT
+	(SELECT COALESCE(sum(COALESCE(size, octet_length(data), 0)), 0) FROM text_data WHERE uuid = $1)::bigint,
+	(SELECT COALESCE(sum(COALESCE(octet_length(data_z), octet_length(data), 0)), 0) FROM text_data WHERE uuid = $1)::bigint,
+	(SELECT COALESCE(sum(d.size), 0) FROM binary_data d WHERE d.uuid = $1)::bigint,
+	(SELECT COALESCE(sum(COALESCE(b.stored_size, d.size)), 0) FROM binary_data d
+		LEFT JOIN blobs b ON b.sha256 = d.sha256 WHERE d.uuid = $1)::bigint,
+	(SELECT COALESCE(sum(a.size), 0) FROM attachments a WHERE a.uuid = $1)::bigint,
+	(SELECT COALESCE(sum(COALESCE(b.stored_size, a.size)), 0) FROM attachments a
+		LEFT JOIN blobs b ON b.sha256 = a.sha256 WHERE a.uuid = $1)::bigint;`
+	err := c.conn().QueryRow(ctx, q, uuid).Scan(&res.Text.Logical, &res.Text.Stored, &res.Binary.Logical,
+		&res.Binary.Stored, &res.Attachments.Logical, &res.Attachments.Stored)
+	return res, err
+}
@@ -0,0 +1,210 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/jackc/pgx/v5"
+)
+
+// InsertVaultParams - switches the user to the vault mode with the key derivation parameters.
+// The parameters are set once, the client changing the master password encrypts the vault again with the new key.
+func (c *ClientPostgres) InsertVaultParams(ctx context.Context, model models.VaultParamsModel) error {
+	q := `INSERT INTO vault_params(uuid, kdf, salt, iterations, memory, parallelism) VALUES ($1, $2, $3, $4, $5, $6)
+	ON CONFLICT (uuid) DO NOTHING;`
+	tag, err := c.conn().Exec(ctx, q, model.UUID, model.KDF, model.Salt, model.Iterations, model.Memory, model.Parallelism)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return customerror.ErrVaultExists
+	}
+	return nil
+}
+
+// SelectVaultParams - get the key derivation parameters of the user.
+func (c *ClientPostgres) SelectVaultParams(ctx context.Context, uuid string) (models.VaultParamsModel, error) {
+	res := models.VaultParamsModel{UUID: uuid}
+	q := `SELECT kdf, salt, iterations, memory, parallelism FROM vault_params WHERE uuid = $1;`
+	err := c.conn().QueryRow(ctx, q, uuid).Scan(&res.KDF, &res.Salt, &res.Iterations, &res.Memory, &res.Parallelism)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return res, customerror.ErrVaultNotSetUp
+	}
+	return res, err
+}
+
+// IsVaultAccount - checks that the user is in the vault mode.
+func (c *ClientPostgres) IsVaultAccount(ctx context.Context, uuid string) (bool, error) {
+	var flag bool
+	q := `SELECT EXISTS(SELECT uuid FROM vault_params WHERE uuid = $1);`
+	if err := c.conn().QueryRow(ctx, q, uuid).Scan(&flag); err != nil {
+		return flag, err
+	}
+	return flag, nil
+}
+
+// nextVaultRevision - returns the next revision of the vault of the user.
+// The row of the parameters is locked, so the revisions are assigned in the order of the commits.
+func (c *ClientPostgres) nextVaultRevision(ctx context.Context, uuid string) (int64, error) {
+	var revision int64
+	q := `UPDATE vault_params SET revision = revision + 1 WHERE uuid = $1 RETURNING revision;`
+	err := c.conn().QueryRow(ctx, q, uuid).Scan(&revision)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return revision, customerror.ErrVaultNotSetUp
+	}
+	return revision, err
+}
+
+// vaultItemConflict - returns the error of the item not changed by the revision check.
+func (c *ClientPostgres) vaultItemConflict(ctx context.Context, uuid string, id int32) error {
+	var exists bool
+	q := `SELECT EXISTS(SELECT id FROM vault_items WHERE id = $1 AND uuid = $2 AND deleted = false);`
+	if err := c.conn().QueryRow(ctx, q, id, uuid).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return customerror.ErrRecordNotFound
+	}
+	return customerror.ErrVaultConflict
+}
+
+// vaultItemOwner - returns the owner of the not deleted item available to the user: the own item
+// or the item shared with the user. The shared item is changed only with the read-write permission.
+func (c *ClientPostgres) vaultItemOwner(ctx context.Context, uuid string, id int32) (string, error) {
+	var owner string
+	var writable bool
+	q := `SELECT v.uuid, v.uuid = $2 OR COALESCE(s.permission = $3, false) FROM vault_items v
+	LEFT JOIN vault_shares s ON s.item_id = v.id AND s.recipient = $2
+	WHERE v.id = $1 AND v.deleted = false AND (v.uuid = $2 OR s.recipient IS NOT NULL);`
+	err := c.conn().QueryRow(ctx, q, id, uuid, datatypes.ReadWritePermission).Scan(&owner, &writable)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return owner, customerror.ErrRecordNotFound
+	}
+	if err != nil {
+		return owner, err
+	}
+	if !writable {
+		return owner, customerror.ErrReadOnlyShare
+	}
+	return owner, nil
+}
+
+// PutVaultItem - saves the new item or replaces the item if it has the revision known to the client.
+// The item shared with the user is replaced in the vault of its owner.
+// Returns the id and the new revision of the item.
+func (c *ClientPostgres) PutVaultItem(ctx context.Context, model models.VaultItemModel) (models.VaultItemModel, error) {
+	res := models.VaultItemModel{UUID: model.UUID, ID: model.ID}
+	metadata := model.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		owner := model.UUID
+		if model.ID != 0 {
+			var err error
+			if owner, err = tc.vaultItemOwner(ctx, model.UUID, model.ID); err != nil {
+				return err
+			}
+		}
+		revision, err := tc.nextVaultRevision(ctx, owner)
+		if err != nil {
+			return err
+		}
+		res.Revision = revision
+		if model.ID == 0 {
+			q := `INSERT INTO vault_items(uuid, blob, metadata, revision) VALUES ($1, $2, $3, $4) RETURNING id;`
+			return tc.conn().QueryRow(ctx, q, model.UUID, model.Blob, metadata, revision).Scan(&res.ID)
+		}
+		q := `UPDATE vault_items SET blob = $1, metadata = $2, revision = $3, updated_at = now()
+		WHERE id = $4 AND uuid = $5 AND revision = $6 AND deleted = false;`
+		tag, err := tc.conn().Exec(ctx, q, model.Blob, metadata, revision, model.ID, owner, model.Revision)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return tc.vaultItemConflict(ctx, owner, model.ID)
+		}
+		return nil
+	})
+	return res, err
+}
+
+// SelectVaultItem - get the not deleted item of the user or shared with the user.
+func (c *ClientPostgres) SelectVaultItem(ctx context.Context, model models.IDModel) (models.VaultItemModel, error) {
+	res := models.VaultItemModel{UUID: model.UUID, ID: model.ID}
+	q := `SELECT blob, metadata, revision, created_at, updated_at FROM vault_items v WHERE id = $1 AND deleted = false
+	AND (uuid = $2 OR EXISTS(SELECT id FROM vault_shares s WHERE s.item_id = v.id AND s.recipient = $2));`
+	err := c.conn().QueryRow(ctx, q, model.ID, model.UUID).Scan(&res.Blob, &res.Metadata, &res.Revision, &res.CreatedAt, &res.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return res, customerror.ErrRecordNotFound
+	}
+	return res, err
+}
+
+// DeleteVaultItem - deletes the item if it has the revision known to the client. Only the owner deletes the item,
+// the shares of the item are deleted with it. The deleted item is kept without the blob and the metadata,
+// so the other clients get the deletion. Returns the new revision of the item.
+func (c *ClientPostgres) DeleteVaultItem(ctx context.Context, model models.VaultItemModel) (int64, error) {
+	var revision int64
+	err := c.inTx(ctx, func(tc *ClientPostgres) error {
+		var err error
+		revision, err = tc.nextVaultRevision(ctx, model.UUID)
+		if err != nil {
+			return err
+		}
+		q := `UPDATE vault_items SET blob = '', metadata = '{}', revision = $1, deleted = true, updated_at = now()
+		WHERE id = $2 AND uuid = $3 AND revision = $4 AND deleted = false;`
+		tag, err := tc.conn().Exec(ctx, q, revision, model.ID, model.UUID, model.Revision)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return tc.vaultItemConflict(ctx, model.UUID, model.ID)
+		}
+		_, err = tc.conn().Exec(ctx, `DELETE FROM vault_shares WHERE item_id = $1;`, model.ID)
+		return err
+	})
+	return revision, err
+}
+
+// SelectVaultItems - get the items changed since the revision, including the deleted items.
+// The items are filtered by the metadata only for the search, the deleted items have no metadata.
+func (c *ClientPostgres) SelectVaultItems(ctx context.Context, model models.VaultListReqModel) (models.VaultListModel, error) {
+	res := models.VaultListModel{Items: make([]models.VaultItemModel, 0), Revision: model.Since}
+	match := model.Match
+	if match == nil {
+		match = map[string]string{}
+	}
+
+	q := `SELECT id, blob, metadata, revision, deleted, created_at, updated_at FROM vault_items
+	WHERE uuid = $1 AND revision > $2 AND metadata @> $3 ORDER BY revision LIMIT $4;`
+	// one extra row shows whether there is a next page
+	rows, err := c.conn().Query(ctx, q, model.UUID, model.Since, match, model.Limit+1)
+	if err != nil {
+		return res, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		item := models.VaultItemModel{UUID: model.UUID}
+		err := rows.Scan(&item.ID, &item.Blob, &item.Metadata, &item.Revision, &item.Deleted, &item.CreatedAt, &item.UpdatedAt)
+		if err != nil {
+			return res, err
+		}
+		res.Items = append(res.Items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return res, err
+	}
+
+	if len(res.Items) > int(model.Limit) {
+		res.Items = res.Items[:model.Limit]
+		res.HasMore = true
+	}
+	if len(res.Items) > 0 {
+		res.Revision = res.Items[len(res.Items)-1].Revision
+	}
+	return res, nil
+}
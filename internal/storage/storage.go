@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/BillyBones007/pwdm_server/internal/storage/models"
 )
@@ -23,10 +24,104 @@ type Storage interface {
 	InsertBinaryData(ctx context.Context, model models.ReqBinaryModel) (models.InsertRespModel, error)
 	SelectLogPwdPair(ctx context.Context, model models.IDModel) (models.RespLogPwdModel, error)
 	SelectCardData(ctx context.Context, model models.IDModel) (models.RespCardModel, error)
+	SelectCardNumbers(ctx context.Context, model models.ListRecordsModel) (map[int32]string, error)
 	SelectTextData(ctx context.Context, model models.IDModel) (models.RespTextModel, error)
 	SelectBinaryData(ctx context.Context, model models.IDModel) (models.RespBinaryModel, error)
 	SelectAllInfoUser(ctx context.Context, uuid string) ([]models.DataRecordModel, error)
+	ListItems(ctx context.Context, model models.ListItemsReqModel) (models.ListItemsRespModel, error)
+	ListTags(ctx context.Context, uuid string) ([]models.TagModel, error)
+	RenameTag(ctx context.Context, model models.RenameTagModel) (int32, error)
+	MergeTags(ctx context.Context, model models.MergeTagsModel) (int32, error)
+	DeleteTag(ctx context.Context, model models.TagReqModel) (int32, error)
+	SelectFolders(ctx context.Context, uuid string) ([]models.FolderModel, error)
+	SelectFolderItems(ctx context.Context, model models.FolderReqModel) ([]models.DataRecordModel, error)
+	CreateFolder(ctx context.Context, model models.FolderModel) (int32, error)
+	RenameFolder(ctx context.Context, model models.FolderModel) error
+	MoveFolder(ctx context.Context, model models.FolderModel) error
+	DeleteFolder(ctx context.Context, model models.FolderReqModel) (int32, error)
+	MoveItems(ctx context.Context, model models.MoveItemsModel) (int32, error)
+	SetCustomFields(ctx context.Context, model models.CustomFieldsModel) error
+	SetLoginURIs(ctx context.Context, model models.LoginURIsModel) error
+	SelectLoginURIs(ctx context.Context, model models.IDModel) ([]models.LoginURIModel, error)
+	LookupLogins(ctx context.Context, model models.LookupLoginsModel) ([]models.LoginMatchModel, error)
+	SetTOTP(ctx context.Context, model models.TOTPModel) error
+	SelectTOTP(ctx context.Context, model models.IDModel) (string, error)
+	InsertSSHKey(ctx context.Context, model models.ReqSSHKeyModel) (models.InsertRespModel, error)
+	UpdateSSHKey(ctx context.Context, model models.ReqSSHKeyModel) (models.InsertRespModel, error)
+	SelectSSHKey(ctx context.Context, model models.IDModel) (models.RespSSHKeyModel, error)
+	SelectSSHKeysInfo(ctx context.Context, uuid string) ([]models.SSHKeyInfoModel, error)
+	InsertIdentity(ctx context.Context, model models.ReqIdentityModel) (models.InsertRespModel, error)
+	UpdateIdentity(ctx context.Context, model models.ReqIdentityModel) (models.InsertRespModel, error)
+	SelectIdentity(ctx context.Context, model models.IDModel) (models.RespIdentityModel, error)
+	SetExpiry(ctx context.Context, model models.ExpiryModel) error
+	SelectExpiring(ctx context.Context, model models.ExpiringReqModel) ([]models.ExpiringItemModel, error)
+	SelectDueReminders(ctx context.Context, before time.Time, limit int32) ([]models.ExpiringItemModel, error)
+	MarkReminded(ctx context.Context, model models.ExpiringItemModel) error
+	SetFavorite(ctx context.Context, model models.FavoriteModel) error
+	TouchItem(ctx context.Context, model models.IDModel) error
+	SelectRecentItems(ctx context.Context, model models.RecentItemsModel) ([]models.DataRecordModel, error)
+	CreateUpload(ctx context.Context, model models.BinaryUploadModel) (string, error)
+	SelectUpload(ctx context.Context, model models.UploadReqModel) (models.BinaryUploadModel, error)
+	AppendUploadChunk(ctx context.Context, model models.BinaryChunkModel) (int64, error)
+	FinishUpload(ctx context.Context, model models.UploadReqModel) (models.InsertRespModel, error)
+	DeleteUpload(ctx context.Context, model models.UploadReqModel) error
+	DeleteExpiredUploads(ctx context.Context, expired time.Time) error
+	SelectBinaryInfo(ctx context.Context, model models.IDModel) (models.RespBinaryModel, error)
+	ReadBinaryChunk(ctx context.Context, model models.BinaryReadModel) ([]byte, error)
+	CollectBlobs(ctx context.Context, before time.Time) (int, error)
+	SelectAttachments(ctx context.Context, model models.IDModel) ([]models.AttachmentModel, error)
+	SelectAttachment(ctx context.Context, model models.AttachmentReqModel) (models.AttachmentModel, error)
+	ReadAttachmentChunk(ctx context.Context, model models.BinaryReadModel) ([]byte, error)
+	DeleteAttachment(ctx context.Context, model models.AttachmentReqModel) error
+	SelectUsage(ctx context.Context, uuid string) (models.UsageModel, error)
+	MovePendingBlobs(ctx context.Context) (int, error)
+	SealPlainSecrets(ctx context.Context) (int, error)
+	RewrapDataKeys(ctx context.Context, limit int) (int, error)
+	SelectKeyVersions(ctx context.Context) ([]models.KeyVersionModel, error)
+	InsertVaultParams(ctx context.Context, model models.VaultParamsModel) error
+	SelectVaultParams(ctx context.Context, uuid string) (models.VaultParamsModel, error)
+	IsVaultAccount(ctx context.Context, uuid string) (bool, error)
+	PutVaultItem(ctx context.Context, model models.VaultItemModel) (models.VaultItemModel, error)
+	SelectVaultItem(ctx context.Context, model models.IDModel) (models.VaultItemModel, error)
+	DeleteVaultItem(ctx context.Context, model models.VaultItemModel) (int64, error)
+	SelectVaultItems(ctx context.Context, model models.VaultListReqModel) (models.VaultListModel, error)
+	CreateSRPUser(ctx context.Context, model models.SRPUserModel) (string, error)
+	SelectSRPUser(ctx context.Context, login string) (models.SRPUserModel, error)
+	FakeSRPSalt(ctx context.Context, login string) ([]byte, error)
+	SetSRPVerifier(ctx context.Context, model models.SRPUserModel) error
+	DropPassword(ctx context.Context, uuid string) error
+	InsertSRPSession(ctx context.Context, model models.SRPSessionModel) error
+	TakeSRPSession(ctx context.Context, id string) (models.SRPSessionModel, error)
+	DeleteExpiredSRPSessions(ctx context.Context, before time.Time) error
+	UpsertKeyPair(ctx context.Context, model models.KeyPairModel) error
+	SelectKeyPair(ctx context.Context, uuid string) (models.KeyPairModel, error)
+	SelectPublicKey(ctx context.Context, login string) (models.KeyPairModel, error)
+	InsertShare(ctx context.Context, model models.ShareModel) error
+	DeleteShare(ctx context.Context, model models.ShareModel) error
+	SelectSharedWithMe(ctx context.Context, uuid string) ([]models.SharedItemModel, error)
+	CreateOrganization(ctx context.Context, model models.OrganizationModel) (string, error)
+	SelectOrganizations(ctx context.Context, uuid string) ([]models.OrganizationModel, error)
+	SelectOrgMembers(ctx context.Context, model models.OrgMemberModel) ([]models.OrgMemberModel, error)
+	RemoveOrgMember(ctx context.Context, model models.OrgMemberModel) error
+	InviteOrgMember(ctx context.Context, model models.InvitationModel) error
+	SelectInvitations(ctx context.Context, uuid string) ([]models.InvitationModel, error)
+	AcceptInvitation(ctx context.Context, model models.InvitationModel) error
+	DeclineInvitation(ctx context.Context, model models.InvitationModel) error
+	CreateCollection(ctx context.Context, model models.CollectionModel) (int32, error)
+	SelectCollections(ctx context.Context, uuid string) ([]models.CollectionModel, error)
+	SetCollectionRole(ctx context.Context, model models.CollectionRoleModel) error
+	SelectCollectionMembers(ctx context.Context, model models.CollectionReqModel) ([]models.CollectionRoleModel, error)
+	SelectCollectionItems(ctx context.Context, model models.CollectionReqModel) ([]models.DataRecordModel, error)
+	MoveToCollection(ctx context.Context, model models.MoveToCollectionModel) error
 	DeleteRecord(ctx context.Context, model models.IDModel) error
-	// DeleteAllRecords(ctx context.Context, model models.ListRecordsModel) error
+	SelectChanges(ctx context.Context, model models.SyncReqModel) (models.SyncRespModel, error)
+	ListenChanges(ctx context.Context, out chan<- string) error
+	DeleteAllRecords(ctx context.Context, model models.ListRecordsModel) error
+	RecordIsExists(ctx context.Context, model models.IDModel) (bool, error)
+	Batch(ctx context.Context, model models.BatchReqModel) (models.BatchRespModel, error)
+	ReserveIdempotencyKey(ctx context.Context, model models.IdempotencyModel) (models.IdempotencyModel, bool, error)
+	SaveIdempotencyResponse(ctx context.Context, model models.IdempotencyModel) error
+	DeleteIdempotencyKey(ctx context.Context, model models.IdempotencyModel) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context, expired time.Time) error
 	Close()
 }
@@ -0,0 +1,187 @@
+// Cardtools package checks the bank card data and detects the card brand by the IIN ranges.
+package cardtools
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+)
+
+// Card brands.
+const (
+	Unknown    string = ""
+	Visa       string = "visa"
+	Mastercard string = "mastercard"
+	Mir        string = "mir"
+	Amex       string = "amex"
+	Discover   string = "discover"
+	JCB        string = "jcb"
+	DinersClub string = "diners_club"
+	UnionPay   string = "unionpay"
+	Maestro    string = "maestro"
+)
+
+// Length limits of the card number.
+const (
+	minNumLen int = 12
+	maxNumLen int = 19
+)
+
+// iinRange - range of the first digits of the card numbers of the brand.
+type iinRange struct {
+	brand string
+	low   int // first digits, all bounds of the range have the same number of digits
+	high  int
+}
+
+// Ranges are checked in order, the more specific ones go first.
+var iinRanges = []iinRange{
+	{Mir, 2200, 2204},
+	{Mastercard, 2221, 2720},
+	{Mastercard, 51, 55},
+	{Amex, 34, 34},
+	{Amex, 37, 37},
+	{Visa, 4, 4},
+	{JCB, 3528, 3589},
+	{DinersClub, 300, 305},
+	{DinersClub, 36, 36},
+	{DinersClub, 38, 39},
+	{Discover, 6011, 6011},
+	{Discover, 622126, 622925},
+	{Discover, 644, 649},
+	{Discover, 65, 65},
+	{UnionPay, 62, 62},
+	{Maestro, 50, 50},
+	{Maestro, 56, 58},
+	{Maestro, 63, 63},
+	{Maestro, 67, 67},
+}
+
+// FieldError - error of the field of the card data.
+type FieldError struct {
+	Field string // num, date or cvc
+	Err   error
+}
+
+// Check - checks the card data and returns it with the normalized number.
+// The empty CVC is allowed, the expired cards are allowed too.
+func Check(card models.CardModel) (models.CardModel, []FieldError) {
+	var errs []FieldError
+	card.Num = Normalize(card.Num)
+	if !ValidNumber(card.Num) {
+		errs = append(errs, FieldError{Field: "num", Err: customerror.ErrInvalidCardNumber})
+	}
+	card.Date = strings.TrimSpace(card.Date)
+	if _, err := ParseExpiry(card.Date); err != nil {
+		errs = append(errs, FieldError{Field: "date", Err: err})
+	}
+	card.CVC = strings.TrimSpace(card.CVC)
+	if card.CVC != "" && !validCVC(card.CVC, Brand(card.Num)) {
+		errs = append(errs, FieldError{Field: "cvc", Err: customerror.ErrInvalidCVC})
+	}
+	return card, errs
+}
+
+// Normalize - removes the spaces and dashes from the card number.
+func Normalize(num string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' {
+			return -1
+		}
+		return r
+	}, num)
+}
+
+// ValidNumber - checks the length and the Luhn checksum of the normalized card number.
+func ValidNumber(num string) bool {
+	if len(num) < minNumLen || len(num) > maxNumLen || !digits(num) {
+		return false
+	}
+	return Luhn(num)
+}
+
+// Luhn - checks the Luhn checksum of the digits.
+func Luhn(num string) bool {
+	sum := 0
+	double := false
+	for i := len(num) - 1; i >= 0; i-- {
+		d := int(num[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// Brand - returns the brand of the card number or Unknown.
+func Brand(num string) string {
+	num = Normalize(num)
+	if !digits(num) {
+		return Unknown
+	}
+	for _, r := range iinRanges {
+		n := len(strconv.Itoa(r.low))
+		if len(num) < n {
+			continue
+		}
+		prefix, _ := strconv.Atoi(num[:n])
+		if prefix >= r.low && prefix <= r.high {
+			return r.brand
+		}
+	}
+	return Unknown
+}
+
+// Mask - returns the masked card number with the last four digits, for example: **** 1234.
+func Mask(num string) string {
+	num = Normalize(num)
+	if len(num) < 4 {
+		return "****"
+	}
+	return "**** " + num[len(num)-4:]
+}
+
+// ParseExpiry - parses the expiry date in the MM/YY format.
+// Returns the last day of the month, the card is valid through it.
+func ParseExpiry(date string) (time.Time, error) {
+	parts := strings.Split(strings.TrimSpace(date), "/")
+	if len(parts) != 2 || len(parts[0]) != 2 || len(parts[1]) != 2 || !digits(parts[0]) || !digits(parts[1]) {
+		return time.Time{}, customerror.ErrInvalidCardDate
+	}
+	month, _ := strconv.Atoi(parts[0])
+	year, _ := strconv.Atoi(parts[1])
+	if month < 1 || month > 12 {
+		return time.Time{}, customerror.ErrInvalidCardDate
+	}
+	return time.Date(2000+year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC), nil
+}
+
+// validCVC - checks the CVC, American Express cards have four digits, the others have three.
+func validCVC(cvc string, brand string) bool {
+	n := 3
+	if brand == Amex {
+		n = 4
+	}
+	return len(cvc) == n && digits(cvc)
+}
+
+// digits - checks that the string is not empty and contains only digits.
+func digits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
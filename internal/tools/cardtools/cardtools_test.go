@@ -0,0 +1,74 @@
+package cardtools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBrand(t *testing.T) {
+	tests := []struct {
+		num   string
+		brand string
+	}{
+		{"4111 1111 1111 1111", Visa},
+		{"5555555555554444", Mastercard},
+		{"2221000000000009", Mastercard},
+		{"2200000000000004", Mir},
+		{"378282246310005", Amex},
+		{"6011111111111117", Discover},
+		{"3530111333300000", JCB},
+		{"30569309025904", DinersClub},
+		{"6200000000000005", UnionPay},
+		{"6759649826438453", Maestro},
+		{"9999999999999995", Unknown},
+		{"abc", Unknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.num, func(t *testing.T) {
+			assert.Equal(t, tt.brand, Brand(tt.num))
+		})
+	}
+}
+
+func TestValidNumber(t *testing.T) {
+	assert.True(t, ValidNumber("4111111111111111"))
+	assert.False(t, ValidNumber("4111111111111112"))
+	assert.False(t, ValidNumber("41111111111"))
+	assert.False(t, ValidNumber("4111-1111-1111-1111"))
+}
+
+func TestParseExpiry(t *testing.T) {
+	date, err := ParseExpiry("02/24")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC), date)
+	date, err = ParseExpiry("12/30")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2030, time.December, 31, 0, 0, 0, 0, time.UTC), date)
+
+	for _, bad := range []string{"", "13/25", "00/25", "1/25", "01/2025", "01-25", "ab/cd"} {
+		_, err := ParseExpiry(bad)
+		assert.ErrorIs(t, err, customerror.ErrInvalidCardDate, bad)
+	}
+}
+
+func TestMask(t *testing.T) {
+	assert.Equal(t, "**** 1111", Mask("4111 1111 1111 1111"))
+	assert.Equal(t, "****", Mask("12"))
+}
+
+func TestCheck(t *testing.T) {
+	card, errs := Check(models.CardModel{Num: "3782 822463 10005", Date: "07/29", CVC: "1234"})
+	assert.Empty(t, errs)
+	assert.Equal(t, "378282246310005", card.Num)
+
+	_, errs = Check(models.CardModel{Num: "4111111111111112", Date: "7/29", CVC: "1234"})
+	assert.Equal(t, []FieldError{
+		{Field: "num", Err: customerror.ErrInvalidCardNumber},
+		{Field: "date", Err: customerror.ErrInvalidCardDate},
+		{Field: "cvc", Err: customerror.ErrInvalidCVC},
+	}, errs)
+}
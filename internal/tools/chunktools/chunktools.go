@@ -0,0 +1,83 @@
+// Chunktools package contains the checksums of the binary data transferred in chunks.
+// The SHA-256 of the upload is computed chunk by chunk and its state is saved with every chunk,
+// so the interrupted upload is resumed without reading the received data again.
+package chunktools
+
+import (
+	"crypto/sha256"
+	"encoding"
+	"encoding/hex"
+	"hash"
+	"hash/crc32"
+	"strings"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+)
+
+// Hash - SHA-256 of the data received in chunks.
+type Hash struct {
+	h hash.Hash
+}
+
+// NewHash - returns the hash of the empty data.
+func NewHash() *Hash {
+	return &Hash{h: sha256.New()}
+}
+
+// ResumeHash - returns the hash restored from the saved state, the empty state is the empty data.
+func ResumeHash(state []byte) (*Hash, error) {
+	h := sha256.New()
+	if len(state) == 0 {
+		return &Hash{h: h}, nil
+	}
+	if err := h.(encoding.BinaryUnmarshaler).UnmarshalBinary(state); err != nil {
+		return nil, err
+	}
+	return &Hash{h: h}, nil
+}
+
+// Write - adds the chunk to the hash.
+func (h *Hash) Write(chunk []byte) {
+	h.h.Write(chunk)
+}
+
+// State - returns the state of the hash for saving.
+func (h *Hash) State() ([]byte, error) {
+	return h.h.(encoding.BinaryMarshaler).MarshalBinary()
+}
+
+// Sum - returns the hex SHA-256 of the written data.
+func (h *Hash) Sum() string {
+	return hex.EncodeToString(h.h.Sum(nil))
+}
+
+// Sum - returns the hex SHA-256 of the data.
+func Sum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// NormalizeSum - checks the hex SHA-256 and returns it in lower case.
+func NormalizeSum(sum string) (string, error) {
+	sum = strings.ToLower(strings.TrimSpace(sum))
+	if len(sum) != sha256.Size*2 {
+		return "", customerror.ErrInvalidChecksum
+	}
+	if _, err := hex.DecodeString(sum); err != nil {
+		return "", customerror.ErrInvalidChecksum
+	}
+	return sum, nil
+}
+
+// CRC - returns the CRC-32 (IEEE) of the chunk.
+func CRC(chunk []byte) uint32 {
+	return crc32.ChecksumIEEE(chunk)
+}
+
+// CheckChunk - checks the CRC-32 of the chunk.
+func CheckChunk(chunk []byte, crc uint32) error {
+	if CRC(chunk) != crc {
+		return customerror.ErrChunkChecksum
+	}
+	return nil
+}
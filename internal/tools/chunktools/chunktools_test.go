@@ -0,0 +1,59 @@
+package chunktools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHash(t *testing.T) {
+	t.Run("Resume after every chunk", func(t *testing.T) {
+		data := []byte(strings.Repeat("binary data ", 1000))
+		var state []byte
+		for pos := 0; pos < len(data); pos += 1000 {
+			end := pos + 1000
+			if end > len(data) {
+				end = len(data)
+			}
+			h, err := ResumeHash(state)
+			assert.NoError(t, err)
+			h.Write(data[pos:end])
+			state, err = h.State()
+			assert.NoError(t, err)
+		}
+		h, err := ResumeHash(state)
+		assert.NoError(t, err)
+		assert.Equal(t, Sum(data), h.Sum())
+	})
+
+	t.Run("Empty data", func(t *testing.T) {
+		h, err := ResumeHash(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", h.Sum())
+		assert.Equal(t, h.Sum(), NewHash().Sum())
+	})
+
+	t.Run("Invalid state", func(t *testing.T) {
+		_, err := ResumeHash([]byte("state"))
+		assert.Error(t, err)
+	})
+}
+
+func TestNormalizeSum(t *testing.T) {
+	sum, err := NormalizeSum(" E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855 ")
+	assert.NoError(t, err)
+	assert.Equal(t, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", sum)
+
+	_, err = NormalizeSum("e3b0")
+	assert.ErrorIs(t, err, customerror.ErrInvalidChecksum)
+	_, err = NormalizeSum(strings.Repeat("z", 64))
+	assert.ErrorIs(t, err, customerror.ErrInvalidChecksum)
+}
+
+func TestCheckChunk(t *testing.T) {
+	chunk := []byte("chunk")
+	assert.NoError(t, CheckChunk(chunk, CRC(chunk)))
+	assert.ErrorIs(t, CheckChunk(chunk, CRC(chunk)+1), customerror.ErrChunkChecksum)
+}
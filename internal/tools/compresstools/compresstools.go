@@ -0,0 +1,204 @@
+// Compresstools package compresses the payloads stored by the server.
+// The text payload starts with the format marker, so the format can be changed without rewriting the old rows.
+// The content of the blob is compressed in independent frames, so its part is read without
+// decompressing the whole content. The frame that does not shrink is stored as is.
+package compresstools
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+)
+
+// Formats of the payloads.
+const (
+	FormatRaw  byte = iota // stored as is
+	FormatGzip             // compressed with gzip
+)
+
+// Size of the uncompressed frame of the blob.
+const FrameSize int64 = 1 << 20
+
+// Encode - returns the payload with the format marker. The payload is compressed if it shrinks.
+func Encode(data []byte) []byte {
+	if compressed, ok := compress(data); ok {
+		return append([]byte{FormatGzip}, compressed...)
+	}
+	return append([]byte{FormatRaw}, data...)
+}
+
+// Decode - returns the data of the payload with the format marker.
+func Decode(payload []byte) ([]byte, error) {
+	if len(payload) == 0 {
+		return nil, customerror.ErrUnknownFormat
+	}
+	switch payload[0] {
+	case FormatRaw:
+		return payload[1:], nil
+	case FormatGzip:
+		return decompress(payload[1:], -1)
+	}
+	return nil, customerror.ErrUnknownFormat
+}
+
+// Frame - stored frame of the blob.
+type Frame struct {
+	Size       int64 // stored size
+	Compressed bool
+}
+
+// CompressFrames - reads the content in frames of FrameSize and writes the stored frames to w.
+// Returns the frames and the size of the content.
+func CompressFrames(r io.Reader, w io.Writer) ([]Frame, int64, error) {
+	frames := make([]Frame, 0)
+	buf := make([]byte, FrameSize)
+	var size int64
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			data := buf[:n]
+			frame := Frame{Size: int64(n)}
+			if compressed, ok := compress(data); ok {
+				data = compressed
+				frame = Frame{Size: int64(len(compressed)), Compressed: true}
+			}
+			if _, err := w.Write(data); err != nil {
+				return nil, size, err
+			}
+			frames = append(frames, frame)
+			size += int64(n)
+		}
+		if err == io.EOF || errors.Is(err, io.ErrUnexpectedEOF) {
+			return frames, size, nil
+		}
+		if err != nil {
+			return nil, size, err
+		}
+	}
+}
+
+// Compressed - checks if any frame is compressed. Otherwise the stored content is the content itself.
+func Compressed(frames []Frame) bool {
+	for _, frame := range frames {
+		if frame.Compressed {
+			return true
+		}
+	}
+	return false
+}
+
+// StoredSize - returns the size of the stored frames.
+func StoredSize(frames []Frame) int64 {
+	var size int64
+	for _, frame := range frames {
+		size += frame.Size
+	}
+	return size
+}
+
+// FrameRange - returns the position and the size of the stored frames containing
+// the part of the content starting at the offset, and the index of the first of them.
+func FrameRange(frames []Frame, offset int64, length int64) (int64, int64, int) {
+	first := int(offset / FrameSize)
+	last := int((offset + length - 1) / FrameSize)
+	if last >= len(frames) {
+		last = len(frames) - 1
+	}
+	var start, size int64
+	for i := 0; i <= last; i++ {
+		if i < first {
+			start += frames[i].Size
+		} else {
+			size += frames[i].Size
+		}
+	}
+	return start, size, first
+}
+
+// ReadFrames - reads the stored frames starting at the frame first and returns their content.
+func ReadFrames(r io.Reader, frames []Frame, first int, size int64) ([]byte, error) {
+	res := make([]byte, 0)
+	for i := first; i < len(frames) && size > 0; i++ {
+		data := make([]byte, frames[i].Size)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, err
+		}
+		size -= frames[i].Size
+		if frames[i].Compressed {
+			var err error
+			if data, err = decompress(data, FrameSize); err != nil {
+				return nil, err
+			}
+		}
+		res = append(res, data...)
+	}
+	return res, nil
+}
+
+// EncodeFrames - returns the frames as bytes: the stored size of every frame with the compression flag in the high bit.
+func EncodeFrames(frames []Frame) []byte {
+	res := make([]byte, 4*len(frames))
+	for i, frame := range frames {
+		v := uint32(frame.Size)
+		if frame.Compressed {
+			v |= 1 << 31
+		}
+		binary.BigEndian.PutUint32(res[4*i:], v)
+	}
+	return res
+}
+
+// DecodeFrames - returns the frames encoded by EncodeFrames.
+func DecodeFrames(data []byte) ([]Frame, error) {
+	if len(data)%4 != 0 {
+		return nil, customerror.ErrUnknownFormat
+	}
+	res := make([]Frame, len(data)/4)
+	for i := range res {
+		v := binary.BigEndian.Uint32(data[4*i:])
+		res[i] = Frame{Size: int64(v &^ (1 << 31)), Compressed: v&(1<<31) != 0}
+	}
+	return res, nil
+}
+
+// compress - returns the compressed data and true if it is smaller than the data.
+func compress(data []byte) ([]byte, bool) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, false
+	}
+	if err := w.Close(); err != nil {
+		return nil, false
+	}
+	if buf.Len() >= len(data) {
+		return nil, false
+	}
+	return buf.Bytes(), true
+}
+
+// decompress - returns the decompressed data, the negative limit is unlimited.
+func decompress(data []byte, limit int64) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, customerror.ErrUnknownFormat
+	}
+	defer r.Close()
+	var src io.Reader = r
+	if limit >= 0 {
+		// the frame is never larger than FrameSize, more data means the corrupted frame
+		src = io.LimitReader(r, limit+1)
+	}
+	res, err := io.ReadAll(src)
+	if err != nil {
+		return nil, customerror.ErrUnknownFormat
+	}
+	if limit >= 0 && int64(len(res)) > limit {
+		return nil, customerror.ErrUnknownFormat
+	}
+	return res, nil
+}
@@ -0,0 +1,85 @@
+package compresstools
+
+import (
+	"bytes"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncode(t *testing.T) {
+	text := []byte(strings.Repeat("secret note ", 100))
+	payload := Encode(text)
+	assert.Equal(t, FormatGzip, payload[0])
+	assert.Less(t, len(payload), len(text))
+	data, err := Decode(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, text, data)
+
+	// the short data does not shrink
+	payload = Encode([]byte("pin"))
+	assert.Equal(t, []byte{FormatRaw, 'p', 'i', 'n'}, payload)
+	data, err = Decode(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("pin"), data)
+
+	_, err = Decode(nil)
+	assert.ErrorIs(t, err, customerror.ErrUnknownFormat)
+	_, err = Decode([]byte{42, 1})
+	assert.ErrorIs(t, err, customerror.ErrUnknownFormat)
+	_, err = Decode([]byte{FormatGzip, 1, 2})
+	assert.ErrorIs(t, err, customerror.ErrUnknownFormat)
+}
+
+func TestFrames(t *testing.T) {
+	random := make([]byte, FrameSize)
+	_, err := rand.Read(random)
+	assert.NoError(t, err)
+	// compressible frame, random frame and the short tail
+	content := append(bytes.Repeat([]byte("a"), int(FrameSize)), random...)
+	content = append(content, []byte("tail")...)
+
+	var stored bytes.Buffer
+	frames, size, err := CompressFrames(bytes.NewReader(content), &stored)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(content)), size)
+	assert.Len(t, frames, 3)
+	assert.True(t, frames[0].Compressed)
+	assert.False(t, frames[1].Compressed)
+	assert.True(t, Compressed(frames))
+	assert.Equal(t, int64(stored.Len()), StoredSize(frames))
+
+	decoded, err := DecodeFrames(EncodeFrames(frames))
+	assert.NoError(t, err)
+	assert.Equal(t, frames, decoded)
+	_, err = DecodeFrames([]byte{1, 2, 3})
+	assert.ErrorIs(t, err, customerror.ErrUnknownFormat)
+
+	read := func(offset int64, length int64) []byte {
+		start, n, first := FrameRange(frames, offset, length)
+		data, err := ReadFrames(bytes.NewReader(stored.Bytes()[start:start+n]), frames, first, n)
+		assert.NoError(t, err)
+		skip := offset - int64(first)*FrameSize
+		return data[skip : skip+length]
+	}
+	assert.Equal(t, content[10:20], read(10, 20-10))
+	assert.Equal(t, content[FrameSize-5:FrameSize+5], read(FrameSize-5, 10))
+	assert.Equal(t, content[2*FrameSize:], read(2*FrameSize, 4))
+}
+
+func TestCompressFrames_raw(t *testing.T) {
+	var stored bytes.Buffer
+	frames, size, err := CompressFrames(strings.NewReader("abc"), &stored)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), size)
+	assert.False(t, Compressed(frames))
+	assert.Equal(t, "abc", stored.String())
+
+	frames, size, err = CompressFrames(strings.NewReader(""), &stored)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), size)
+	assert.Empty(t, frames)
+}
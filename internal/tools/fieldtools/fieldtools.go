@@ -0,0 +1,59 @@
+// Fieldtools package checks the custom fields of the records.
+package fieldtools
+
+import (
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+)
+
+// Limits of the custom fields.
+const (
+	MaxFields      int = 100
+	MaxNameLength  int = 255
+	MaxValueLength int = 10000
+)
+
+// Validate - checks the list of custom fields. Returns nil if all fields are correct.
+func Validate(fields []models.CustomFieldModel) error {
+	if len(fields) > MaxFields {
+		return customerror.ErrTooManyFields
+	}
+	for _, field := range fields {
+		if err := validField(field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validField - checks the name and the value of the field by its type.
+func validField(field models.CustomFieldModel) error {
+	if strings.TrimSpace(field.Name) == "" || utf8.RuneCountInString(field.Name) > MaxNameLength ||
+		utf8.RuneCountInString(field.Value) > MaxValueLength {
+		return customerror.ErrInvalidCustomField
+	}
+	switch field.Type {
+	case datatypes.TextFieldType, datatypes.HiddenFieldType:
+		return nil
+	case datatypes.BooleanFieldType:
+		if field.Value != "true" && field.Value != "false" {
+			return customerror.ErrInvalidCustomField
+		}
+		return nil
+	case datatypes.URLFieldType:
+		if field.Value == "" {
+			return nil
+		}
+		u, err := url.Parse(field.Value)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return customerror.ErrInvalidCustomField
+		}
+		return nil
+	}
+	return customerror.ErrUnknownFieldType
+}
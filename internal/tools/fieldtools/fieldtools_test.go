@@ -0,0 +1,36 @@
+package fieldtools
+
+import (
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields []models.CustomFieldModel
+		err    error
+	}{
+		{"empty", nil, nil},
+		{"all types", []models.CustomFieldModel{
+			{Name: "Question", Type: datatypes.TextFieldType, Value: "City"},
+			{Name: "PIN", Type: datatypes.HiddenFieldType, Value: "1234"},
+			{Name: "Active", Type: datatypes.BooleanFieldType, Value: "true"},
+			{Name: "Site", Type: datatypes.URLFieldType, Value: "https://example.com/login"},
+		}, nil},
+		{"empty name", []models.CustomFieldModel{{Type: datatypes.TextFieldType}}, customerror.ErrInvalidCustomField},
+		{"bad boolean", []models.CustomFieldModel{{Name: "Active", Type: datatypes.BooleanFieldType, Value: "yes"}}, customerror.ErrInvalidCustomField},
+		{"bad url", []models.CustomFieldModel{{Name: "Site", Type: datatypes.URLFieldType, Value: "example"}}, customerror.ErrInvalidCustomField},
+		{"unknown type", []models.CustomFieldModel{{Name: "Field", Type: 100}}, customerror.ErrUnknownFieldType},
+		{"too many", make([]models.CustomFieldModel, MaxFields+1), customerror.ErrTooManyFields},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, Validate(tt.fields), tt.err)
+		})
+	}
+}
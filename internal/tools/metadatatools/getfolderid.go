@@ -0,0 +1,29 @@
+package metadatatools
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// FolderIDMD - metadata key of the folder id.
+const FolderIDMD string = "folder-id"
+
+// GetFolderIDFromMetadata - getting folder id from incoming context.
+// Returns false if the metadata does not contain the folder id.
+func GetFolderIDFromMetadata(ctx context.Context) (int32, bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, false, nil
+	}
+	values := md.Get(FolderIDMD)
+	if len(values) == 0 {
+		return 0, false, nil
+	}
+	id, err := strconv.ParseInt(values[0], 10, 32)
+	if err != nil {
+		return 0, false, err
+	}
+	return int32(id), true, nil
+}
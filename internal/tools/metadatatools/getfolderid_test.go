@@ -0,0 +1,37 @@
+package metadatatools
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestGetFolderIDFromMetadata(t *testing.T) {
+	// Test case 1: When metadata contains folder id
+	md := metadata.New(map[string]string{FolderIDMD: "12"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	id, ok, err := GetFolderIDFromMetadata(ctx)
+	if err != nil || !ok || id != 12 {
+		t.Errorf("Expected: %d, but got: %d (ok: %v, err: %v)", 12, id, ok, err)
+	}
+
+	// Test case 2: When metadata contains not a number
+	md = metadata.New(map[string]string{FolderIDMD: "abc"})
+	ctx = metadata.NewIncomingContext(context.Background(), md)
+	if _, _, err := GetFolderIDFromMetadata(ctx); err == nil {
+		t.Errorf("Expected error, but got nil")
+	}
+
+	// Test case 3: When metadata does not contain folder id
+	md = metadata.New(map[string]string{"foo": "bar"})
+	ctx = metadata.NewIncomingContext(context.Background(), md)
+	if _, ok, _ := GetFolderIDFromMetadata(ctx); ok {
+		t.Errorf("Expected no folder id")
+	}
+
+	// Test case 4: When context is empty
+	if _, ok, _ := GetFolderIDFromMetadata(context.Background()); ok {
+		t.Errorf("Expected no folder id")
+	}
+}
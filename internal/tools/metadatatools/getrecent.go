@@ -0,0 +1,32 @@
+package metadatatools
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// RecentMD - metadata key of the number of the recently used records.
+const RecentMD string = "recent"
+
+// GetRecentFromMetadata - getting the number of the recently used records from incoming context.
+// Returns false if the metadata does not contain the number, the empty value is zero.
+func GetRecentFromMetadata(ctx context.Context) (int32, bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, false, nil
+	}
+	values := md.Get(RecentMD)
+	if len(values) == 0 {
+		return 0, false, nil
+	}
+	if values[0] == "" {
+		return 0, true, nil
+	}
+	n, err := strconv.ParseInt(values[0], 10, 32)
+	if err != nil {
+		return 0, false, err
+	}
+	return int32(n), true, nil
+}
@@ -0,0 +1,40 @@
+package metadatatools
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestGetRecentFromMetadata(t *testing.T) {
+	// Test case 1: When metadata contains the number
+	md := metadata.New(map[string]string{RecentMD: "10"})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	n, ok, err := GetRecentFromMetadata(ctx)
+	if err != nil || !ok || n != 10 {
+		t.Errorf("Expected: %d, but got: %d (ok: %v, err: %v)", 10, n, ok, err)
+	}
+
+	// Test case 2: When metadata contains the empty value
+	md = metadata.New(map[string]string{RecentMD: ""})
+	ctx = metadata.NewIncomingContext(context.Background(), md)
+	n, ok, err = GetRecentFromMetadata(ctx)
+	if err != nil || !ok || n != 0 {
+		t.Errorf("Expected: %d, but got: %d (ok: %v, err: %v)", 0, n, ok, err)
+	}
+
+	// Test case 3: When metadata contains not a number
+	md = metadata.New(map[string]string{RecentMD: "ten"})
+	ctx = metadata.NewIncomingContext(context.Background(), md)
+	if _, _, err := GetRecentFromMetadata(ctx); err == nil {
+		t.Errorf("Expected error, but got nil")
+	}
+
+	// Test case 4: When metadata does not contain the number
+	md = metadata.New(map[string]string{"foo": "bar"})
+	ctx = metadata.NewIncomingContext(context.Background(), md)
+	if _, ok, _ := GetRecentFromMetadata(ctx); ok {
+		t.Errorf("Expected no number")
+	}
+}
@@ -0,0 +1,35 @@
+// Pagecursor package encodes and decodes the opaque cursors for the keyset pagination.
+package pagecursor
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+)
+
+// Encode - returns the cursor pointing to the record as an opaque string.
+func Encode(cursor models.ItemsCursorModel) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Decode - returns the cursor from the opaque string. The empty string is the start of the list.
+func Decode(cursor string) (*models.ItemsCursorModel, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, customerror.ErrInvalidCursor
+	}
+	res := &models.ItemsCursorModel{}
+	if err := json.Unmarshal(data, res); err != nil {
+		return nil, customerror.ErrInvalidCursor
+	}
+	return res, nil
+}
@@ -0,0 +1,34 @@
+package pagecursor
+
+import (
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursor(t *testing.T) {
+	t.Run("Encode and decode", func(t *testing.T) {
+		cursor := models.ItemsCursorModel{Value: "Title", Type: 2, ID: 15}
+		encoded, err := Encode(cursor)
+		assert.NoError(t, err)
+
+		decoded, err := Decode(encoded)
+		assert.NoError(t, err)
+		assert.Equal(t, cursor, *decoded)
+	})
+
+	t.Run("Empty cursor", func(t *testing.T) {
+		decoded, err := Decode("")
+		assert.NoError(t, err)
+		assert.Nil(t, decoded)
+	})
+
+	t.Run("Invalid cursor", func(t *testing.T) {
+		_, err := Decode("!!!")
+		assert.Error(t, err)
+
+		_, err = Decode("bm90IGpzb24")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,130 @@
+// Sealtools package encrypts the secrets stored by the server with AES-256-GCM.
+// The sealed value carries a prefix, so the values written before the encryption
+// are recognized and returned as is.
+package sealtools
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+)
+
+// Size of the key in bytes.
+const KeySize = 32
+
+// Prefix of the sealed string.
+const StringPrefix = "$pwe1$"
+
+// Magic - prefix of the sealed payload. It differs from the format markers of compresstools.
+var Magic = []byte("PWE1")
+
+// NewKey - returns the new random key.
+func NewKey() ([]byte, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// newAEAD - returns AES-256-GCM with the key.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, customerror.ErrInvalidKeySize
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Seal - encrypts the data, the additional data is authenticated but not stored.
+// Returns the nonce followed by the ciphertext.
+func Seal(key, data, aad []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(data)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, data, aad), nil
+}
+
+// Open - decrypts the data sealed by Seal with the same key and additional data.
+func Open(key, sealed, aad []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize()+aead.Overhead() {
+		return nil, customerror.ErrDecrypt
+	}
+	data, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], aad)
+	if err != nil {
+		return nil, customerror.ErrDecrypt
+	}
+	return data, nil
+}
+
+// SealString - returns the sealed value with StringPrefix. The empty value stays empty.
+func SealString(key []byte, value string, aad []byte) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	sealed, err := Seal(key, []byte(value), aad)
+	if err != nil {
+		return "", err
+	}
+	return StringPrefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// OpenString - returns the value sealed by SealString, the value without StringPrefix is returned as is.
+func OpenString(key []byte, value string, aad []byte) (string, error) {
+	if !IsSealedString(value) {
+		return value, nil
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(value[len(StringPrefix):])
+	if err != nil {
+		return "", customerror.ErrDecrypt
+	}
+	data, err := Open(key, sealed, aad)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// IsSealedString - checks that the value is sealed by SealString.
+func IsSealedString(value string) bool {
+	return strings.HasPrefix(value, StringPrefix)
+}
+
+// SealBytes - returns the sealed payload with Magic.
+func SealBytes(key, data, aad []byte) ([]byte, error) {
+	sealed, err := Seal(key, data, aad)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, Magic...), sealed...), nil
+}
+
+// OpenBytes - returns the payload sealed by SealBytes, the payload without Magic is returned as is.
+func OpenBytes(key, payload, aad []byte) ([]byte, error) {
+	if !IsSealedBytes(payload) {
+		return payload, nil
+	}
+	return Open(key, payload[len(Magic):], aad)
+}
+
+// IsSealedBytes - checks that the payload is sealed by SealBytes.
+func IsSealedBytes(payload []byte) bool {
+	return bytes.HasPrefix(payload, Magic)
+}
@@ -0,0 +1,83 @@
+package sealtools
+
+import (
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeal(t *testing.T) {
+	key, err := NewKey()
+	assert.NoError(t, err)
+	aad := []byte("user/log_pwd_data.password")
+
+	sealed, err := Seal(key, []byte("secret"), aad)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(sealed), "secret")
+	data, err := Open(key, sealed, aad)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("secret"), data)
+
+	// the same data is sealed with the new nonce
+	again, err := Seal(key, []byte("secret"), aad)
+	assert.NoError(t, err)
+	assert.NotEqual(t, sealed, again)
+
+	other, err := NewKey()
+	assert.NoError(t, err)
+	_, err = Open(other, sealed, aad)
+	assert.ErrorIs(t, err, customerror.ErrDecrypt)
+	_, err = Open(key, sealed, []byte("user/card_data.num"))
+	assert.ErrorIs(t, err, customerror.ErrDecrypt)
+	_, err = Open(key, sealed[:10], aad)
+	assert.ErrorIs(t, err, customerror.ErrDecrypt)
+	_, err = Seal(key[:16], []byte("secret"), aad)
+	assert.ErrorIs(t, err, customerror.ErrInvalidKeySize)
+}
+
+func TestSealString(t *testing.T) {
+	key, err := NewKey()
+	assert.NoError(t, err)
+	aad := []byte("aad")
+
+	sealed, err := SealString(key, "4111111111111111", aad)
+	assert.NoError(t, err)
+	assert.True(t, IsSealedString(sealed))
+	value, err := OpenString(key, sealed, aad)
+	assert.NoError(t, err)
+	assert.Equal(t, "4111111111111111", value)
+
+	// the empty value stays empty, the plain value is returned as is
+	sealed, err = SealString(key, "", aad)
+	assert.NoError(t, err)
+	assert.Equal(t, "", sealed)
+	value, err = OpenString(key, "plain", aad)
+	assert.NoError(t, err)
+	assert.Equal(t, "plain", value)
+
+	_, err = OpenString(key, StringPrefix+"!!!", aad)
+	assert.ErrorIs(t, err, customerror.ErrDecrypt)
+}
+
+func TestSealBytes(t *testing.T) {
+	key, err := NewKey()
+	assert.NoError(t, err)
+	aad := []byte("aad")
+
+	payload, err := SealBytes(key, []byte{0, 'n', 'o', 't', 'e'}, aad)
+	assert.NoError(t, err)
+	assert.True(t, IsSealedBytes(payload))
+	data, err := OpenBytes(key, payload, aad)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0, 'n', 'o', 't', 'e'}, data)
+
+	// the payload of compresstools is returned as is
+	data, err = OpenBytes(key, []byte{1, 2, 3}, aad)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, data)
+
+	payload[len(payload)-1] ^= 1
+	_, err = OpenBytes(key, payload, aad)
+	assert.ErrorIs(t, err, customerror.ErrDecrypt)
+}
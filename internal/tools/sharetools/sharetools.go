@@ -0,0 +1,79 @@
+// Sharetools package checks the public keys of the users and the item keys wrapped for the recipients.
+// The keys are generated and used by the clients, the server only keeps them.
+package sharetools
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+)
+
+// Algorithms of the public keys supported by the clients.
+const (
+	X25519       string = "x25519"          // raw 32-byte key, the item key is sealed with HPKE
+	RSAOAEP      string = "rsa-oaep-sha256" // DER-encoded PKIX key, the item key is encrypted with RSA-OAEP
+	minRSABits   int    = 2048
+	x25519KeyLen int    = 32
+)
+
+// Limits of the keys kept by the server.
+const (
+	MaxPublicKeySize  int = 1024
+	MaxPrivateKeySize int = 8192
+	MaxWrappedKeySize int = 1024
+)
+
+// ValidatePublicKey - checks the public key of the algorithm.
+func ValidatePublicKey(algorithm string, key []byte) error {
+	if len(key) == 0 || len(key) > MaxPublicKeySize {
+		return customerror.ErrInvalidPublicKey
+	}
+	switch algorithm {
+	case X25519:
+		if len(key) != x25519KeyLen {
+			return customerror.ErrInvalidPublicKey
+		}
+		return nil
+	case RSAOAEP:
+		pub, err := x509.ParsePKIXPublicKey(key)
+		if err != nil {
+			return customerror.ErrInvalidPublicKey
+		}
+		rsaKey, ok := pub.(*rsa.PublicKey)
+		if !ok || rsaKey.N.BitLen() < minRSABits {
+			return customerror.ErrInvalidPublicKey
+		}
+		return nil
+	}
+	return customerror.ErrUnknownKeyAlgorithm
+}
+
+// ValidateKeyPair - checks the public key and the private key encrypted by the client with its vault key.
+func ValidateKeyPair(algorithm string, publicKey []byte, encryptedPrivateKey []byte) error {
+	if len(encryptedPrivateKey) == 0 || len(encryptedPrivateKey) > MaxPrivateKeySize {
+		return customerror.ErrInvalidPrivateKey
+	}
+	return ValidatePublicKey(algorithm, publicKey)
+}
+
+// ValidateShare - checks the item key wrapped for the recipient and the permission.
+func ValidateShare(wrappedKey []byte, permission int32) error {
+	if len(wrappedKey) == 0 || len(wrappedKey) > MaxWrappedKeySize {
+		return customerror.ErrInvalidWrappedKey
+	}
+	if permission != datatypes.ReadOnlyPermission && permission != datatypes.ReadWritePermission {
+		return customerror.ErrUnknownPermission
+	}
+	return nil
+}
+
+// Fingerprint - returns the hex SHA-256 of the public key. The sender passes the fingerprint of the key
+// the item key is wrapped with, so the share to the replaced key is rejected.
+func Fingerprint(publicKey []byte) string {
+	sum := sha256.Sum256(publicKey)
+	return hex.EncodeToString(sum[:])
+}
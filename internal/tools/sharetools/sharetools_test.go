@@ -0,0 +1,68 @@
+package sharetools
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/datatypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePublicKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	rsaDER, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	assert.NoError(t, err)
+	weakKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	assert.NoError(t, err)
+	weakDER, err := x509.MarshalPKIXPublicKey(&weakKey.PublicKey)
+	assert.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	ecDER, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		algorithm string
+		key       []byte
+		err       error
+	}{
+		{"x25519", X25519, make([]byte, 32), nil},
+		{"rsa", RSAOAEP, rsaDER, nil},
+		{"short x25519", X25519, make([]byte, 31), customerror.ErrInvalidPublicKey},
+		{"weak rsa", RSAOAEP, weakDER, customerror.ErrInvalidPublicKey},
+		{"not rsa", RSAOAEP, ecDER, customerror.ErrInvalidPublicKey},
+		{"garbage", RSAOAEP, []byte("garbage"), customerror.ErrInvalidPublicKey},
+		{"empty", X25519, nil, customerror.ErrInvalidPublicKey},
+		{"unknown", "ed25519", make([]byte, 32), customerror.ErrUnknownKeyAlgorithm},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.err, ValidatePublicKey(tt.algorithm, tt.key))
+		})
+	}
+}
+
+func TestValidateKeyPair(t *testing.T) {
+	assert.NoError(t, ValidateKeyPair(X25519, make([]byte, 32), []byte("encrypted")))
+	assert.ErrorIs(t, ValidateKeyPair(X25519, make([]byte, 32), nil), customerror.ErrInvalidPrivateKey)
+	assert.ErrorIs(t, ValidateKeyPair(X25519, make([]byte, 32), make([]byte, MaxPrivateKeySize+1)), customerror.ErrInvalidPrivateKey)
+}
+
+func TestValidateShare(t *testing.T) {
+	assert.NoError(t, ValidateShare([]byte("wrapped"), datatypes.ReadOnlyPermission))
+	assert.NoError(t, ValidateShare([]byte("wrapped"), datatypes.ReadWritePermission))
+	assert.ErrorIs(t, ValidateShare(nil, datatypes.ReadOnlyPermission), customerror.ErrInvalidWrappedKey)
+	assert.ErrorIs(t, ValidateShare([]byte("wrapped"), 0), customerror.ErrUnknownPermission)
+}
+
+func TestFingerprint(t *testing.T) {
+	assert.Equal(t, "66687aadf862bd776c8fc18b8e9f8e20089714856ee233b3902a591d0d5f2925", Fingerprint(make([]byte, 32)))
+	assert.NotEqual(t, Fingerprint([]byte{1}), Fingerprint([]byte{2}))
+}
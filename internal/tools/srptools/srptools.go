@@ -0,0 +1,211 @@
+// Srptools package implements the SRP-6a login (RFC 5054) with the 2048-bit group and SHA-256.
+// The server keeps only the salt and the verifier of the password, the password never reaches the server.
+// The private value x is derived from the password with Argon2id, so the stolen verifier is slow to brute force:
+//
+//	x = SHA256(salt | SHA256(login | ":" | Argon2id(password, salt, 2, 19 MiB, 1, 32 bytes)))
+//	v = g^x
+package srptools
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"math/big"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"golang.org/x/crypto/argon2"
+)
+
+// Sizes of the salt and the secret ephemeral values in bytes.
+const (
+	SaltSize    int = 16
+	MaxSaltSize int = 64
+	SecretSize  int = 32
+)
+
+// Parameters of Argon2id deriving the private value from the password.
+const (
+	argonTime    uint32 = 2
+	argonMemory  uint32 = 19456 // KiB
+	argonThreads uint8  = 1
+	argonKeyLen  uint32 = 32
+)
+
+// groupN - the 2048-bit prime of RFC 5054, the generator is 2.
+const groupN = "AC6BDB41324A9A9BF166DE5E1389582FAF72B6651987EE07FC3192943DB56050A37329CBB4A099ED8193E0757767A13DD52312AB4B03310D" +
+	"CD7F48A9DA04FD50E8083969EDB767B0CF6095179A163AB3661A05FBD5FAAAE82918A9962F0B93B855F97993EC975EEAA80D740ADBF4FF74" +
+	"7359D041D5C33EA71D281E446B14773BCA97B43A23FB801676BD207A436C6481F1D2B9078717461A5B9D32E688F87748544523B524B0D57D" +
+	"5EA77A2775D2ECFA032CFBDBF52FB3786160279004E57AE6AF874E7303CE53299CCC041C7BC308D82A5698F3A8D0C38271AE35F8E9DBFBB6" +
+	"94B5C803D89F7AE435DE236D525F54759B65E372FCD68EF20FA7111F9E4AFF73"
+
+var (
+	n, _ = new(big.Int).SetString(groupN, 16)
+	g    = big.NewInt(2)
+	// k = H(N | PAD(g))
+	k = new(big.Int).SetBytes(hash(pad(n), pad(g)))
+)
+
+// NewVerifier - returns the new random salt and the verifier of the password.
+func NewVerifier(login string, password string) ([]byte, []byte, error) {
+	salt := make([]byte, SaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, nil, err
+	}
+	return salt, Verifier(login, password, salt), nil
+}
+
+// Verifier - returns the verifier of the password with the salt.
+func Verifier(login string, password string, salt []byte) []byte {
+	x := privateValue(login, password, salt)
+	return pad(new(big.Int).Exp(g, x, n))
+}
+
+// FakeSalt - returns the salt of the login that has no SRP login, derived from the login with the server key.
+// The same login always gets the same salt, so the unknown login looks like the existing one.
+func FakeSalt(key []byte, login string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(login))
+	return mac.Sum(nil)[:SaltSize]
+}
+
+// ValidVerifier - checks the salt and the verifier sent by the client on the registration.
+func ValidVerifier(salt []byte, verifier []byte) error {
+	if len(salt) < SaltSize || len(salt) > MaxSaltSize {
+		return customerror.ErrInvalidSRPVerifier
+	}
+	v := new(big.Int).SetBytes(verifier)
+	if len(verifier) > len(pad(n)) || v.Cmp(big.NewInt(1)) <= 0 || v.Cmp(n) >= 0 {
+		return customerror.ErrInvalidSRPVerifier
+	}
+	return nil
+}
+
+// NewSecret - returns the new random secret ephemeral value a or b.
+func NewSecret() ([]byte, error) {
+	secret := make([]byte, SecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// ServerPublic - returns the public ephemeral value of the server B = k*v + g^b.
+func ServerPublic(verifier []byte, secret []byte) []byte {
+	v := new(big.Int).SetBytes(verifier)
+	b := new(big.Int).SetBytes(secret)
+	res := new(big.Int).Mul(k, v)
+	res.Add(res, new(big.Int).Exp(g, b, n))
+	return pad(res.Mod(res, n))
+}
+
+// ClientPublic - returns the public ephemeral value of the client A = g^a.
+func ClientPublic(secret []byte) []byte {
+	a := new(big.Int).SetBytes(secret)
+	return pad(new(big.Int).Exp(g, a, n))
+}
+
+// ValidPublic - checks the public ephemeral value of the other side, A or B mod N must not be zero.
+func ValidPublic(public []byte) error {
+	p := new(big.Int).SetBytes(public)
+	if len(public) == 0 || len(public) > len(pad(n)) || p.Mod(p, n).Sign() == 0 {
+		return customerror.ErrInvalidSRPPublic
+	}
+	return nil
+}
+
+// ServerProof - checks the proof of the client M1 and returns the proof of the server M2.
+// A is the public value of the client, b is the secret value of the server.
+func ServerProof(login string, salt []byte, verifier []byte, clientPublic []byte, secret []byte, clientProof []byte) ([]byte, error) {
+	if err := ValidPublic(clientPublic); err != nil {
+		return nil, err
+	}
+	serverPublic := ServerPublic(verifier, secret)
+	u, err := scrambling(clientPublic, serverPublic)
+	if err != nil {
+		return nil, err
+	}
+	// S = (A * v^u) ^ b
+	v := new(big.Int).SetBytes(verifier)
+	s := new(big.Int).Exp(v, u, n)
+	s.Mul(s, new(big.Int).SetBytes(clientPublic))
+	s.Exp(s.Mod(s, n), new(big.Int).SetBytes(secret), n)
+
+	key := hash(pad(s))
+	expected := clientProofOf(login, salt, clientPublic, serverPublic, key)
+	if subtle.ConstantTimeCompare(expected, clientProof) != 1 {
+		return nil, customerror.ErrLoginOrPassIncorrect
+	}
+	return hash(pad(new(big.Int).SetBytes(clientPublic)), expected, key), nil
+}
+
+// ClientProof - returns the proof of the client M1 and the expected proof of the server M2.
+// a is the secret value of the client, B is the public value of the server.
+func ClientProof(login string, password string, salt []byte, secret []byte, serverPublic []byte) ([]byte, []byte, error) {
+	if err := ValidPublic(serverPublic); err != nil {
+		return nil, nil, err
+	}
+	clientPublic := ClientPublic(secret)
+	u, err := scrambling(clientPublic, serverPublic)
+	if err != nil {
+		return nil, nil, err
+	}
+	// S = (B - k * g^x) ^ (a + u * x)
+	x := privateValue(login, password, salt)
+	base := new(big.Int).Exp(g, x, n)
+	base.Mul(base, k)
+	base.Sub(new(big.Int).SetBytes(serverPublic), base)
+	base.Mod(base, n)
+	exp := new(big.Int).Mul(u, x)
+	exp.Add(exp, new(big.Int).SetBytes(secret))
+	s := new(big.Int).Exp(base, exp, n)
+
+	key := hash(pad(s))
+	proof := clientProofOf(login, salt, clientPublic, serverPublic, key)
+	return proof, hash(clientPublic, proof, key), nil
+}
+
+// privateValue - returns x derived from the password.
+func privateValue(login string, password string, salt []byte) *big.Int {
+	stretched := argon2.IDKey([]byte(password), salt, argonTime, argonMemory, argonThreads, argonKeyLen)
+	inner := hash([]byte(login), []byte(":"), stretched)
+	return new(big.Int).SetBytes(hash(salt, inner))
+}
+
+// scrambling - returns u = H(PAD(A) | PAD(B)), it must not be zero.
+func scrambling(clientPublic []byte, serverPublic []byte) (*big.Int, error) {
+	a := pad(new(big.Int).SetBytes(clientPublic))
+	b := pad(new(big.Int).SetBytes(serverPublic))
+	u := new(big.Int).SetBytes(hash(a, b))
+	if u.Sign() == 0 {
+		return nil, customerror.ErrInvalidSRPPublic
+	}
+	return u, nil
+}
+
+// clientProofOf - returns M1 = H(H(N) xor H(g) | H(login) | salt | A | B | K).
+func clientProofOf(login string, salt []byte, clientPublic []byte, serverPublic []byte, key []byte) []byte {
+	hn, hg := hash(n.Bytes()), hash(g.Bytes())
+	for i := range hn {
+		hn[i] ^= hg[i]
+	}
+	a := pad(new(big.Int).SetBytes(clientPublic))
+	b := pad(new(big.Int).SetBytes(serverPublic))
+	return hash(hn, hash([]byte(login)), salt, a, b, key)
+}
+
+// pad - returns the number padded with the zeros to the length of N.
+func pad(x *big.Int) []byte {
+	size := (n.BitLen() + 7) / 8
+	res := make([]byte, size)
+	return x.FillBytes(res)
+}
+
+// hash - returns SHA-256 of the concatenated parts.
+func hash(parts ...[]byte) []byte {
+	h := sha256.New()
+	for _, part := range parts {
+		h.Write(part)
+	}
+	return h.Sum(nil)
+}
@@ -0,0 +1,67 @@
+package srptools
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/stretchr/testify/assert"
+)
+
+// login - runs the login with the password against the verifier. Returns the error of the server.
+func login(t *testing.T, name string, password string, salt []byte, verifier []byte) error {
+	a, err := NewSecret()
+	assert.NoError(t, err)
+	b, err := NewSecret()
+	assert.NoError(t, err)
+
+	serverPublic := ServerPublic(verifier, b)
+	clientProof, expected, err := ClientProof(name, password, salt, a, serverPublic)
+	assert.NoError(t, err)
+	serverProof, err := ServerProof(name, salt, verifier, ClientPublic(a), b, clientProof)
+	if err != nil {
+		return err
+	}
+	assert.Equal(t, expected, serverProof)
+	return nil
+}
+
+func TestLogin(t *testing.T) {
+	salt, verifier, err := NewVerifier("alice", "correct horse")
+	assert.NoError(t, err)
+	assert.Len(t, salt, SaltSize)
+	assert.NoError(t, ValidVerifier(salt, verifier))
+	assert.Equal(t, verifier, Verifier("alice", "correct horse", salt))
+
+	assert.NoError(t, login(t, "alice", "correct horse", salt, verifier))
+	assert.ErrorIs(t, login(t, "alice", "wrong horse", salt, verifier), customerror.ErrLoginOrPassIncorrect)
+	assert.ErrorIs(t, login(t, "bob", "correct horse", salt, verifier), customerror.ErrLoginOrPassIncorrect)
+}
+
+func TestInvalidValues(t *testing.T) {
+	salt, verifier, err := NewVerifier("alice", "correct horse")
+	assert.NoError(t, err)
+	b, err := NewSecret()
+	assert.NoError(t, err)
+
+	// A = 0 or A = N lets the client compute the key without the password
+	for _, public := range [][]byte{nil, {0}, pad(n), make([]byte, len(pad(n))+1)} {
+		assert.ErrorIs(t, ValidPublic(public), customerror.ErrInvalidSRPPublic)
+		_, err := ServerProof("alice", salt, verifier, public, b, make([]byte, 32))
+		assert.ErrorIs(t, err, customerror.ErrInvalidSRPPublic)
+	}
+
+	assert.ErrorIs(t, ValidVerifier(salt[:8], verifier), customerror.ErrInvalidSRPVerifier)
+	assert.ErrorIs(t, ValidVerifier(salt, []byte{1}), customerror.ErrInvalidSRPVerifier)
+	assert.ErrorIs(t, ValidVerifier(salt, pad(n)), customerror.ErrInvalidSRPVerifier)
+	assert.ErrorIs(t, ValidVerifier(salt, pad(new(big.Int).Add(n, big.NewInt(5)))), customerror.ErrInvalidSRPVerifier)
+}
+
+func TestFakeSalt(t *testing.T) {
+	key := []byte("server key")
+	salt := FakeSalt(key, "nobody")
+	assert.Len(t, salt, SaltSize)
+	assert.Equal(t, salt, FakeSalt(key, "nobody"))
+	assert.NotEqual(t, salt, FakeSalt(key, "somebody"))
+	assert.NotEqual(t, salt, FakeSalt([]byte("other key"), "nobody"))
+}
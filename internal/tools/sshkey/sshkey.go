@@ -0,0 +1,60 @@
+// Sshkey package checks the SSH private keys and derives their public keys and fingerprints.
+package sshkey
+
+import (
+	"crypto/x509"
+	"errors"
+	"strings"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"golang.org/x/crypto/ssh"
+)
+
+// KeyInfo - public information about the key.
+type KeyInfo struct {
+	KeyType     string // for example: ssh-ed25519, ssh-rsa
+	PublicKey   string // authorized_keys format
+	Fingerprint string // SHA256:...
+}
+
+// Parse - checks the private key and returns its public information. The passphrase is required
+// for the encrypted keys. If the public key is not empty, it must belong to the private key.
+func Parse(privateKey string, publicKey string, passphrase string) (KeyInfo, error) {
+	var signer ssh.Signer
+	var err error
+	if passphrase == "" {
+		signer, err = ssh.ParsePrivateKey([]byte(privateKey))
+	} else {
+		signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(privateKey), []byte(passphrase))
+	}
+	var missing *ssh.PassphraseMissingError
+	switch {
+	case errors.As(err, &missing):
+		return KeyInfo{}, customerror.ErrSSHKeyPassphrase
+	case errors.Is(err, x509.IncorrectPasswordError):
+		return KeyInfo{}, customerror.ErrSSHKeyPassphrase
+	case err != nil:
+		return KeyInfo{}, customerror.ErrInvalidSSHKey
+	}
+
+	info := Info(signer.PublicKey())
+	if strings.TrimSpace(publicKey) != "" {
+		pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+		if err != nil {
+			return KeyInfo{}, customerror.ErrInvalidSSHKey
+		}
+		if ssh.FingerprintSHA256(pub) != info.Fingerprint {
+			return KeyInfo{}, customerror.ErrSSHKeyMismatch
+		}
+	}
+	return info, nil
+}
+
+// Info - returns the public information about the public key.
+func Info(pub ssh.PublicKey) KeyInfo {
+	return KeyInfo{
+		KeyType:     pub.Type(),
+		PublicKey:   strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pub))),
+		Fingerprint: ssh.FingerprintSHA256(pub),
+	}
+}
@@ -0,0 +1,61 @@
+package sshkey
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestParse(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	assert.NoError(t, err)
+	privPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	sshPub, err := ssh.NewPublicKey(pub)
+	assert.NoError(t, err)
+
+	info, err := Parse(privPEM, "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "ssh-ed25519", info.KeyType)
+	assert.Equal(t, ssh.FingerprintSHA256(sshPub), info.Fingerprint)
+	assert.True(t, strings.HasPrefix(info.PublicKey, "ssh-ed25519 "))
+
+	_, err = Parse(privPEM, info.PublicKey+" user@host", "")
+	assert.NoError(t, err)
+
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	otherSSHPub, err := ssh.NewPublicKey(otherPub)
+	assert.NoError(t, err)
+	_, err = Parse(privPEM, string(ssh.MarshalAuthorizedKey(otherSSHPub)), "")
+	assert.ErrorIs(t, err, customerror.ErrSSHKeyMismatch)
+
+	_, err = Parse("not a key", "", "")
+	assert.ErrorIs(t, err, customerror.ErrInvalidSSHKey)
+}
+
+func TestParseEncrypted(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	assert.NoError(t, err)
+	//nolint:staticcheck // legacy encrypted PEM is still used by old keys
+	block, err := x509.EncryptPEMBlock(rand.Reader, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key), []byte("secret"), x509.PEMCipherAES128)
+	assert.NoError(t, err)
+	privPEM := string(pem.EncodeToMemory(block))
+
+	_, err = Parse(privPEM, "", "")
+	assert.ErrorIs(t, err, customerror.ErrSSHKeyPassphrase)
+	_, err = Parse(privPEM, "", "wrong")
+	assert.ErrorIs(t, err, customerror.ErrSSHKeyPassphrase)
+	info, err := Parse(privPEM, "", "secret")
+	assert.NoError(t, err)
+	assert.Equal(t, "ssh-rsa", info.KeyType)
+}
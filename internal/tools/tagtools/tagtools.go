@@ -0,0 +1,40 @@
+// Tagtools package converts the tags of the record between the list and the string
+// used by the record models ("work, mail, bank").
+package tagtools
+
+import (
+	"sort"
+	"strings"
+)
+
+// Separators of the tags in the string: any tags are split by Separator, joined by JoinSeparator.
+const (
+	Separator     string = ","
+	JoinSeparator string = ", "
+)
+
+// Parse - returns the sorted list of unique non-empty tags from the string.
+func Parse(s string) []string {
+	names := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(s, Separator) {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Join - returns the string of the tags.
+func Join(names []string) string {
+	return strings.Join(names, JoinSeparator)
+}
+
+// Valid - checks that the name can be used as one tag.
+func Valid(name string) bool {
+	return strings.TrimSpace(name) != "" && !strings.Contains(name, Separator) && len(name) <= 255
+}
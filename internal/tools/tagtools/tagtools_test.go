@@ -0,0 +1,24 @@
+package tagtools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	assert.Equal(t, []string{"bank", "mail", "work"}, Parse("work, mail,bank"))
+	assert.Equal(t, []string{"work"}, Parse(" work ,, work"))
+	assert.Equal(t, []string{}, Parse(""))
+}
+
+func TestJoin(t *testing.T) {
+	assert.Equal(t, "bank, work", Join([]string{"bank", "work"}))
+	assert.Equal(t, "", Join(nil))
+}
+
+func TestValid(t *testing.T) {
+	assert.True(t, Valid("work"))
+	assert.False(t, Valid(" "))
+	assert.False(t, Valid("work,mail"))
+}
@@ -0,0 +1,132 @@
+// Totp package parses the otpauth:// URIs and generates the time-based one-time passwords (RFC 6238).
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+)
+
+// Default parameters of the key.
+const (
+	DefaultAlgorithm string = "SHA1"
+	DefaultDigits    int    = 6
+	DefaultPeriod    int    = 30
+)
+
+// Key - parameters of the one-time password generation.
+type Key struct {
+	Secret    []byte
+	Algorithm string // SHA1, SHA256 or SHA512
+	Digits    int
+	Period    int // seconds
+	Issuer    string
+	Account   string
+}
+
+// Parse - parses the otpauth://totp URI. The base32 secret without the URI is also accepted.
+func Parse(raw string) (Key, error) {
+	key := Key{Algorithm: DefaultAlgorithm, Digits: DefaultDigits, Period: DefaultPeriod}
+	raw = strings.TrimSpace(raw)
+	if !strings.HasPrefix(strings.ToLower(raw), "otpauth://") {
+		secret, err := decodeSecret(raw)
+		key.Secret = secret
+		return key, err
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || !strings.EqualFold(u.Host, "totp") {
+		return key, customerror.ErrInvalidTOTP
+	}
+	label := strings.TrimPrefix(u.Path, "/")
+	if issuer, account, ok := strings.Cut(label, ":"); ok {
+		key.Issuer, key.Account = issuer, strings.TrimSpace(account)
+	} else {
+		key.Account = label
+	}
+
+	q := u.Query()
+	if key.Secret, err = decodeSecret(q.Get("secret")); err != nil {
+		return key, err
+	}
+	if issuer := q.Get("issuer"); issuer != "" {
+		key.Issuer = issuer
+	}
+	if algorithm := q.Get("algorithm"); algorithm != "" {
+		key.Algorithm = strings.ToUpper(algorithm)
+		if newHash(key.Algorithm) == nil {
+			return key, customerror.ErrInvalidTOTP
+		}
+	}
+	if digits := q.Get("digits"); digits != "" {
+		if key.Digits, err = strconv.Atoi(digits); err != nil || key.Digits < 6 || key.Digits > 8 {
+			return key, customerror.ErrInvalidTOTP
+		}
+	}
+	if period := q.Get("period"); period != "" {
+		if key.Period, err = strconv.Atoi(period); err != nil || key.Period <= 0 {
+			return key, customerror.ErrInvalidTOTP
+		}
+	}
+	return key, nil
+}
+
+// Code - returns the one-time password for the time and the time it remains valid.
+func Code(key Key, t time.Time) (string, time.Duration) {
+	period := int64(key.Period)
+	counter := t.Unix() / period
+	remaining := time.Duration(period-t.Unix()%period) * time.Second
+
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(counter))
+	mac := hmac.New(func() hash.Hash { return newHash(key.Algorithm) }, key.Secret)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	// dynamic truncation (RFC 4226)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < key.Digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", key.Digits, value%mod), remaining
+}
+
+// decodeSecret - decodes the base32 secret, the padding and the spaces are optional.
+func decodeSecret(s string) ([]byte, error) {
+	s = strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+	s = strings.TrimRight(s, "=")
+	if s == "" {
+		return nil, customerror.ErrInvalidTOTP
+	}
+	secret, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(s)
+	if err != nil {
+		return nil, customerror.ErrInvalidTOTP
+	}
+	return secret, nil
+}
+
+// newHash - returns the hash of the algorithm, nil if the algorithm is unknown.
+func newHash(algorithm string) hash.Hash {
+	switch algorithm {
+	case "SHA1":
+		return sha1.New()
+	case "SHA256":
+		return sha256.New()
+	case "SHA512":
+		return sha512.New()
+	}
+	return nil
+}
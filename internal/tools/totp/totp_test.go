@@ -0,0 +1,60 @@
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	key, err := Parse("otpauth://totp/Example:alice@example.com?secret=JBSWY3DPEHPK3PXP&issuer=Example&digits=8&period=60&algorithm=sha256")
+	assert.NoError(t, err)
+	assert.Equal(t, "Example", key.Issuer)
+	assert.Equal(t, "alice@example.com", key.Account)
+	assert.Equal(t, "SHA256", key.Algorithm)
+	assert.Equal(t, 8, key.Digits)
+	assert.Equal(t, 60, key.Period)
+	assert.Equal(t, []byte("Hello!\xde\xad\xbe\xef"), key.Secret)
+
+	key, err = Parse("jbsw y3dp ehpk 3pxp")
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultPeriod, key.Period)
+
+	for _, raw := range []string{"", "otpauth://hotp/A?secret=JBSWY3DPEHPK3PXP", "otpauth://totp/A?secret=1",
+		"otpauth://totp/A?secret=JBSWY3DPEHPK3PXP&algorithm=MD5", "otpauth://totp/A?secret=JBSWY3DPEHPK3PXP&digits=4"} {
+		_, err = Parse(raw)
+		assert.ErrorIs(t, err, customerror.ErrInvalidTOTP, raw)
+	}
+}
+
+func TestCode(t *testing.T) {
+	// test vectors of RFC 6238
+	secrets := map[string]string{
+		"SHA1":   "12345678901234567890",
+		"SHA256": "12345678901234567890123456789012",
+		"SHA512": "1234567890123456789012345678901234567890123456789012345678901234",
+	}
+	tests := []struct {
+		time      int64
+		algorithm string
+		code      string
+	}{
+		{59, "SHA1", "94287082"},
+		{59, "SHA256", "46119246"},
+		{59, "SHA512", "90693936"},
+		{1111111109, "SHA1", "07081804"},
+		{1234567890, "SHA256", "91819424"},
+		{20000000000, "SHA512", "47863826"},
+	}
+	for _, tt := range tests {
+		key, err := Parse(base32.StdEncoding.EncodeToString([]byte(secrets[tt.algorithm])))
+		assert.NoError(t, err)
+		key.Algorithm, key.Digits = tt.algorithm, 8
+		code, remaining := Code(key, time.Unix(tt.time, 0))
+		assert.Equal(t, tt.code, code)
+		assert.Equal(t, time.Duration(30-tt.time%30)*time.Second, remaining)
+	}
+}
@@ -0,0 +1,114 @@
+// Urimatch package matches the page URL with the URIs of the login records.
+// Domains are compared with the public suffix list, so "mail.example.co.uk" and
+// "www.example.co.uk" have the same base domain, but "a.github.io" and "b.github.io" do not.
+package urimatch
+
+import (
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"golang.org/x/net/publicsuffix"
+)
+
+// Match strategies of the URI.
+const (
+	BaseDomain int32 = iota // same registrable domain, any subdomain
+	Host                    // same host and port
+	StartsWith              // the page URL starts with the URI
+	Exact                   // the page URL is equal to the URI
+	Regex                   // the page URL matches the regular expression
+)
+
+// Maximum number of URIs of one record.
+const MaxURIs int = 50
+
+// Prepare - checks the URI and fills its host and base domain used for the search.
+func Prepare(uri models.LoginURIModel) (models.LoginURIModel, error) {
+	uri.URI = strings.TrimSpace(uri.URI)
+	uri.Host, uri.BaseDomain = "", ""
+	if uri.URI == "" {
+		return uri, customerror.ErrInvalidURI
+	}
+
+	switch uri.Match {
+	case Regex:
+		if _, err := regexp.Compile(uri.URI); err != nil {
+			return uri, customerror.ErrInvalidURI
+		}
+		return uri, nil
+	case BaseDomain, Host, StartsWith, Exact:
+		u, err := parse(uri.URI)
+		if err != nil {
+			return uri, err
+		}
+		uri.Host = u.Host
+		uri.BaseDomain = DomainOf(u.Hostname())
+		return uri, nil
+	}
+	return uri, customerror.ErrUnknownMatchType
+}
+
+// PageDomain - returns the base domain of the page URL.
+func PageDomain(page string) (string, error) {
+	u, err := parse(page)
+	if err != nil {
+		return "", err
+	}
+	return DomainOf(u.Hostname()), nil
+}
+
+// DomainOf - returns the registrable domain of the host (public suffix plus one label).
+// IP addresses and hosts without a public suffix are returned as is.
+func DomainOf(host string) string {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if net.ParseIP(host) != nil {
+		return host
+	}
+	domain, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		return host
+	}
+	return domain
+}
+
+// Match - checks that the page URL matches the URI prepared by Prepare.
+func Match(uri models.LoginURIModel, page string) bool {
+	switch uri.Match {
+	case Regex:
+		re, err := regexp.Compile(uri.URI)
+		return err == nil && re.MatchString(page)
+	case StartsWith:
+		return strings.HasPrefix(page, uri.URI)
+	case Exact:
+		return page == uri.URI
+	}
+
+	u, err := parse(page)
+	if err != nil {
+		return false
+	}
+	switch uri.Match {
+	case BaseDomain:
+		return DomainOf(u.Hostname()) == uri.BaseDomain
+	case Host:
+		return u.Host == uri.Host
+	}
+	return false
+}
+
+// parse - parses the URL, the URL without a scheme is considered https.
+func parse(raw string) (*url.URL, error) {
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Hostname() == "" {
+		return nil, customerror.ErrInvalidURI
+	}
+	u.Host = strings.ToLower(u.Host)
+	return u, nil
+}
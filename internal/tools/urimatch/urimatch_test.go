@@ -0,0 +1,60 @@
+package urimatch
+
+import (
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainOf(t *testing.T) {
+	assert.Equal(t, "example.com", DomainOf("www.Example.com"))
+	assert.Equal(t, "example.co.uk", DomainOf("mail.example.co.uk"))
+	assert.Equal(t, "user.github.io", DomainOf("user.github.io"))
+	assert.Equal(t, "localhost", DomainOf("localhost"))
+	assert.Equal(t, "192.168.0.1", DomainOf("192.168.0.1"))
+}
+
+func TestPrepare(t *testing.T) {
+	uri, err := Prepare(models.LoginURIModel{URI: "https://login.example.co.uk:8443/auth"})
+	assert.NoError(t, err)
+	assert.Equal(t, "login.example.co.uk:8443", uri.Host)
+	assert.Equal(t, "example.co.uk", uri.BaseDomain)
+
+	uri, err = Prepare(models.LoginURIModel{URI: "example.com", Match: Host})
+	assert.NoError(t, err)
+	assert.Equal(t, "example.com", uri.Host)
+
+	_, err = Prepare(models.LoginURIModel{URI: "(", Match: Regex})
+	assert.ErrorIs(t, err, customerror.ErrInvalidURI)
+	_, err = Prepare(models.LoginURIModel{URI: " "})
+	assert.ErrorIs(t, err, customerror.ErrInvalidURI)
+	_, err = Prepare(models.LoginURIModel{URI: "example.com", Match: 100})
+	assert.ErrorIs(t, err, customerror.ErrUnknownMatchType)
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		uri   models.LoginURIModel
+		page  string
+		match bool
+	}{
+		{"base domain", models.LoginURIModel{URI: "https://example.com", Match: BaseDomain}, "https://mail.example.com/inbox", true},
+		{"other domain", models.LoginURIModel{URI: "https://example.com", Match: BaseDomain}, "https://example.org", false},
+		{"private suffix", models.LoginURIModel{URI: "https://a.github.io", Match: BaseDomain}, "https://b.github.io", false},
+		{"host", models.LoginURIModel{URI: "https://mail.example.com", Match: Host}, "https://mail.example.com/inbox", true},
+		{"other host", models.LoginURIModel{URI: "https://mail.example.com", Match: Host}, "https://www.example.com", false},
+		{"starts with", models.LoginURIModel{URI: "https://example.com/app", Match: StartsWith}, "https://example.com/app/login", true},
+		{"exact", models.LoginURIModel{URI: "https://example.com/login", Match: Exact}, "https://example.com/login?next=1", false},
+		{"regex", models.LoginURIModel{URI: `^https://(www\.)?example\.com/`, Match: Regex}, "https://www.example.com/login", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uri, err := Prepare(tt.uri)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.match, Match(uri, tt.page))
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Vaulttools package checks the data of the zero-knowledge vault.
+// The server cannot check the encrypted items, so only the key derivation parameters,
+// the size of the items and the plain metadata are checked.
+package vaulttools
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+)
+
+// Key derivation functions supported by the clients.
+const (
+	PBKDF2SHA256 string = "pbkdf2-sha256"
+	Argon2id     string = "argon2id"
+)
+
+// Minimal parameters of the key derivation, the recommendations of OWASP.
+const (
+	MinSaltSize         int   = 16
+	MaxSaltSize         int   = 64
+	MinPBKDF2Iterations int32 = 600000
+	MinArgon2Memory     int32 = 19456 // KiB
+)
+
+// Limits of the vault items.
+const (
+	MaxItemSize       int = 1 << 20
+	MaxMetadataKeys   int = 16
+	MaxMetadataKeyLen int = 64
+	MaxMetadataValLen int = 255
+)
+
+// ValidateParams - checks the key derivation parameters. Returns nil if the parameters are correct.
+func ValidateParams(params models.VaultParamsModel) error {
+	if len(params.Salt) < MinSaltSize || len(params.Salt) > MaxSaltSize {
+		return customerror.ErrInvalidSalt
+	}
+	switch params.KDF {
+	case PBKDF2SHA256:
+		if params.Iterations < MinPBKDF2Iterations || params.Memory != 0 || params.Parallelism != 0 {
+			return customerror.ErrWeakKDFParams
+		}
+		return nil
+	case Argon2id:
+		if params.Iterations < 1 || params.Memory < MinArgon2Memory || params.Parallelism < 1 {
+			return customerror.ErrWeakKDFParams
+		}
+		return nil
+	}
+	return customerror.ErrUnknownKDF
+}
+
+// ValidateItem - checks the size and the metadata of the item. Returns nil if the item is correct.
+func ValidateItem(item models.VaultItemModel) error {
+	if len(item.Blob) == 0 {
+		return customerror.ErrEmptyVaultItem
+	}
+	if len(item.Blob) > MaxItemSize {
+		return customerror.ErrVaultItemTooLarge
+	}
+	return ValidateMetadata(item.Metadata)
+}
+
+// ValidateMetadata - checks the metadata of the item or the metadata to search.
+func ValidateMetadata(metadata map[string]string) error {
+	if len(metadata) > MaxMetadataKeys {
+		return customerror.ErrInvalidVaultMetadata
+	}
+	for key, value := range metadata {
+		if strings.TrimSpace(key) == "" || len(key) > MaxMetadataKeyLen || len(value) > MaxMetadataValLen ||
+			!utf8.ValidString(key) || !utf8.ValidString(value) {
+			return customerror.ErrInvalidVaultMetadata
+		}
+	}
+	return nil
+}
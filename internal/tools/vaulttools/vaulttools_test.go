@@ -0,0 +1,60 @@
+package vaulttools
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/BillyBones007/pwdm_server/internal/customerror"
+	"github.com/BillyBones007/pwdm_server/internal/storage/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateParams(t *testing.T) {
+	salt := make([]byte, MinSaltSize)
+	tests := []struct {
+		name   string
+		params models.VaultParamsModel
+		err    error
+	}{
+		{"pbkdf2", models.VaultParamsModel{KDF: PBKDF2SHA256, Salt: salt, Iterations: MinPBKDF2Iterations}, nil},
+		{"argon2id", models.VaultParamsModel{KDF: Argon2id, Salt: salt, Iterations: 2, Memory: MinArgon2Memory, Parallelism: 1}, nil},
+		{"few iterations", models.VaultParamsModel{KDF: PBKDF2SHA256, Salt: salt, Iterations: 1000}, customerror.ErrWeakKDFParams},
+		{"pbkdf2 memory", models.VaultParamsModel{KDF: PBKDF2SHA256, Salt: salt, Iterations: MinPBKDF2Iterations, Memory: 1}, customerror.ErrWeakKDFParams},
+		{"little memory", models.VaultParamsModel{KDF: Argon2id, Salt: salt, Iterations: 2, Memory: 1024, Parallelism: 1}, customerror.ErrWeakKDFParams},
+		{"no parallelism", models.VaultParamsModel{KDF: Argon2id, Salt: salt, Iterations: 2, Memory: MinArgon2Memory}, customerror.ErrWeakKDFParams},
+		{"short salt", models.VaultParamsModel{KDF: Argon2id, Salt: salt[:8], Iterations: 2, Memory: MinArgon2Memory, Parallelism: 1}, customerror.ErrInvalidSalt},
+		{"long salt", models.VaultParamsModel{KDF: PBKDF2SHA256, Salt: make([]byte, MaxSaltSize+1), Iterations: MinPBKDF2Iterations}, customerror.ErrInvalidSalt},
+		{"unknown kdf", models.VaultParamsModel{KDF: "scrypt", Salt: salt, Iterations: 1}, customerror.ErrUnknownKDF},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.err, ValidateParams(tt.params))
+		})
+	}
+}
+
+func TestValidateItem(t *testing.T) {
+	tooMany := make(map[string]string, MaxMetadataKeys+1)
+	for i := 0; i <= MaxMetadataKeys; i++ {
+		tooMany[strings.Repeat("k", i+1)] = "v"
+	}
+	tests := []struct {
+		name string
+		item models.VaultItemModel
+		err  error
+	}{
+		{"without metadata", models.VaultItemModel{Blob: []byte("ciphertext")}, nil},
+		{"with metadata", models.VaultItemModel{Blob: []byte("ciphertext"), Metadata: map[string]string{"kind": "login", "folder": ""}}, nil},
+		{"empty", models.VaultItemModel{}, customerror.ErrEmptyVaultItem},
+		{"too large", models.VaultItemModel{Blob: make([]byte, MaxItemSize+1)}, customerror.ErrVaultItemTooLarge},
+		{"empty key", models.VaultItemModel{Blob: []byte("c"), Metadata: map[string]string{" ": "v"}}, customerror.ErrInvalidVaultMetadata},
+		{"long value", models.VaultItemModel{Blob: []byte("c"), Metadata: map[string]string{"k": strings.Repeat("v", MaxMetadataValLen+1)}}, customerror.ErrInvalidVaultMetadata},
+		{"invalid utf8", models.VaultItemModel{Blob: []byte("c"), Metadata: map[string]string{"k": "\xff"}}, customerror.ErrInvalidVaultMetadata},
+		{"too many keys", models.VaultItemModel{Blob: []byte("c"), Metadata: tooMany}, customerror.ErrInvalidVaultMetadata},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.err, ValidateItem(tt.item))
+		})
+	}
+}
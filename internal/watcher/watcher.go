@@ -0,0 +1,122 @@
+// Watcher package delivers notifications about changes of the user data to the subscribers.
+// Notifications come from the storage (PostgreSQL LISTEN/NOTIFY), so the changes made
+// on any server replica reach the subscribers of all replicas.
+package watcher
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/BillyBones007/pwdm_server/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+// Delay before reconnecting to the storage after a listen error.
+const reconnectDelay = time.Second * 3
+
+// Hub - keeps the subscribers and sends them the notifications of changes.
+type Hub struct {
+	rep    storage.Storage
+	Logger *logrus.Logger
+	mu     sync.Mutex
+	subs   map[string]map[chan struct{}]struct{} // uuid -> set of subscribers
+	done   chan struct{}                         // closed when the hub stops
+}
+
+// NewHub - constructor Hub.
+func NewHub(r storage.Storage, l *logrus.Logger) *Hub {
+	return &Hub{rep: r, Logger: l, subs: make(map[string]map[chan struct{}]struct{}),
+		done: make(chan struct{})}
+}
+
+// Subscribe - subscribes to the changes of the user data. Returns the channel
+// signaling that there are new changes and the function to unsubscribe.
+func (h *Hub) Subscribe(uuid string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	h.mu.Lock()
+	if h.subs[uuid] == nil {
+		h.subs[uuid] = make(map[chan struct{}]struct{})
+	}
+	h.subs[uuid][ch] = struct{}{}
+	h.mu.Unlock()
+
+	unsubscribe := func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.subs[uuid], ch)
+		if len(h.subs[uuid]) == 0 {
+			delete(h.subs, uuid)
+		}
+	}
+	return ch, unsubscribe
+}
+
+// Notify - notifies all subscribers of the user about new changes.
+// Does not block: a subscriber that has not read the previous signal yet will read the data anyway.
+func (h *Hub) Notify(uuid string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subs[uuid] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// notifyAll - notifies all subscribers. Used after reconnecting, when notifications could be lost.
+func (h *Hub) notifyAll() {
+	h.mu.Lock()
+	uuids := make([]string, 0, len(h.subs))
+	for uuid := range h.subs {
+		uuids = append(uuids, uuid)
+	}
+	h.mu.Unlock()
+
+	for _, uuid := range uuids {
+		h.Notify(uuid)
+	}
+}
+
+// Done - returns the channel that is closed when the hub stops.
+func (h *Hub) Done() <-chan struct{} {
+	return h.done
+}
+
+// Run - listens for the change notifications from the storage until the context is done.
+func (h *Hub) Run(ctx context.Context) {
+	defer close(h.done)
+	notifications := make(chan string)
+	go func() {
+		for {
+			select {
+			case uuid := <-notifications:
+				h.Notify(uuid)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		err := h.rep.ListenChanges(ctx, notifications)
+		if ctx.Err() != nil {
+			return
+		}
+		h.Logger.WithFields(logrus.Fields{
+			"service": "watcher",
+			"handler": "run",
+			"err":     err,
+			"from":    "storage.listen_changes",
+		}).Error("Storage error")
+
+		select {
+		case <-time.After(reconnectDelay):
+			h.notifyAll()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
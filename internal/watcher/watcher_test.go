@@ -0,0 +1,49 @@
+package watcher
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHub(t *testing.T) {
+	t.Run("Notify subscriber", func(t *testing.T) {
+		hub := NewHub(nil, logrus.New())
+		ch, unsubscribe := hub.Subscribe("uuid")
+		defer unsubscribe()
+
+		hub.Notify("uuid")
+		hub.Notify("uuid") // must not block
+		assert.Len(t, ch, 1)
+	})
+
+	t.Run("Notify other user", func(t *testing.T) {
+		hub := NewHub(nil, logrus.New())
+		ch, unsubscribe := hub.Subscribe("uuid")
+		defer unsubscribe()
+
+		hub.Notify("other")
+		assert.Len(t, ch, 0)
+	})
+
+	t.Run("Unsubscribe", func(t *testing.T) {
+		hub := NewHub(nil, logrus.New())
+		_, unsubscribe := hub.Subscribe("uuid")
+		unsubscribe()
+
+		assert.Empty(t, hub.subs)
+	})
+
+	t.Run("Notify all", func(t *testing.T) {
+		hub := NewHub(nil, logrus.New())
+		first, unsubFirst := hub.Subscribe("first")
+		defer unsubFirst()
+		second, unsubSecond := hub.Subscribe("second")
+		defer unsubSecond()
+
+		hub.notifyAll()
+		assert.Len(t, first, 1)
+		assert.Len(t, second, 1)
+	})
+}